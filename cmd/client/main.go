@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"slices"
 
 	"github.com/dnsoftware/gophkeeper/internal/client/app"
 )
@@ -13,6 +15,56 @@ var (
 )
 
 func main() {
+	// неинтерактивные команды для скриптов: gophkeeper login|list|get|add|edit|rm|upload|download|fields|search [ключи]
+	// stdout занят результатом команды, поэтому версия сборки не выводится
+	if len(os.Args) > 1 && slices.Contains(app.Commands, os.Args[1]) {
+		os.Exit(app.CommandRun(os.Args[1], os.Args[2:]))
+	}
+
+	// запуск процесса с секретами из хранилища: gophkeeper run [--env NAME=ссылка ...] [--template файл] [ключи] -- команда
+	// stdout принадлежит дочернему процессу, поэтому версия сборки не выводится
+	if len(os.Args) > 1 && os.Args[1] == "run" {
+		code, err := app.ExecRun(os.Args[2:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "run: "+err.Error())
+		}
+		os.Exit(code)
+	}
+
+	// git credential helper: gophkeeper git-credential get|store|erase [ключи]
+	// stdout занят протоколом git, поэтому версия сборки не выводится
+	if len(os.Args) > 1 && os.Args[1] == "git-credential" {
+		err := app.GitCredentialRun(os.Args[2:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "git-credential: "+err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	// полноэкранный интерфейс: gophkeeper tui [ключи]
+	// экран занят интерфейсом, поэтому версия сборки не выводится
+	if len(os.Args) > 1 && os.Args[1] == "tui" {
+		err := app.TUIRun(os.Args[2:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "tui: "+err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	// агенты, выдающие секреты из хранилища: gophkeeper agent ssh [ключи]
+	// агент работает в текущем процессе (вход и подтверждения подписи в консоли), в stdout выводится
+	// только строка SSH_AUTH_SOCK для перенаправления в файл, поэтому версия сборки не выводится
+	if len(os.Args) > 1 && os.Args[1] == "agent" {
+		err := app.AgentRun(os.Args[2:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "agent: "+err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	fmt.Printf("Build version: %s\n", buildVersion)
 	fmt.Printf("Build date: %s\n", buildDate)
 	fmt.Printf("Build commit: %s\n", buildCommit)
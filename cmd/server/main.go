@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/dnsoftware/gophkeeper/internal/server/app"
 )
@@ -17,5 +18,15 @@ func main() {
 	fmt.Printf("Build date: %s\n", buildDate)
 	fmt.Printf("Build commit: %s\n", buildCommit)
 
+	// управление схемой базы данных: serverkeeper migrate up|down [N]|version|force V [ключи]
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err := app.MigrateRun(os.Args[2:])
+		if err != nil {
+			fmt.Println("migrate: " + err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
 	app.ServerRun()
 }
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS entity_deleted_at_index;
+
+ALTER TABLE entities
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,4 @@
+ALTER TABLE entities
+    ADD COLUMN deleted_at timestamp;
+
+CREATE INDEX entity_deleted_at_index ON entities (deleted_at);
@@ -0,0 +1 @@
+/* Удаленные при восстановлении данные вернуть нельзя */
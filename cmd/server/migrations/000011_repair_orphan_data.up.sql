@@ -0,0 +1,34 @@
+/* Сущности удаленных пользователей */
+DELETE FROM entities e
+WHERE e.user_id IS NULL
+   OR NOT EXISTS (SELECT 1 FROM users u WHERE u.id = e.user_id);
+
+/* Сущности неизвестного типа */
+DELETE FROM entities e
+WHERE e.etype IS NULL
+   OR NOT EXISTS (SELECT 1 FROM entity_codes c WHERE c.etype = e.etype);
+
+/* Свойства без сущности или без описания поля */
+DELETE FROM properties p
+WHERE p.entity_id IS NULL
+   OR p.field_id IS NULL
+   OR NOT EXISTS (SELECT 1 FROM entities e WHERE e.id = p.entity_id)
+   OR NOT EXISTS (SELECT 1 FROM fields f WHERE f.id = p.field_id);
+
+/* Дубли свойств (entity_id, field_id) - оставляем последнее */
+DELETE FROM properties p
+USING properties d
+WHERE p.entity_id = d.entity_id
+  AND p.field_id = d.field_id
+  AND p.id < d.id;
+
+/* Метаинформация без сущности */
+DELETE FROM metainfo m
+WHERE m.entity_id IS NULL
+   OR NOT EXISTS (SELECT 1 FROM entities e WHERE e.id = m.entity_id);
+
+/* Пустые значения */
+UPDATE properties SET value = '' WHERE value IS NULL;
+UPDATE metainfo SET title = '' WHERE title IS NULL;
+UPDATE metainfo SET value = '' WHERE value IS NULL;
+UPDATE entities SET created_at = now() WHERE created_at IS NULL;
@@ -0,0 +1,36 @@
+ALTER TABLE metainfo
+    DROP CONSTRAINT IF EXISTS metainfo_entity_id_fk;
+
+ALTER TABLE properties
+    DROP CONSTRAINT IF EXISTS properties_entity_field_unique,
+    DROP CONSTRAINT IF EXISTS properties_field_id_fk,
+    DROP CONSTRAINT IF EXISTS properties_entity_id_fk;
+
+ALTER TABLE entities
+    DROP CONSTRAINT IF EXISTS entities_etype_fk,
+    DROP CONSTRAINT IF EXISTS entities_user_id_fk;
+
+ALTER TABLE fields
+    DROP CONSTRAINT IF EXISTS fields_etype_fk;
+
+ALTER TABLE fields
+    ALTER COLUMN etype DROP NOT NULL,
+    ALTER COLUMN name DROP NOT NULL,
+    ALTER COLUMN ftype DROP NOT NULL;
+
+ALTER TABLE entities
+    ALTER COLUMN user_id DROP NOT NULL,
+    ALTER COLUMN etype DROP NOT NULL,
+    ALTER COLUMN created_at DROP NOT NULL;
+
+/* Колонки значений остаются TEXT: возврат к VARCHAR(1024) невозможен, если сохранены более длинные значения,
+   а обрезка испортила бы зашифрованные данные */
+ALTER TABLE metainfo
+    ALTER COLUMN entity_id DROP NOT NULL,
+    ALTER COLUMN title DROP NOT NULL,
+    ALTER COLUMN value DROP NOT NULL;
+
+ALTER TABLE properties
+    ALTER COLUMN entity_id DROP NOT NULL,
+    ALTER COLUMN field_id DROP NOT NULL,
+    ALTER COLUMN value DROP NOT NULL;
@@ -0,0 +1,39 @@
+/* Значения хранятся в зашифрованном виде (hex) и могут быть длиннее 1024 символов */
+ALTER TABLE properties
+    ALTER COLUMN value TYPE TEXT,
+    ALTER COLUMN entity_id SET NOT NULL,
+    ALTER COLUMN field_id SET NOT NULL,
+    ALTER COLUMN value SET NOT NULL;
+
+ALTER TABLE metainfo
+    ALTER COLUMN title TYPE TEXT,
+    ALTER COLUMN value TYPE TEXT,
+    ALTER COLUMN entity_id SET NOT NULL,
+    ALTER COLUMN title SET NOT NULL,
+    ALTER COLUMN value SET NOT NULL;
+
+ALTER TABLE entities
+    ALTER COLUMN user_id SET NOT NULL,
+    ALTER COLUMN etype SET NOT NULL,
+    ALTER COLUMN created_at SET NOT NULL;
+
+ALTER TABLE fields
+    ALTER COLUMN etype SET NOT NULL,
+    ALTER COLUMN name SET NOT NULL,
+    ALTER COLUMN ftype SET NOT NULL;
+
+/* Внешние ключи: удаление пользователя или сущности удаляет все зависимые данные */
+ALTER TABLE fields
+    ADD CONSTRAINT fields_etype_fk FOREIGN KEY (etype) REFERENCES entity_codes (etype) ON DELETE CASCADE;
+
+ALTER TABLE entities
+    ADD CONSTRAINT entities_user_id_fk FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    ADD CONSTRAINT entities_etype_fk FOREIGN KEY (etype) REFERENCES entity_codes (etype) ON DELETE CASCADE;
+
+ALTER TABLE properties
+    ADD CONSTRAINT properties_entity_id_fk FOREIGN KEY (entity_id) REFERENCES entities (id) ON DELETE CASCADE,
+    ADD CONSTRAINT properties_field_id_fk FOREIGN KEY (field_id) REFERENCES fields (id) ON DELETE CASCADE,
+    ADD CONSTRAINT properties_entity_field_unique UNIQUE (entity_id, field_id);
+
+ALTER TABLE metainfo
+    ADD CONSTRAINT metainfo_entity_id_fk FOREIGN KEY (entity_id) REFERENCES entities (id) ON DELETE CASCADE;
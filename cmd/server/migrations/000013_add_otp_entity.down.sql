@@ -0,0 +1,3 @@
+DELETE FROM entities WHERE etype = 'otp';
+DELETE FROM fields WHERE etype = 'otp';
+DELETE FROM entity_codes WHERE etype = 'otp';
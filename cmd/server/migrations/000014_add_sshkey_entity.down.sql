@@ -0,0 +1,3 @@
+DELETE FROM entities WHERE etype = 'sshkey';
+DELETE FROM fields WHERE etype = 'sshkey';
+DELETE FROM entity_codes WHERE etype = 'sshkey';
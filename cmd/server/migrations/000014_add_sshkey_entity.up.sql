@@ -0,0 +1,8 @@
+INSERT INTO entity_codes (etype, name)
+VALUES ('sshkey', 'SSH ключи');
+
+INSERT INTO fields (etype, name, ftype, validate_rules, validate_messages)
+VALUES ('sshkey', 'Приватный ключ (путь к файлу)', 'sshkey', 'required,file,sshkey', '{"required": "Путь к файлу не может быть пустым", "file": "Файла не существует", "sshkey": "Файл не содержит приватный SSH ключ"}'),
+       ('sshkey', 'Пароль ключа (пусто - ключ не зашифрован)', 'sshpassphrase', '', '{}'),
+       ('sshkey', 'Публичный ключ (пусто - вычислить из приватного)', 'sshpub', 'omitempty,sshpub', '{"sshpub": "Неверный формат публичного ключа"}'),
+       ('sshkey', 'Комментарий', 'sshcomment', '', '{}');
@@ -0,0 +1,8 @@
+CREATE TABLE blind_index
+(
+    entity_id INTEGER NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    token     CHARACTER VARYING(64) NOT NULL,
+    PRIMARY KEY (entity_id, token)
+);
+
+CREATE INDEX blind_index_token_index ON blind_index (token);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS entity_tags;
+DROP INDEX IF EXISTS entity_folder_id_index;
+ALTER TABLE entities DROP COLUMN IF EXISTS folder_id;
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS entity_user_accessed_at_index;
+ALTER TABLE entities DROP COLUMN IF EXISTS accessed_at;
+ALTER TABLE entities DROP COLUMN IF EXISTS favorite;
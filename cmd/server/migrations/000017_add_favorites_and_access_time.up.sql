@@ -0,0 +1,7 @@
+/* Избранное и время последнего получения сущности для быстрого доступа к часто используемым сущностям */
+ALTER TABLE entities
+    ADD COLUMN favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE entities
+    ADD COLUMN accessed_at timestamp;
+
+CREATE INDEX entity_user_accessed_at_index ON entities (user_id, accessed_at);
@@ -0,0 +1,3 @@
+/* Номер ревизии сущности увеличивается при каждом сохранении изменений */
+ALTER TABLE entities
+    ADD COLUMN revision BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,6 @@
+DELETE FROM entity_codes WHERE user_id IS NOT NULL;
+ALTER TABLE fields DROP COLUMN IF EXISTS position;
+ALTER TABLE fields DROP COLUMN IF EXISTS secret;
+DROP INDEX IF EXISTS entity_codes_user_id_index;
+ALTER TABLE entity_codes DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE entity_codes DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,20 @@
+/* Пользовательские типы сущностей: владелец типа (NULL - встроенный тип) и время перемещения в архив */
+ALTER TABLE entity_codes
+    ADD COLUMN user_id INTEGER REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE entity_codes
+    ADD COLUMN archived_at timestamp;
+
+CREATE INDEX entity_codes_user_id_index ON entity_codes (user_id);
+
+/* Секретное поле скрывается при просмотре и не попадает в поисковый индекс клиента, position - порядок полей в форме */
+ALTER TABLE fields
+    ADD COLUMN secret BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE fields
+    ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+/* У встроенных типов секретны пароль, код проверки карты, секрет OTP, приватный SSH ключ и его пароль,
+   логин, номер и срок действия карты остаются открытыми и попадают в поисковый индекс клиента */
+UPDATE fields SET secret = true
+WHERE etype = 'logopas' AND name = 'Пароль'
+   OR etype = 'card' AND name = 'Код проверки подлинности'
+   OR ftype IN ('otp', 'sshkey', 'sshpassphrase');
@@ -0,0 +1,15 @@
+/* Вложения сущностей: название и контрольная сумма хранятся в зашифрованном виде,
+   содержимое - зашифрованными фрагментами в файловом хранилище */
+CREATE TABLE attachments
+(
+    id          SERIAL PRIMARY KEY,
+    entity_id   INTEGER   NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    name        TEXT      NOT NULL,
+    size        BIGINT    NOT NULL DEFAULT 0,
+    digest      TEXT      NOT NULL DEFAULT '',
+    servername  TEXT      NOT NULL,
+    chunk_count INTEGER   NOT NULL DEFAULT 0,
+    created_at  timestamp NOT NULL DEFAULT now()
+);
+
+CREATE INDEX attachments_entity_id_index ON attachments (entity_id);
@@ -0,0 +1,9 @@
+// Package migrations миграции базы данных Postgresql, встроенные в бинарный файл сервера
+package migrations
+
+import "embed"
+
+// FS файлы миграций
+//
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,72 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/chzyer/readline"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/config"
+	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+)
+
+// режимы подкоманды agent
+const (
+	agentSSH = "ssh"
+)
+
+// AgentRun подкоманда запуска агентов, выдающих секреты из хранилища:
+// agent ssh [ключи запуска клиента]
+func AgentRun(args []string) error {
+	if len(args) == 0 || args[0] != agentSSH {
+		return errors.New("usage: agent ssh [-agent-socket path] [client flags]")
+	}
+
+	// оставшиеся аргументы - обычные ключи запуска клиента (-c, -a, -k, -agent-socket ...)
+	os.Args = append(os.Args[:1], args[1:]...)
+	cfg, err := config.NewClientConfig()
+	if err != nil {
+		return err
+	}
+
+	sender, _, err := newSender(cfg)
+	if err != nil {
+		return err
+	}
+
+	// вход и подтверждения подписи идут через stderr: stdout занят строкой SSH_AUTH_SOCK
+	rl, err := domain.NewCLIReadline(&readline.Config{
+		Prompt:          "\033[31m»\033[0m ",
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+		Stdout:          os.Stderr,
+	})
+	if err != nil {
+		return err
+	}
+	defer rl.Close()
+
+	client, err := domain.NewGophKeepClient(rl, sender)
+	if err != nil {
+		return err
+	}
+
+	socketPath := cfg.AgentSocket
+	if socketPath == "" {
+		socketPath = filepath.Join(os.TempDir(), fmt.Sprintf("gophkeeper-agent-%d.sock", os.Getpid()))
+	}
+
+	stop := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		close(stop)
+	}()
+
+	return client.SSHAgent(socketPath, stop)
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"os"
 
 	"github.com/chzyer/readline"
@@ -9,7 +10,9 @@ import (
 
 	"github.com/dnsoftware/gophkeeper/internal/client/config"
 	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+	"github.com/dnsoftware/gophkeeper/internal/client/hibp"
 	"github.com/dnsoftware/gophkeeper/internal/client/infrastructure"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
 	"github.com/dnsoftware/gophkeeper/logger"
 )
 
@@ -23,22 +26,7 @@ func ClientRun() {
 	}
 	logger.Log().Info("Client starting...")
 
-	path, _ := os.Getwd()
-	certFile := path + "/cert/ca.crt"
-
-	creds, err := credentials.NewClientTLSFromFile(certFile, "")
-	if err != nil {
-		logger.Log().Fatal(err.Error())
-	}
-
-	// Директория для загрузки файлов с сервера
-	uploadDir, err := domain.FilestorageDir()
-	if err != nil {
-		logger.Log().Fatal(err.Error())
-	}
-
-	var opts []grpc.DialOption
-	sender, _, err := infrastructure.NewGRPCSender(uploadDir, cfg.ServerAddress, cfg.SecretKey, creds, opts...)
+	sender, uploadDir, err := newSender(cfg)
 	if err != nil {
 		logger.Log().Fatal(err.Error())
 	}
@@ -59,11 +47,96 @@ func ClientRun() {
 	if err != nil {
 		logger.Log().Fatal(err.Error())
 	}
+	rl.Breaches, err = openBreaches(cfg)
+	if err != nil {
+		logger.Log().Fatal(err.Error())
+	}
 
 	client, err := domain.NewGophKeepClient(rl, sender)
 	if err != nil {
 		logger.Log().Fatal(err.Error())
 	}
+	client.Presenter, err = domain.NewPresenter(cfg.Output, os.Stdout)
+	if err != nil {
+		logger.Log().Fatal(err.Error())
+	}
 
 	client.Start(stopChan)
 }
+
+// newSender подключение к серверу
+// Возвращает также директорию, в которую загружаются файлы с сервера
+func newSender(cfg *config.ClientConfig) (*infrastructure.GRPCSender, string, error) {
+	path, _ := os.Getwd()
+	certFile := path + "/cert/ca.crt"
+
+	creds, err := credentials.NewClientTLSFromFile(certFile, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Директория для загрузки файлов с сервера
+	uploadDir, err := domain.FilestorageDir()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var opts []grpc.DialOption
+	sender, _, err := infrastructure.NewGRPCSender(uploadDir, cfg.ServerAddress, cfg.SecretKey, creds, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return sender, uploadDir, nil
+}
+
+// openBreaches подключение локальной базы утечек паролей, если она задана в конфигурации
+func openBreaches(cfg *config.ClientConfig) (*hibp.Checker, error) {
+	if cfg.HIBPFile == "" {
+		return nil, nil
+	}
+
+	checker, err := hibp.Open(cfg.HIBPFile)
+	if err != nil {
+		return nil, fmt.Errorf("база утечек паролей: %w", err)
+	}
+
+	return checker, nil
+}
+
+// login аутентификация в хранилище для неинтерактивных режимов
+// Недостающие логин и пароль берутся из переменных окружения, при их отсутствии и разрешенном вводе запрашиваются в консоли
+// (приглашения выводятся в stderr, чтобы не смешиваться с выводом режима)
+func login(sender domain.Sender, creds vaultCredentials, interactive bool) error {
+	err := creds.resolve(nil)
+	if err != nil {
+		return err
+	}
+
+	if creds.login == "" || creds.password == "" {
+		if !interactive {
+			return fmt.Errorf("%w: укажите логин и пароль хранилища в переменных окружения %v и %v", errAuth, constants.EnvLogin, constants.EnvPassword)
+		}
+
+		rl, err := domain.NewCLIReadline(&readline.Config{
+			InterruptPrompt: "^C",
+			Stdout:          os.Stderr,
+		})
+		if err != nil {
+			return err
+		}
+		defer rl.Close()
+
+		creds.login, creds.password, err = rl.Login()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = sender.Login(creds.login, creds.password)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errAuth, err)
+	}
+
+	return nil
+}
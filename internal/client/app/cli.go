@@ -0,0 +1,608 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/config"
+	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+	"github.com/dnsoftware/gophkeeper/internal/client/passgen"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// коды завершения неинтерактивных команд
+const (
+	ExitOK       = 0 // успешное выполнение
+	ExitError    = 1 // прочие ошибки (сеть, сервер, файлы)
+	ExitUsage    = 2 // неверные аргументы команды
+	ExitAuth     = 3 // ошибка аутентификации
+	ExitNotFound = 4 // сущность, тип сущности или поле не найдены
+	ExitInvalid  = 5 // данные не прошли проверку
+)
+
+// неинтерактивные команды
+const (
+	cmdLogin    = "login"
+	cmdList     = "list"
+	cmdGet      = "get"
+	cmdAdd      = "add"
+	cmdEdit     = "edit"
+	cmdRemove   = "rm"
+	cmdUpload   = "upload"
+	cmdDownload = "download"
+	cmdFields   = "fields"
+	cmdSearch   = "search"
+	cmdFolders  = "folders"
+	cmdMkdir    = "mkdir"
+	cmdMvdir    = "mvdir"
+	cmdRmdir    = "rmdir"
+	cmdTypes    = "types"
+	cmdMktype   = "mktype"
+	cmdEdittype = "edittype"
+	cmdArchtype = "archtype"
+	cmdGenerate = "generate"
+	cmdAudit    = "audit"
+)
+
+// Commands названия неинтерактивных команд
+var Commands = []string{cmdLogin, cmdList, cmdGet, cmdAdd, cmdEdit, cmdRemove, cmdUpload, cmdDownload, cmdFields, cmdSearch,
+	cmdFolders, cmdMkdir, cmdMvdir, cmdRmdir, cmdTypes, cmdMktype, cmdEdittype, cmdArchtype, cmdGenerate, cmdAudit}
+
+// количество позиционных аргументов команд
+var commandPositional = map[string]int{
+	cmdLogin:    0,
+	cmdList:     0,
+	cmdGet:      1, // <id>
+	cmdAdd:      0,
+	cmdEdit:     1, // <id>
+	cmdRemove:   1, // <id>
+	cmdUpload:   1, // <файл>
+	cmdDownload: 1, // <id>
+	cmdFields:   0,
+	cmdSearch:   1, // <запрос>
+	cmdFolders:  0,
+	cmdMkdir:    1, // <путь>
+	cmdMvdir:    2, // <папка> <новый путь>
+	cmdRmdir:    1, // <папка>
+	cmdTypes:    0,
+	cmdMktype:   1, // <название>
+	cmdEdittype: 1, // <тип>
+	cmdArchtype: 1, // <тип>
+	cmdGenerate: 0,
+	cmdAudit:    0,
+}
+
+// команды, первый позиционный аргумент которых - ID сущности
+var commandWithID = map[string]bool{
+	cmdGet:      true,
+	cmdEdit:     true,
+	cmdRemove:   true,
+	cmdDownload: true,
+}
+
+var errAuth = errors.New("ошибка аутентификации")
+
+// vaultCredentials учетные данные хранилища
+// Приоритет: ключи командной строки, переменные окружения, первая строка stdin (ключ -password-stdin)
+type vaultCredentials struct {
+	login         string
+	password      string
+	passwordStdin bool
+}
+
+// commandOptions разобранные аргументы неинтерактивной команды
+type commandOptions struct {
+	args    []string           // позиционные аргументы
+	etype   string             // тип сущности
+	field   string             // название поля для get
+	values  map[string]string  // значения полей для add и edit
+	metas   []*domain.Metainfo // метаданные для add, edit и upload
+	out     string             // путь сохранения файла для download
+	output  string             // формат вывода: table, json, yaml
+	limit   int                // максимальное количество результатов search
+	server  bool               // search: поиск на сервере по слепому индексу
+	folder  string             // папка (путь или ID) для list, add, edit и upload
+	tags    []string           // теги: отбор для list, добавляемые для add, edit и upload
+	untags  []string           // удаляемые теги для edit
+	name    string             // новое название типа для edittype
+	defs    []string           // описания полей пользовательского типа для mktype и edittype
+	renames map[string]string  // переименование полей для edittype
+	restore bool               // archtype: возврат типа из архива
+	gen     passgen.Spec       // параметры генерации пароля для generate
+	creds   vaultCredentials   // учетные данные хранилища
+	rest    []string           // ключи запуска клиента
+}
+
+// multiFlag повторяемый ключ вида -field Название=значение
+type multiFlag []string
+
+func (m *multiFlag) String() string {
+	return strings.Join(*m, ", ")
+}
+
+func (m *multiFlag) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("ожидается Название=значение: %q", value)
+	}
+	*m = append(*m, value)
+	return nil
+}
+
+// tagFlag повторяемый ключ -tag тег
+type tagFlag []string
+
+func (m *tagFlag) String() string {
+	return strings.Join(*m, ", ")
+}
+
+func (m *tagFlag) Set(value string) error {
+	*m = append(*m, value)
+	return nil
+}
+
+// CommandRun выполнение неинтерактивной команды:
+// login | list [-type T] [-folder P] [-tag T] | get <id> [-field F] |
+// add -type T -field F=V ... [-meta M=V ...] [-folder P] [-tag T ...] |
+// edit <id> [-field F=V ...] [-meta M=V ...] [-folder P] [-tag T ...] [-untag T ...] | rm <id> |
+// upload <файл> [-type binary|text] [-meta M=V ...] [-folder P] [-tag T ...] |
+// download <id> [-out путь] | fields [-type T] | search <запрос> [-type T] [-limit N] [-server] |
+// folders | mkdir <путь> | mvdir <папка> <новый путь> | rmdir <папка> |
+// types | mktype <название> -def "Поле[:secret,правила]" ... |
+// edittype <тип> [-name N] [-def "Поле[:secret,правила]" ...] [-rename Старое=Новое ...] | archtype <тип> [-restore] |
+// generate [-length N] [-no-lower] [-no-upper] [-no-digits] [-no-symbols] [-no-ambiguous] [-words N] [-separator S] | audit
+// Команда generate выполняется без подключения к серверу.
+// Если задана локальная база утечек (ключ -hibp), пароли logopas проверяются при add и edit (предупреждение в stderr),
+// audit выводит все пароли хранилища, найденные в базе.
+// Папка задается путем через / или ID, / - вне папок.
+// Ключ -output table|json|yaml задает формат вывода.
+// Результат выводится в stdout, ошибки - в stderr (в форматах json и yaml - документом {error: {code, message}}).
+// Возвращает код завершения.
+func CommandRun(name string, args []string) int {
+	opts, err := parseCommandArgs(name, args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, name+": "+err.Error())
+		return ExitUsage
+	}
+
+	if name == cmdGenerate {
+		err = generateCommand(opts)
+	} else {
+		err = runCommand(name, opts)
+	}
+	if err != nil {
+		code := exitCode(err)
+		errOut, perr := domain.NewPresenter(opts.output, os.Stderr)
+		if perr != nil {
+			errOut, _ = domain.NewPresenter(domain.OutputTable, os.Stderr)
+		}
+		_ = errOut.Error(name, err, code)
+		return code
+	}
+
+	return ExitOK
+}
+
+// runCommand подключение к серверу, аутентификация и выполнение команды
+func runCommand(name string, opts *commandOptions) error {
+	// оставшиеся аргументы - обычные ключи запуска клиента (-c, -a, -k ...)
+	os.Args = append(os.Args[:1], opts.rest...)
+	cfg, err := config.NewClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if opts.output == "" {
+		opts.output = cfg.Output
+	}
+	out, err := domain.NewPresenter(opts.output, os.Stdout)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrValidation, err)
+	}
+
+	breaches, err := openBreaches(cfg)
+	if err != nil {
+		return err
+	}
+
+	sender, _, err := newSender(cfg)
+	if err != nil {
+		return err
+	}
+
+	err = opts.creds.resolve(os.Stdin)
+	if err != nil {
+		return err
+	}
+	err = login(sender, opts.creds, false)
+	if err != nil {
+		return err
+	}
+
+	cmds, err := domain.NewCommands(sender)
+	if err != nil {
+		return err
+	}
+	cmds.Breaches = breaches
+
+	return execCommand(cmds, name, opts, out)
+}
+
+// generateCommand генерация пароля или парольной фразы, хранилище не используется
+func generateCommand(opts *commandOptions) error {
+	out, err := domain.NewPresenter(opts.output, os.Stdout)
+	if err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrValidation, err)
+	}
+
+	pass, err := passgen.Generate(opts.gen)
+	if err != nil {
+		return err
+	}
+
+	return out.Generated(domain.GeneratedDoc{Password: pass, Entropy: int(passgen.Entropy(opts.gen))})
+}
+
+// execCommand выполнение команды над аутентифицированным хранилищем
+func execCommand(cmds *domain.Commands, name string, opts *commandOptions, out *domain.Presenter) error {
+	var id int32
+	if commandWithID[name] {
+		num, err := strconv.ParseInt(opts.args[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("%w: неверный ID %q", domain.ErrValidation, opts.args[0])
+		}
+		id = int32(num)
+	}
+
+	switch name {
+	case cmdLogin:
+		return out.Result(domain.ResultDoc{Action: domain.ActionLoggedIn})
+
+	case cmdList:
+		var tag string
+		if len(opts.tags) > 0 {
+			tag = opts.tags[0]
+		}
+		filter, err := cmds.FolderFilter(opts.folder, tag)
+		if err != nil {
+			return err
+		}
+		items, err := cmds.ListFiltered(opts.etype, filter)
+		if err != nil {
+			return err
+		}
+		return out.List(items)
+
+	case cmdGet:
+		if opts.field != "" {
+			value, err := cmds.GetField(id, opts.field)
+			if err != nil {
+				return err
+			}
+			return out.Value(domain.ValueDoc{Id: id, Field: opts.field, Value: value})
+		}
+		view, err := cmds.Get(id)
+		if err != nil {
+			return err
+		}
+		return out.Entity(view)
+
+	case cmdAdd:
+		newID, err := cmds.Add(opts.etype, opts.values, opts.metas, opts.placement())
+		if err != nil {
+			return err
+		}
+		warnBreached(name, cmds, os.Stderr)
+		return out.Result(domain.ResultDoc{Action: domain.ActionAdded, Id: newID})
+
+	case cmdEdit:
+		err := cmds.Edit(id, opts.values, opts.metas, opts.placement())
+		if err != nil {
+			return err
+		}
+		warnBreached(name, cmds, os.Stderr)
+		return out.Result(domain.ResultDoc{Action: domain.ActionEdited, Id: id})
+
+	case cmdRemove:
+		err := cmds.Remove(id)
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionRemoved, Id: id})
+
+	case cmdUpload:
+		newID, size, err := cmds.Upload(opts.etype, opts.args[0], opts.metas, opts.placement())
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionUploaded, Id: newID, Size: size})
+
+	case cmdDownload:
+		file, err := cmds.Download(id, opts.out)
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionDownloaded, Id: id, Path: file})
+
+	case cmdFields:
+		fields, err := cmds.Fields(opts.etype)
+		if err != nil {
+			return err
+		}
+		return out.Fields(fields)
+
+	case cmdSearch:
+		search := cmds.Search
+		if opts.server {
+			search = cmds.SearchServer
+		}
+		results, err := search(opts.args[0], opts.etype, opts.limit)
+		if err != nil {
+			return err
+		}
+		return out.SearchResults(results)
+
+	case cmdFolders:
+		nodes, err := cmds.Folders()
+		if err != nil {
+			return err
+		}
+		return out.Folders(nodes)
+
+	case cmdMkdir:
+		folderID, err := cmds.MakeFolder(opts.args[0])
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionCreated, Id: folderID})
+
+	case cmdMvdir:
+		folderID, err := cmds.MoveFolder(opts.args[0], opts.args[1])
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionMoved, Id: folderID})
+
+	case cmdRmdir:
+		folderID, err := cmds.RemoveFolder(opts.args[0])
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionDeleted, Id: folderID})
+
+	case cmdTypes:
+		codes, err := cmds.Types()
+		if err != nil {
+			return err
+		}
+		return out.Types(codes)
+
+	case cmdMktype:
+		etype, err := cmds.MakeType(opts.args[0], opts.defs)
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionCreated, Etype: etype})
+
+	case cmdEdittype:
+		err := cmds.EditType(opts.args[0], opts.name, opts.defs, opts.renames)
+		if err != nil {
+			return err
+		}
+		return out.Result(domain.ResultDoc{Action: domain.ActionEdited, Etype: opts.args[0]})
+
+	case cmdArchtype:
+		err := cmds.ArchiveType(opts.args[0], !opts.restore)
+		if err != nil {
+			return err
+		}
+		action := domain.ActionArchived
+		if opts.restore {
+			action = domain.ActionRestored
+		}
+		return out.Result(domain.ResultDoc{Action: action, Etype: opts.args[0]})
+
+	case cmdAudit:
+		items, err := cmds.Audit()
+		if err != nil {
+			return err
+		}
+		return out.Audit(items)
+	}
+
+	return fmt.Errorf("неизвестная команда %v", name)
+}
+
+// warnBreached предупреждения в stderr о паролях сохраненной сущности, найденных в базе утечек
+func warnBreached(name string, cmds *domain.Commands, w io.Writer) {
+	items, err := cmds.Breached()
+	if err != nil {
+		fmt.Fprintf(w, "%v: проверка по базе утечек не выполнена: %v\n", name, err)
+	}
+	for _, item := range items {
+		fmt.Fprintf(w, "%v: предупреждение: %v\n", name, domain.BreachWarning(item.Field, item.Count))
+	}
+}
+
+// parseCommandArgs разбор аргументов команды
+// Ключи и позиционные аргументы могут идти в любом порядке: get 42 -field Пароль
+func parseCommandArgs(name string, args []string) (*commandOptions, error) {
+	positional, ok := commandPositional[name]
+	if !ok {
+		return nil, fmt.Errorf("неизвестная команда %v", name)
+	}
+
+	opts := &commandOptions{values: make(map[string]string), renames: make(map[string]string)}
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	// ключи запуска клиента передаются в конфигурацию
+	clientFlags := []string{"c", "e", "a", "k", "hibp"}
+	for _, f := range clientFlags {
+		fs.String(f, "", "client flag")
+	}
+
+	fs.StringVar(&opts.creds.login, "login", "", "vault login")
+	fs.StringVar(&opts.creds.password, "password", "", "vault password")
+	fs.BoolVar(&opts.creds.passwordStdin, "password-stdin", false, "read vault password from stdin")
+	fs.StringVar(&opts.output, "output", "", "output format: table, json, yaml")
+
+	var fields, metas, renames multiFlag
+	var tags, untags, defs tagFlag
+	switch name {
+	case cmdList, cmdAdd, cmdFields, cmdSearch:
+		fs.StringVar(&opts.etype, "type", "", "entity type")
+	case cmdUpload:
+		fs.StringVar(&opts.etype, "type", constants.BinaryEntity, "entity type (binary or text)")
+	}
+	switch name {
+	case cmdGet:
+		fs.StringVar(&opts.field, "field", "", "field name")
+	case cmdAdd, cmdEdit:
+		fs.Var(&fields, "field", "field value: Name=value")
+	case cmdDownload:
+		fs.StringVar(&opts.out, "out", "", "output file path")
+	case cmdSearch:
+		fs.IntVar(&opts.limit, "limit", 0, "max results")
+		fs.BoolVar(&opts.server, "server", false, "search on server by blind index")
+	}
+	switch name {
+	case cmdAdd, cmdEdit, cmdUpload:
+		fs.Var(&metas, "meta", "metainfo: Title=value")
+	}
+	switch name {
+	case cmdList, cmdAdd, cmdEdit, cmdUpload:
+		fs.StringVar(&opts.folder, "folder", "", "folder path or ID, / - outside folders")
+		fs.Var(&tags, "tag", "tag")
+	}
+	if name == cmdEdit {
+		fs.Var(&untags, "untag", "tag to remove")
+	}
+	switch name {
+	case cmdMktype, cmdEdittype:
+		fs.Var(&defs, "def", "field definition: Name[:secret,rules]")
+	}
+	switch name {
+	case cmdEdittype:
+		fs.StringVar(&opts.name, "name", "", "new type name")
+		fs.Var(&renames, "rename", "rename field: Old=New")
+	case cmdArchtype:
+		fs.BoolVar(&opts.restore, "restore", false, "restore type from archive")
+	case cmdGenerate:
+		opts.gen.RegisterFlags(fs)
+	}
+
+	for {
+		err := fs.Parse(args)
+		if err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		opts.args = append(opts.args, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	if len(opts.args) != positional {
+		return nil, fmt.Errorf("ожидается аргументов: %v, получено: %v", positional, len(opts.args))
+	}
+	if name == cmdAdd && opts.etype == "" {
+		return nil, errors.New("не указан тип сущности (-type)")
+	}
+	if name == cmdMktype && len(defs) == 0 {
+		return nil, errors.New("не указаны поля типа (-def)")
+	}
+	if name == cmdList && len(tags) > 1 {
+		return nil, errors.New("список отбирается только по одному тегу")
+	}
+	if name == cmdGenerate {
+		if err := opts.gen.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := domain.NewPresenter(opts.output, io.Discard); err != nil {
+		return nil, err
+	}
+
+	for _, f := range fields {
+		key, value, _ := strings.Cut(f, "=")
+		opts.values[key] = value
+	}
+	for _, m := range metas {
+		title, value, _ := strings.Cut(m, "=")
+		opts.metas = append(opts.metas, &domain.Metainfo{Title: title, Value: value})
+	}
+	for _, r := range renames {
+		old, value, _ := strings.Cut(r, "=")
+		opts.renames[old] = value
+	}
+	opts.tags, opts.untags, opts.defs = tags, untags, defs
+
+	fs.Visit(func(f *flag.Flag) {
+		for _, cf := range clientFlags {
+			if f.Name == cf {
+				opts.rest = append(opts.rest, "-"+f.Name+"="+f.Value.String())
+			}
+		}
+	})
+
+	return opts, nil
+}
+
+// placement размещение сущности из ключей -folder, -tag и -untag
+func (o *commandOptions) placement() domain.Placement {
+	return domain.Placement{Folder: o.folder, Tags: o.tags, Untags: o.untags}
+}
+
+// resolve заполнение недостающих учетных данных из переменных окружения и stdin
+func (cr *vaultCredentials) resolve(stdin io.Reader) error {
+	if cr.login == "" {
+		cr.login = os.Getenv(constants.EnvLogin)
+	}
+	if cr.password == "" && !cr.passwordStdin {
+		cr.password = os.Getenv(constants.EnvPassword)
+	}
+	if cr.password == "" && cr.passwordStdin {
+		line, err := bufio.NewReader(stdin).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		cr.password = strings.TrimRight(line, "\r\n")
+	}
+
+	return nil
+}
+
+// exitCode код завершения по ошибке выполнения команды
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, errAuth):
+		return ExitAuth
+	case errors.Is(err, domain.ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, domain.ErrValidation):
+		return ExitInvalid
+	}
+
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return ExitAuth
+	case codes.NotFound:
+		return ExitNotFound
+	case codes.InvalidArgument:
+		return ExitInvalid
+	}
+
+	return ExitError
+}
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+	"github.com/dnsoftware/gophkeeper/internal/client/passgen"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func TestParseCommandArgs(t *testing.T) {
+	opts, err := parseCommandArgs(cmdGet, []string{"42", "-field", "Пароль", "-a=localhost:9090", "-login", "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"42"}, opts.args)
+	assert.Equal(t, "Пароль", opts.field)
+	assert.Equal(t, "alice", opts.creds.login)
+	assert.Equal(t, []string{"-a=localhost:9090"}, opts.rest)
+
+	opts, err = parseCommandArgs(cmdAdd, []string{"-type", "logopas", "-field", "Логин=alice", "-field", "Пароль=a=b", "-meta", "сайт=github"})
+	require.NoError(t, err)
+	assert.Equal(t, "logopas", opts.etype)
+	assert.Equal(t, map[string]string{"Логин": "alice", "Пароль": "a=b"}, opts.values)
+	assert.Equal(t, []*domain.Metainfo{{Title: "сайт", Value: "github"}}, opts.metas)
+
+	opts, err = parseCommandArgs(cmdEdit, []string{"7", "-folder", "work/team", "-tag", "dev", "-tag", "ci", "-untag", "old"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.Placement{Folder: "work/team", Tags: []string{"dev", "ci"}, Untags: []string{"old"}}, opts.placement())
+
+	opts, err = parseCommandArgs(cmdMvdir, []string{"work", "job/work"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work", "job/work"}, opts.args)
+
+	opts, err = parseCommandArgs(cmdEdittype, []string{"u1_0a0b0c0d", "-name", "Wi-Fi", "-def", "Пароль:secret,required", "-rename", "SSID=Сеть"})
+	require.NoError(t, err)
+	assert.Equal(t, "Wi-Fi", opts.name)
+	assert.Equal(t, []string{"Пароль:secret,required"}, opts.defs)
+	assert.Equal(t, map[string]string{"SSID": "Сеть"}, opts.renames)
+
+	opts, err = parseCommandArgs(cmdAudit, []string{"-hibp", "/data/pwned.txt", "-output", "json"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-hibp=/data/pwned.txt"}, opts.rest)
+
+	opts, err = parseCommandArgs(cmdGenerate, []string{"-words", "5", "-output", "json"})
+	require.NoError(t, err)
+	assert.Equal(t, passgen.Spec{Length: passgen.DefaultLength, Words: 5, Separator: passgen.DefaultSeparator}, opts.gen)
+
+	opts, err = parseCommandArgs(cmdUpload, []string{"report.pdf", "-password-stdin"})
+	require.NoError(t, err)
+	assert.Equal(t, constants.BinaryEntity, opts.etype)
+	assert.True(t, opts.creds.passwordStdin)
+
+	for _, args := range [][]string{
+		{cmdGet},                          // нет ID
+		{cmdGet, "1", "2"},                // лишний аргумент
+		{cmdAdd, "-field", "Логин=alice"}, // нет типа
+		{cmdAdd, "-type", "logopas", "-field", "Логин"}, // нет значения
+		{cmdList, "-field", "x"},                        // ключ другой команды
+		{cmdList, "-tag", "a", "-tag", "b"},             // отбор только по одному тегу
+		{cmdAdd, "-type", "logopas", "-untag", "a"},     // -untag только для edit
+		{cmdMktype, "Wi-Fi"},                            // нет описаний полей
+		{cmdArchtype, "u1_0a0b0c0d", "-name", "x"},      // -name только для edittype
+		{cmdGenerate, "-length", "2"},                   // слишком короткий пароль
+		{"unknown"},
+	} {
+		_, err := parseCommandArgs(args[0], args[1:])
+		assert.Error(t, err, args)
+	}
+}
+
+func TestCredentialsResolve(t *testing.T) {
+	t.Setenv(constants.EnvLogin, "env-login")
+	t.Setenv(constants.EnvPassword, "env-password")
+
+	creds := vaultCredentials{login: "flag-login"}
+	require.NoError(t, creds.resolve(nil))
+	assert.Equal(t, vaultCredentials{login: "flag-login", password: "env-password"}, creds)
+
+	creds = vaultCredentials{passwordStdin: true}
+	require.NoError(t, creds.resolve(strings.NewReader("stdin-password\nrest")))
+	assert.Equal(t, "env-login", creds.login)
+	assert.Equal(t, "stdin-password", creds.password)
+}
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, ExitOK, exitCode(nil))
+	assert.Equal(t, ExitError, exitCode(errors.New("сеть")))
+	assert.Equal(t, ExitAuth, exitCode(fmt.Errorf("%w: неверный пароль", errAuth)))
+	assert.Equal(t, ExitNotFound, exitCode(fmt.Errorf("%w: сущность 1", domain.ErrNotFound)))
+	assert.Equal(t, ExitInvalid, exitCode(fmt.Errorf("%w: Пароль", domain.ErrValidation)))
+	assert.Equal(t, ExitAuth, exitCode(status.Error(codes.Unauthenticated, "token")))
+	assert.Equal(t, ExitNotFound, exitCode(status.Error(codes.NotFound, "entity")))
+	assert.Equal(t, ExitInvalid, exitCode(status.Error(codes.InvalidArgument, "Пароль: обязательное поле не заполнено")))
+}
+
+func TestExecCommand(t *testing.T) {
+	sender := domain.NewMockSender(gomock.NewController(t))
+	sender.EXPECT().Fields(constants.LogopasEntity).Return([]*domain.Field{
+		{Id: 1, Etype: constants.LogopasEntity, Name: "Логин", Ftype: constants.FieldTypeString},
+		{Id: 2, Etype: constants.LogopasEntity, Name: "Пароль", Ftype: constants.FieldTypeString},
+	}, nil).AnyTimes()
+	sender.EXPECT().EntityList(constants.LogopasEntity).Return(map[int32]string{7: "сайт:github. "}, nil).Times(3)
+	sender.EXPECT().Entity(int32(7)).Return(&domain.Entity{
+		Id:       7,
+		Etype:    constants.LogopasEntity,
+		Props:    []*domain.Property{{FieldId: 1, Value: "alice"}, {FieldId: 2, Value: "s3cret"}},
+		Metainfo: []*domain.Metainfo{{Title: "сайт", Value: "github"}},
+	}, nil).AnyTimes()
+	sender.EXPECT().DeleteEntity(int32(7)).Return(nil)
+
+	cmds, err := domain.NewCommands(sender)
+	require.NoError(t, err)
+
+	run := func(name string, args ...string) (string, error) {
+		opts, err := parseCommandArgs(name, args)
+		require.NoError(t, err)
+		var out bytes.Buffer
+		presenter, err := domain.NewPresenter(opts.output, &out)
+		require.NoError(t, err)
+		err = execCommand(cmds, name, opts, presenter)
+		return out.String(), err
+	}
+
+	out, err := run(cmdList, "-type", "logopas")
+	require.NoError(t, err)
+	assert.Equal(t, "ID  TYPE     TITLE\n7   logopas  сайт:github.\n", out)
+
+	out, err = run(cmdList, "-type", "logopas", "-output", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"id": 7, "type": "logopas", "title": "сайт:github."}]`, out)
+
+	out, err = run(cmdGet, "7", "-output=yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "id: 7\ntype: logopas\nfields:\n  - name: Логин\n    value: alice\n  - name: Пароль\n    value: s3cret\nmetainfo:\n  - name: сайт\n    value: github\n", out)
+
+	out, err = run(cmdGet, "7", "-field", "Пароль")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret\n", out)
+
+	out, err = run(cmdFields, "-type", "logopas", "-output", "json")
+	require.NoError(t, err)
+	assert.Contains(t, out, `"name": "Пароль"`)
+
+	out, err = run(cmdSearch, "githb", "-type", "logopas", "-output", "json")
+	require.NoError(t, err)
+	assert.Contains(t, out, `"title": "сайт:github."`)
+
+	sender.EXPECT().SearchEntities("GitHub", constants.LogopasEntity).Return([]*domain.FoundEntity{
+		{Id: 7, Etype: constants.LogopasEntity, Metainfo: []*domain.Metainfo{{Title: "сайт", Value: "github"}}},
+	}, nil)
+	out, err = run(cmdSearch, "GitHub", "-type", "logopas", "-server")
+	require.NoError(t, err)
+	assert.Equal(t, "ID  TYPE     TITLE         MATCH\n7   logopas  сайт:github.  сайт: github\n", out)
+
+	sender.EXPECT().Folders().Return([]*domain.Folder{{Id: 3, Name: "work"}, {Id: 4, ParentId: 3, Name: "team"}}, nil).AnyTimes()
+	out, err = run(cmdFolders)
+	require.NoError(t, err)
+	assert.Equal(t, "ID  FOLDER\n3   work\n4     team\n", out)
+
+	sender.EXPECT().EntityListFiltered(constants.LogopasEntity, domain.ListFilter{FolderId: 4, Tag: "dev"}).Return(map[int32]string{7: "сайт:github. "}, nil)
+	out, err = run(cmdList, "-type", "logopas", "-folder", "work/team", "-tag", "dev")
+	require.NoError(t, err)
+	assert.Equal(t, "ID  TYPE     TITLE\n7   logopas  сайт:github.\n", out)
+
+	sender.EXPECT().DeleteFolder(int32(4)).Return(nil)
+	out, err = run(cmdRmdir, "work/team", "-output", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"action": "deleted", "id": 4}`, out)
+
+	_, err = run(cmdGet, "x")
+	assert.Equal(t, ExitInvalid, exitCode(err))
+
+	out, err = run(cmdRemove, "7", "-output", "json")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"action": "removed", "id": 7}`, out)
+
+	_, err = parseCommandArgs(cmdList, []string{"-output", "xml"})
+	assert.Error(t, err)
+}
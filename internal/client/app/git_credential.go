@@ -0,0 +1,43 @@
+package app
+
+import (
+	"errors"
+	"os"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/config"
+	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+)
+
+// GitCredentialRun подкоманда git credential helper:
+// git-credential get|store|erase [ключи запуска клиента]
+// stdin и stdout заняты протоколом git, поэтому логин и пароль хранилища берутся из переменных окружения
+func GitCredentialRun(args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: git-credential get|store|erase [client flags]")
+	}
+	action := args[0]
+
+	// оставшиеся аргументы - обычные ключи запуска клиента (-c, -a, -k ...)
+	os.Args = append(os.Args[:1], args[1:]...)
+	cfg, err := config.NewClientConfig()
+	if err != nil {
+		return err
+	}
+
+	sender, _, err := newSender(cfg)
+	if err != nil {
+		return err
+	}
+
+	err = login(sender, vaultCredentials{}, false)
+	if err != nil {
+		return err
+	}
+
+	helper, err := domain.NewGitCredential(sender)
+	if err != nil {
+		return err
+	}
+
+	return helper.Run(action, os.Stdin, os.Stdout)
+}
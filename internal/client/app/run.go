@@ -0,0 +1,175 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/config"
+	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+)
+
+// runArgs разобранные аргументы подкоманды run
+type runArgs struct {
+	env      []string // переменные окружения в виде NAME=ссылка
+	template string   // файл шаблона с плейсхолдерами {{ secret "ссылка" }}
+	rest     []string // ключи запуска клиента
+	command  []string // запускаемая команда с аргументами
+}
+
+// ExecRun подкоманда запуска процесса с секретами из хранилища:
+// run [--env NAME=ссылка ...] [--template файл] [ключи запуска клиента] -- команда [аргументы]
+// Секреты передаются дочернему процессу в переменных окружения, отрисованный шаблон - в его stdin
+// (без команды шаблон выводится в stdout). На диск секреты не записываются.
+// Возвращает код завершения дочернего процесса.
+func ExecRun(args []string) (int, error) {
+	ra, err := parseRunArgs(args)
+	if err != nil {
+		return 1, err
+	}
+
+	// оставшиеся аргументы - обычные ключи запуска клиента (-c, -a, -k ...)
+	os.Args = append(os.Args[:1], ra.rest...)
+	cfg, err := config.NewClientConfig()
+	if err != nil {
+		return 1, err
+	}
+
+	sender, _, err := newSender(cfg)
+	if err != nil {
+		return 1, err
+	}
+
+	// логин и пароль запрашиваются до запуска дочернего процесса, поэтому консоль свободна
+	err = login(sender, vaultCredentials{}, true)
+	if err != nil {
+		return 1, err
+	}
+
+	resolver, err := domain.NewSecretResolver(sender)
+	if err != nil {
+		return 1, err
+	}
+
+	env := make([]string, 0, len(ra.env))
+	for _, val := range ra.env {
+		name, ref, _ := strings.Cut(val, "=")
+		secret, err := resolver.Resolve(ref)
+		if err != nil {
+			return 1, err
+		}
+		env = append(env, name+"="+secret)
+	}
+
+	var stdin io.Reader = os.Stdin
+	if ra.template != "" {
+		text, err := os.ReadFile(ra.template)
+		if err != nil {
+			return 1, err
+		}
+		rendered, err := resolver.RenderTemplate(ra.template, string(text))
+		if err != nil {
+			return 1, err
+		}
+		if len(ra.command) == 0 {
+			_, err = fmt.Fprint(os.Stdout, rendered)
+			return 0, err
+		}
+		stdin = strings.NewReader(rendered)
+	}
+
+	return runChild(ra.command, env, stdin)
+}
+
+// parseRunArgs разбор аргументов подкоманды run
+func parseRunArgs(args []string) (*runArgs, error) {
+	ra := &runArgs{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			ra.command = args[i+1:]
+			break
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if !strings.HasPrefix(arg, "-") || (name != "env" && name != "template") {
+			ra.rest = append(ra.rest, arg)
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("не указано значение ключа %v", arg)
+			}
+			i++
+			value = args[i]
+		}
+
+		switch name {
+		case "env":
+			varName, ref, ok := strings.Cut(value, "=")
+			if !ok || varName == "" || ref == "" {
+				return nil, fmt.Errorf("неверный формат %q, ожидается NAME=ссылка", value)
+			}
+			ra.env = append(ra.env, value)
+		case "template":
+			if ra.template != "" {
+				return nil, errors.New("шаблон можно указать только один")
+			}
+			ra.template = value
+		}
+	}
+
+	if len(ra.command) == 0 && (ra.template == "" || len(ra.env) > 0) {
+		return nil, errors.New("usage: run [--env NAME=ref ...] [--template file] [client flags] -- command [args]")
+	}
+
+	return ra, nil
+}
+
+// runChild запуск команды с дополнительными переменными окружения
+// Сигналы прерывания передаются дочернему процессу, возвращается его код завершения
+func runChild(command []string, env []string, stdin io.Reader) (int, error) {
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin = stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	err := cmd.Start()
+	if err != nil {
+		return 1, err
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer func() {
+		signal.Stop(signals)
+		close(signals)
+	}()
+	go func() {
+		for sig := range signals {
+			_ = cmd.Process.Signal(sig)
+		}
+	}()
+
+	err = cmd.Wait()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		// процесс завершен сигналом - код как в shell
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			return 128 + int(status.Signal()), nil
+		}
+		return exitErr.ExitCode(), nil
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRunArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want *runArgs
+		err  bool
+	}{
+		{
+			name: "env",
+			args: []string{"--env", "DB_PASSWORD=10/Пароль", "-env=TOKEN=logopas/github/Пароль", "-a", "localhost:9090", "--", "psql", "-h", "db"},
+			want: &runArgs{
+				env:     []string{"DB_PASSWORD=10/Пароль", "TOKEN=logopas/github/Пароль"},
+				rest:    []string{"-a", "localhost:9090"},
+				command: []string{"psql", "-h", "db"},
+			},
+		},
+		{
+			name: "template to stdout",
+			args: []string{"--template", "app.conf.tmpl"},
+			want: &runArgs{template: "app.conf.tmpl"},
+		},
+		{
+			name: "template to child",
+			args: []string{"--template=app.conf.tmpl", "--", "app", "--config", "/dev/stdin"},
+			want: &runArgs{template: "app.conf.tmpl", command: []string{"app", "--config", "/dev/stdin"}},
+		},
+		{name: "no command", args: []string{"--env", "A=1/Пароль"}, err: true},
+		{name: "nothing", args: []string{}, err: true},
+		{name: "bad env", args: []string{"--env", "A", "--", "env"}, err: true},
+		{name: "no value", args: []string{"--env"}, err: true},
+		{name: "two templates", args: []string{"--template", "a", "--template", "b"}, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseRunArgs(tt.args)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRunChild(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+
+	out := filepath.Join(t.TempDir(), "out")
+
+	// переменные окружения и stdin передаются дочернему процессу
+	code, err := runChild([]string{"sh", "-c", `printf "%s " "$GK_SECRET" > "$0"; cat >> "$0"`, out}, []string{"GK_SECRET=s3cret"}, strings.NewReader("rendered"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, code)
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret rendered", string(data))
+
+	// код завершения дочернего процесса
+	code, err = runChild([]string{"sh", "-c", "exit 3"}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, code)
+
+	code, err = runChild([]string{"sh", "-c", "kill -TERM $$"}, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 143, code)
+
+	_, err = runChild([]string{filepath.Join(t.TempDir(), "absent")}, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/config"
+	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+)
+
+// TUIRun полноэкранный интерфейс клиента: tui [ключи запуска клиента]
+// Логин и пароль хранилища берутся из переменных окружения или запрашиваются в консоли до запуска интерфейса
+func TUIRun(args []string) error {
+	// оставшиеся аргументы - обычные ключи запуска клиента (-c, -a, -k ...)
+	os.Args = append(os.Args[:1], args...)
+	cfg, err := config.NewClientConfig()
+	if err != nil {
+		return err
+	}
+
+	sender, _, err := newSender(cfg)
+	if err != nil {
+		return err
+	}
+
+	err = login(sender, vaultCredentials{}, true)
+	if err != nil {
+		return err
+	}
+
+	cmds, err := domain.NewCommands(sender)
+	if err != nil {
+		return err
+	}
+	cmds.Breaches, err = openBreaches(cfg)
+	if err != nil {
+		return err
+	}
+
+	_, err = tea.NewProgram(domain.NewTUI(cmds), tea.WithAltScreen()).Run()
+
+	return err
+}
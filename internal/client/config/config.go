@@ -17,6 +17,9 @@ type ClientConfig struct {
 	Env           string `yaml:"env"`           // окружение (local, dev, prod)
 	ServerAddress string `yaml:"serverAddress"` // адрес и порт сервера
 	SecretKey     string `yaml:"secretKey"`     // ключ шифрования передаваемых данных
+	AgentSocket   string `yaml:"agentSocket"`   // путь к unix сокету встроенного ssh-agent
+	Output        string `yaml:"output"`        // формат вывода сущностей и списков (table, json, yaml)
+	HIBPFile      string `yaml:"hibpFile"`      // локальная база утечек Have I Been Pwned: файл SHA-1 или папка файлов диапазонов
 }
 
 // NewClientConfig создание конфигурационной структуры
@@ -29,6 +32,9 @@ func NewClientConfig() (*ClientConfig, error) {
 	flag.StringVar(&flagCfg.Env, "e", "local", "environment (local, dev, prod)")
 	flag.StringVar(&flagCfg.ServerAddress, "a", "", "server address")
 	flag.StringVar(&flagCfg.SecretKey, "k", "", "secret key for encryption")
+	flag.StringVar(&flagCfg.AgentSocket, "agent-socket", "", "ssh-agent unix socket path")
+	flag.StringVar(&flagCfg.Output, "output", "", "output format (table, json, yaml)")
+	flag.StringVar(&flagCfg.HIBPFile, "hibp", "", "local Have I Been Pwned SHA-1 file or range directory")
 	flag.Parse()
 
 	if configFile != "" {
@@ -53,6 +59,15 @@ func NewClientConfig() (*ClientConfig, error) {
 	if cfg.SecretKey == "" {
 		cfg.SecretKey = flagCfg.SecretKey
 	}
+	if cfg.AgentSocket == "" {
+		cfg.AgentSocket = flagCfg.AgentSocket
+	}
+	if cfg.Output == "" {
+		cfg.Output = flagCfg.Output
+	}
+	if cfg.HIBPFile == "" {
+		cfg.HIBPFile = flagCfg.HIBPFile
+	}
 
 	return cfg, nil
 }
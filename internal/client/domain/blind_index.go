@@ -0,0 +1,148 @@
+// Тексты слепого индекса (blind index) для поиска на сервере по зашифрованной метаинформации
+package domain
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// префиксы текстов слепого индекса, разделяют слова и пары название=значение
+const (
+	blindWord = "w:" // слово из названия или значения метаинформации
+	blindPair = "m:" // пара название=значение целиком
+	blindTag  = "t:" // тег целиком
+	tagPrefix = "#"  // признак тега в запросе поиска
+)
+
+// FoundEntity сущность, найденная на сервере по слепому индексу
+type FoundEntity struct {
+	Id       int32       // ID сущности
+	Etype    string      // тип сущности: card, text, logopas, binary и т.д.
+	Metainfo []*Metainfo // массив значений метаинформации (расшифрованы)
+}
+
+// BlindIndexTerms тексты, от которых клиент вычисляет токены слепого индекса сущности:
+// каждое слово названий и значений метаинформации и каждая пара название=значение целиком.
+// Индексируются только метаданные, названия которых перечислены в метаданных search-index,
+// без такой отметки метаинформация в индекс не попадает.
+// Сами тексты на сервер не передаются, только HMAC от них.
+func BlindIndexTerms(metas []*Metainfo) []string {
+	searchable := searchableTitles(metas)
+
+	var terms []string
+	seen := make(map[string]bool)
+	for _, meta := range metas {
+		if !searchable[blindNormalize(meta.Title)] {
+			continue
+		}
+		for _, term := range blindMetaTerms(meta) {
+			if !seen[term] {
+				seen[term] = true
+				terms = append(terms, term)
+			}
+		}
+	}
+
+	return terms
+}
+
+// searchableTitles нормализованные названия метаданных, отмеченных для слепого индекса
+func searchableTitles(metas []*Metainfo) map[string]bool {
+	titles := make(map[string]bool)
+	for _, meta := range metas {
+		if blindNormalize(meta.Title) != constants.MetaSearchIndex {
+			continue
+		}
+		for _, title := range strings.Split(meta.Value, ",") {
+			if title = blindNormalize(title); title != "" {
+				titles[title] = true
+			}
+		}
+	}
+
+	return titles
+}
+
+// blindMetaTerms тексты слепого индекса одного значения метаинформации
+func blindMetaTerms(meta *Metainfo) []string {
+	title, value := blindNormalize(meta.Title), blindNormalize(meta.Value)
+	if title == "" && value == "" {
+		return nil
+	}
+
+	var terms []string
+	for _, word := range blindWords(title + " " + value) {
+		terms = append(terms, blindWord+word)
+	}
+
+	return append(terms, blindPair+title+"="+value)
+}
+
+// BlindTagTerms тексты слепого индекса тегов сущности
+func BlindTagTerms(tags []string) []string {
+	terms := make([]string, 0, len(tags))
+	for _, tag := range normalizeTags(tags) {
+		terms = append(terms, BlindTagTerm(tag))
+	}
+
+	return terms
+}
+
+// BlindTagTerm текст слепого индекса одного тега, по его токену сервер отбирает список сущностей
+func BlindTagTerm(tag string) string {
+	return blindTag + blindNormalize(strings.TrimPrefix(strings.TrimSpace(tag), tagPrefix))
+}
+
+// BlindQueryTerms тексты токенов запроса: слово ищется среди слов метаинформации,
+// название=значение - как пара целиком, #тег - среди тегов. Сущность должна содержать все тексты запроса.
+func BlindQueryTerms(query string) []string {
+	var terms []string
+	seen := make(map[string]bool)
+	for _, part := range strings.Fields(query) {
+		var parsed []string
+		if strings.HasPrefix(part, tagPrefix) && len(part) > len(tagPrefix) {
+			parsed = []string{BlindTagTerm(part)}
+		} else if title, value, ok := strings.Cut(part, "="); ok {
+			parsed = []string{blindPair + blindNormalize(title) + "=" + blindNormalize(value)}
+		} else {
+			for _, word := range blindWords(blindNormalize(part)) {
+				parsed = append(parsed, blindWord+word)
+			}
+		}
+		for _, term := range parsed {
+			if !seen[term] {
+				seen[term] = true
+				terms = append(terms, term)
+			}
+		}
+	}
+
+	return terms
+}
+
+// blindMatch метаинформация, давшая совпадение с текстом запроса
+func blindMatch(metas []*Metainfo, term string) string {
+	for _, meta := range metas {
+		for _, t := range blindMetaTerms(meta) {
+			if t == term {
+				return meta.Title + ": " + meta.Value
+			}
+		}
+	}
+
+	return ""
+}
+
+// blindNormalize приведение текста к единому виду: нижний регистр, без крайних пробелов
+func blindNormalize(text string) string {
+	return strings.ToLower(strings.TrimSpace(text))
+}
+
+// blindWords слова текста (последовательности букв и цифр)
+func blindWords(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func TestBlindIndexTerms(t *testing.T) {
+	terms := BlindIndexTerms([]*Metainfo{
+		{Title: "Сайт", Value: " GitHub.com "},
+		{Title: "env", Value: "prod"},
+		{Title: "", Value: ""},
+		{Title: "note", Value: "private words"},
+		{Title: constants.MetaSearchIndex, Value: " сайт, ENV "},
+	})
+	assert.Equal(t, []string{"w:сайт", "w:github", "w:com", "m:сайт=github.com", "w:env", "w:prod", "m:env=prod"}, terms)
+
+	// неотмеченная метаинформация токенов не дает
+	assert.Empty(t, BlindIndexTerms([]*Metainfo{
+		{Title: "Сайт", Value: "github.com"},
+		{Title: "env", Value: "prod"},
+	}))
+	assert.Empty(t, BlindIndexTerms([]*Metainfo{
+		{Title: "Сайт", Value: "github.com"},
+		{Title: constants.MetaSearchIndex, Value: "env"},
+	}))
+
+	// запрос сопоставляется с теми же текстами
+	assert.Equal(t, []string{"w:github", "m:env=prod"}, BlindQueryTerms("GitHub  Env=PROD github"))
+	assert.Empty(t, BlindQueryTerms(" ... "))
+	for _, term := range BlindQueryTerms("сайт github.com env=prod") {
+		assert.Contains(t, terms, term)
+	}
+
+	// теги индексируются целиком и ищутся запросом #тег
+	assert.Equal(t, []string{"t:work", "t:ci cd"}, BlindTagTerms([]string{" Work", "work", "CI CD"}))
+	assert.Equal(t, []string{"t:work", "w:github"}, BlindQueryTerms("#Work github"))
+}
+
+func TestSearchServer(t *testing.T) {
+	cmds, sender := newTestCommands(t)
+
+	sender.EXPECT().SearchEntities("prod", "").Return([]*FoundEntity{
+		{Id: 9, Etype: constants.LogopasEntity, Metainfo: []*Metainfo{{Title: "сайт", Value: "gitlab"}, {Title: "env", Value: "prod"}}},
+		{Id: 7, Etype: constants.LogopasEntity, Metainfo: []*Metainfo{{Title: "env", Value: "prod"}}},
+	}, nil)
+
+	results, err := cmds.SearchServer("prod", "", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, SearchResult{Id: 7, Etype: constants.LogopasEntity, Title: "env:prod.", Match: "env: prod", Score: 1}, results[0])
+	assert.Equal(t, int32(9), results[1].Id)
+
+	_, err = cmds.SearchServer("  ", "", 0)
+	assert.ErrorIs(t, err, ErrValidation)
+}
@@ -0,0 +1,103 @@
+// Проверка паролей сущностей logopas по локальной базе утечек Have I Been Pwned
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/hibp"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// AuditItem пароль сущности, найденный в базе утечек
+type AuditItem struct {
+	Id    int32  `json:"id" yaml:"id"`       // ID сущности
+	Title string `json:"title" yaml:"title"` // описание, составленное из метаданных
+	Field string `json:"field" yaml:"field"` // название поля пароля
+	Count int    `json:"count" yaml:"count"` // сколько раз пароль встречался в утечках
+}
+
+// Audit проверка паролей всех сущностей logopas по локальной базе утечек
+// Возвращает найденные в базе пароли в порядке возрастания ID сущности
+func (c *Commands) Audit() ([]AuditItem, error) {
+	if c.Breaches == nil {
+		return nil, fmt.Errorf("%w: не задана база утечек (ключ -hibp или параметр hibpFile файла конфигурации)", ErrValidation)
+	}
+
+	fields, err := c.fieldsOf(constants.LogopasEntity)
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.Sender.EntityList(constants.LogopasEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(list))
+	for id := range list {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var items []AuditItem
+	for _, id := range ids {
+		ent, err := c.entity(id)
+		if err != nil {
+			return nil, err
+		}
+		found, err := breachedFields(c.Breaches, ent, fields)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, found...)
+	}
+
+	return items, nil
+}
+
+// Breached пароли последней добавленной или измененной сущности, найденные в базе утечек,
+// и ошибка проверки, если базу прочитать не удалось (сохранению она не мешает)
+func (c *Commands) Breached() ([]AuditItem, error) {
+	return c.breached, c.breachErr
+}
+
+// checkBreaches проверка паролей сохраняемой сущности, результат доступен через Breached
+func (c *Commands) checkBreaches(ent *Entity, fields []*Field) {
+	c.breached, c.breachErr = nil, nil
+	if c.Breaches == nil {
+		return
+	}
+
+	c.breached, c.breachErr = breachedFields(c.Breaches, ent, fields)
+}
+
+// breachedFields пароли сущности, найденные в базе утечек
+func breachedFields(checker *hibp.Checker, ent *Entity, fields []*Field) ([]AuditItem, error) {
+	var items []AuditItem
+	for _, prop := range ent.Props {
+		field := fieldByID(fields, prop.FieldId)
+		if field == nil || !isBreachChecked(field) || prop.Value == "" {
+			continue
+		}
+
+		count, err := checker.Count(prop.Value)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			items = append(items, AuditItem{Id: ent.Id, Title: metaTitle(ent.Metainfo), Field: field.Name, Count: count})
+		}
+	}
+
+	return items, nil
+}
+
+// isBreachChecked поле проверяется по базе утечек: секретные поля сущностей logopas
+func isBreachChecked(field *Field) bool {
+	return field.Etype == constants.LogopasEntity && field.Ftype == constants.FieldTypeSecret
+}
+
+// BreachWarning предупреждение о пароле, найденном в базе утечек
+func BreachWarning(field string, count int) string {
+	return fmt.Sprintf("%v найден в базе утечек (встречался %v раз), замените его", field, count)
+}
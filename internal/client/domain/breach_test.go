@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/chzyer/readline"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/hibp"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// newTestBreaches база утечек с паролями password (10 раз) и qwerty (5 раз)
+func newTestBreaches(t *testing.T) *hibp.Checker {
+	lines := []string{hibp.Hash("password") + ":10\n", hibp.Hash("qwerty") + ":5\n"}
+	sort.Strings(lines)
+	file := filepath.Join(t.TempDir(), "pwned.txt")
+	require.NoError(t, os.WriteFile(file, []byte(lines[0]+lines[1]), 0600))
+
+	checker, err := hibp.Open(file)
+	require.NoError(t, err)
+
+	return checker
+}
+
+// newBreachCommands команды с подключенной базой утечек, пароль logopas - секретное поле
+func newBreachCommands(t *testing.T) (*Commands, *MockSender) {
+	cmds, sender := newTestCommands(t)
+	cmds.Breaches = newTestBreaches(t)
+
+	fields, err := cmds.fieldsOf(constants.LogopasEntity)
+	require.NoError(t, err)
+	fields[1].Ftype = constants.FieldTypeSecret
+
+	return cmds, sender
+}
+
+func TestCommandsAudit(t *testing.T) {
+	cmds, sender := newBreachCommands(t)
+
+	sender.EXPECT().EntityList(constants.LogopasEntity).Return(map[int32]string{3: "", 1: "", 2: ""}, nil)
+	sender.EXPECT().Entity(int32(1)).Return(logopas(1, "alice", "qwerty", &Metainfo{Title: "сайт", Value: "github"}), nil)
+	sender.EXPECT().Entity(int32(2)).Return(logopas(2, "bob", "Xk9#mQ2!vL"), nil)
+	sender.EXPECT().Entity(int32(3)).Return(logopas(3, "carol", "password"), nil)
+
+	items, err := cmds.Audit()
+	require.NoError(t, err)
+	assert.Equal(t, []AuditItem{
+		{Id: 1, Title: metaTitle([]*Metainfo{{Title: "сайт", Value: "github"}}), Field: "Пароль", Count: 5},
+		{Id: 3, Title: metaTitle(nil), Field: "Пароль", Count: 10},
+	}, items)
+
+	// без базы утечек проверка не выполняется
+	cmds.Breaches = nil
+	_, err = cmds.Audit()
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestCommandsBreached(t *testing.T) {
+	cmds, sender := newBreachCommands(t)
+
+	sender.EXPECT().AddEntity(gomock.Any()).Return(int32(7), nil).Times(2)
+	_, err := cmds.Add(constants.LogopasEntity, map[string]string{"Логин": "alice", "Пароль": "password"}, nil, Placement{})
+	require.NoError(t, err)
+	items, err := cmds.Breached()
+	require.NoError(t, err)
+	assert.Equal(t, []AuditItem{{Id: 7, Title: metaTitle(nil), Field: "Пароль", Count: 10}}, items)
+
+	_, err = cmds.Add(constants.LogopasEntity, map[string]string{"Логин": "alice", "Пароль": "Xk9#mQ2!vL"}, nil, Placement{})
+	require.NoError(t, err)
+	items, err = cmds.Breached()
+	require.NoError(t, err)
+	assert.Empty(t, items)
+
+	sender.EXPECT().Entity(int32(7)).Return(logopas(7, "alice", "Xk9#mQ2!vL"), nil)
+	sender.EXPECT().SaveEntity(gomock.Any()).Return(int32(7), nil)
+	require.NoError(t, cmds.Edit(7, map[string]string{"Пароль": "qwerty"}, nil, Placement{}))
+	items, err = cmds.Breached()
+	require.NoError(t, err)
+	assert.Equal(t, []AuditItem{{Id: 7, Title: metaTitle(nil), Field: "Пароль", Count: 5}}, items)
+}
+
+func TestAcceptBreached(t *testing.T) {
+	r, w := io.Pipe()
+	rl, err := NewCLIReadline(&readline.Config{
+		Prompt:          "\033[31m»\033[0m ",
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+		Stdin:           r,
+	})
+	require.NoError(t, err)
+	field := &Field{Name: "Пароль", Etype: constants.LogopasEntity, Ftype: constants.FieldTypeSecret}
+
+	// без базы утечек и для паролей, которых нет в базе, подтверждение не запрашивается
+	assert.True(t, rl.acceptBreached(field, "password", ""))
+	rl.Breaches = newTestBreaches(t)
+	assert.True(t, rl.acceptBreached(field, "Xk9#mQ2!vL", ""))
+	assert.True(t, rl.acceptBreached(field, "password", "password"))
+	assert.True(t, rl.acceptBreached(&Field{Etype: constants.CardEntity, Ftype: constants.FieldTypeSecret}, "password", ""))
+
+	for answer, accepted := range map[string]bool{"n\n": false, "Y\n": true} {
+		var ok bool
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			ok = rl.acceptBreached(field, "password", "")
+		}()
+		sleep()
+		w.Write([]byte(answer))
+		<-done
+		assert.Equal(t, accepted, ok, answer)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/dnsoftware/gophkeeper/internal/constants"
 )
@@ -16,6 +18,8 @@ type Readline interface {
 	input(prompt string, validateRules string, validateMessages string) (string, error)
 	// edit редактирование строки данных в консоли
 	edit(prompt string, what string, validateRules string, validateMessages string) (string, error)
+	// inputField ввод значения поля сущности с учетом типа поля, current - прежнее значение при редактировании
+	inputField(field *Field, current string) (string, error)
 	// Writeln вывод в консоль с переводом строки
 	Writeln(str string)
 	// Registration регистрация
@@ -49,12 +53,24 @@ type Sender interface {
 	EntityCodes() ([]*EntityCode, error)
 	// Fields получение описаний полей сущностей
 	Fields(etype string) ([]*Field, error)
+	// CreateEntityType создание пользовательского типа сущности, порядок полей в форме - порядок в fields
+	CreateEntityType(name string, fields []*Field) (string, error)
+	// UpdateEntityType изменение пользовательского типа: поля с Id изменяются, без Id - добавляются
+	UpdateEntityType(etype string, name string, fields []*Field) error
+	// ArchiveEntityType перемещение пользовательского типа в архив или возврат из архива
+	ArchiveEntityType(etype string, archived bool) error
 	// AddEntity добавление сущности
 	AddEntity(ae Entity) (int32, error)
 	// SaveEntity сохранение сущности
 	SaveEntity(ae Entity) (int32, error)
-	// DeleteEntity удаление сущности
+	// DeleteEntity удаление сущности (перемещение в корзину)
 	DeleteEntity(id int32) error
+	// ListTrash получение содержимого корзины
+	ListTrash() ([]*TrashItem, error)
+	// RestoreEntity восстановление сущности из корзины
+	RestoreEntity(id int32) error
+	// PurgeEntity окончательное удаление сущности из корзины
+	PurgeEntity(id int32) error
 	// UploadBinary загрузка незашифрованных бинарных данных (клиент -> сервер)
 	UploadBinary(entityId int32, file string) (int32, error)
 	// DownloadBinary отдача незашифрованных бинарных данных клиенту (сервер -> клиент)
@@ -63,20 +79,61 @@ type Sender interface {
 	UploadCryptoBinary(entityId int32, file string) (int32, error)
 	// DownloadCryptoBinary отдача зашифрованных бинарных данных клиенту (сервер -> клиент)
 	DownloadCryptoBinary(entityId int32, fileName string) (string, error)
+	// AddAttachment загрузка файла вложением к сущности, имя файла, контрольная сумма и содержимое шифруются
+	AddAttachment(entityId int32, file string) (*Attachment, error)
+	// ListAttachments вложения сущности (имена и контрольные суммы расшифрованы)
+	ListAttachments(entityId int32) ([]*Attachment, error)
+	// DownloadAttachment скачивание вложения с проверкой контрольной суммы, возвращает путь к сохраненному файлу
+	DownloadAttachment(att *Attachment) (string, error)
+	// DeleteAttachment удаление вложения
+	DeleteAttachment(id int32) error
 	// EntityList Получение списка сущностей указанного типа для конкретного пользователя
 	// Простая карта с кодом сущности и названием(составляется из метаданных)
 	EntityList(etype string) (map[int32]string, error)
-	// Entity получение сущности
+	// EntityListFiltered список сущностей с отбором по папке и тегу, пустой etype - все типы
+	EntityListFiltered(etype string, filter ListFilter) (map[int32]string, error)
+	// EntitySummaries список сущностей с отметками избранного и времени получения в порядке сервера
+	// При отборе Recent - от последней открытой сущности
+	EntitySummaries(etype string, filter ListFilter) ([]*EntitySummary, error)
+	// SetFavorite добавление сущности в избранное или удаление из него
+	SetFavorite(id int32, favorite bool) error
+	// SearchEntities поиск сущностей на сервере по токенам слепого индекса, пустой etype - все типы
+	// Все слова и пары название=значение запроса должны присутствовать в метаинформации сущности
+	SearchEntities(query string, etype string) ([]*FoundEntity, error)
+	// Entity получение сущности (индекс поиска, агент, подстановка секретов и т.п.), список последних открытых не меняется
 	Entity(id int32) (*Entity, error)
+	// OpenEntity получение сущности, открытой пользователем для просмотра: она попадает в список последних открытых
+	OpenEntity(id int32) (*Entity, error)
+	// Folders получение всех папок пользователя (названия расшифрованы)
+	Folders() ([]*Folder, error)
+	// CreateFolder создание папки, parentId = 0 - папка верхнего уровня
+	CreateFolder(parentId int32, name string) (int32, error)
+	// UpdateFolder переименование и перемещение папки
+	UpdateFolder(folder Folder) error
+	// DeleteFolder удаление папки, содержимое переносится в родительскую папку
+	DeleteFolder(id int32) error
 }
 
 // Entity сущность
 type Entity struct {
-	Id       int32       // ID сущности
-	UserID   int32       // ID пользователя
-	Etype    string      // тип сущности: card, text, logopas, binary и т.д.
-	Props    []*Property // массив значений свойств
-	Metainfo []*Metainfo // массив значений метаинформации
+	Id         int32       // ID сущности
+	UserID     int32       // ID пользователя
+	Etype      string      // тип сущности: card, text, logopas, binary и т.д.
+	Props      []*Property // массив значений свойств
+	Metainfo   []*Metainfo // массив значений метаинформации
+	FolderId   int32       // ID папки, 0 - вне папок
+	Tags       []string    // теги
+	Favorite   bool        // сущность в избранном
+	AccessedAt time.Time   // время предыдущего получения, нулевое - не открывалась
+}
+
+// EntitySummary элемент списка сущностей с отметками
+type EntitySummary struct {
+	Id         int32     // ID сущности
+	Etype      string    // тип сущности: card, text, logopas, binary и т.д.
+	Title      string    // описание, составленное из метаданных
+	Favorite   bool      // сущность в избранном
+	AccessedAt time.Time // время последнего получения, нулевое - не открывалась
 }
 
 // Property свойство сущности
@@ -93,26 +150,51 @@ type Metainfo struct {
 	Value    string // значение метаинформации
 }
 
+// TrashItem сущность, находящаяся в корзине
+type TrashItem struct {
+	Id        int32       // ID сущности
+	Etype     string      // тип сущности: card, text, logopas, binary и т.д.
+	Metainfo  []*Metainfo // массив значений метаинформации
+	DeletedAt time.Time   // время перемещения в корзину
+}
+
+// Attachment вложение сущности
+type Attachment struct {
+	Id        int32     // ID вложения
+	EntityId  int32     // ID сущности
+	Name      string    // имя файла
+	Size      int64     // размер файла в байтах
+	Digest    string    // SHA-256 содержимого файла (hex)
+	CreatedAt time.Time // время добавления
+}
+
 // EntityCode название типа сущности
 type EntityCode struct {
-	Etype string // код типа сущности
-	Name  string // название типа сущности
+	Etype    string // код типа сущности
+	Name     string // название типа сущности
+	Custom   bool   // тип создан пользователем
+	Archived bool   // тип в архиве: новые сущности не добавляются
 }
 
 // Field описание поля сущности
 type Field struct {
-	Id               int32  // ID поля
-	Name             string // название поля
-	Etype            string // код типа сущности
-	Ftype            string // тип поля
-	ValidateRules    string // правила валидации при вводе поля
-	ValidateMessages string // сообщения валидации при ее непрохождении
+	Id               int32    // ID поля
+	Name             string   // название поля
+	Etype            string   // код типа сущности
+	Ftype            string   // тип поля
+	ValidateRules    string   // правила валидации при вводе поля
+	ValidateMessages string   // сообщения валидации при ее непрохождении
+	Secret           bool     // значение скрывается при просмотре и не индексируется для поиска
+	Position         int32    // порядок поля в форме
+	Options          []string // варианты значения поля типа select
 }
 
 // GophKeepClient клиент, управляет вводом данных в консоли и отправкой/получением данных с/на сервер
 type GophKeepClient struct {
-	rl     Readline // работа в консоли
-	Sender Sender   // отправка-получение данных на/с сервера
+	rl        Readline     // работа в консоли
+	Sender    Sender       // отправка-получение данных на/с сервера
+	Presenter *Presenter   // вывод сущностей и списков в выбранном формате
+	index     *SearchIndex // индекс поиска по объектам всех типов
 }
 
 // BinaryFileProperty Данные в поле свойства бинарной сущности содержат JSON в формате:
@@ -131,9 +213,14 @@ const (
 // NewGophKeepClient конструктор
 func NewGophKeepClient(readline Readline, sender Sender) (*GophKeepClient, error) {
 
+	presenter, _ := NewPresenter(OutputTable, os.Stdout)
+	index := NewSearchIndex(sender)
+
 	client := &GophKeepClient{
-		rl:     readline,
-		Sender: sender,
+		rl:        readline,
+		Sender:    index.Tracking(sender),
+		Presenter: presenter,
+		index:     index,
 	}
 
 	return client, nil
@@ -176,41 +263,14 @@ func (c *GophKeepClient) Start(stopChan chan bool) error {
 	// Если уже ранее регистрировались - запрашиваем логин-пароль
 	// без аутентификации дальнейшая работа невозможна
 	if token == "" {
-		for {
-			login, password, err := c.rl.Login()
-			if err != nil {
-				return err
-			}
-
-			token, err = c.Sender.Login(login, password)
-			if err != nil {
-				fmt.Println(err.Error())
-				continue
-			}
-
-			break
-		}
-	}
-
-	// Инициализация списка сущностей, с которыми можно работать
-	entCodes, err := c.Sender.EntityCodes()
-
-	if err != nil {
-		fmt.Printf("Ошибка загрузки сущностей: %v\n", err)
-	}
-	for _, val := range entCodes {
-		c.rl.SetEtypeName(val.Etype, val.Name)
-	}
-
-	// Инициализация описаний полей сущностей
-	for _, val := range entCodes {
-		fields, err := c.Sender.Fields(val.Etype)
+		err := c.login()
 		if err != nil {
-			fmt.Printf("Ошибка загрузки полей с описаниями: %v\n", err)
+			return err
 		}
-		c.rl.MakeFieldsDescription(fields)
 	}
 
+	entCodes := c.initDictionaries()
+
 	/************** Основная логика ************/
 	go func() {
 		for {
@@ -238,28 +298,95 @@ func (c *GophKeepClient) Start(stopChan chan bool) error {
 	return nil
 }
 
+// login запрос логина и пароля до успешной аутентификации
+func (c *GophKeepClient) login() error {
+	for {
+		login, password, err := c.rl.Login()
+		if err != nil {
+			return err
+		}
+
+		_, err = c.Sender.Login(login, password)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			continue
+		}
+
+		return nil
+	}
+}
+
+// initDictionaries загрузка справочников типов сущностей и описаний их полей
+func (c *GophKeepClient) initDictionaries() []*EntityCode {
+	// Инициализация списка сущностей, с которыми можно работать
+	entCodes, err := c.Sender.EntityCodes()
+
+	if err != nil {
+		fmt.Printf("Ошибка загрузки сущностей: %v\n", err)
+	}
+	for _, val := range entCodes {
+		c.rl.SetEtypeName(val.Etype, val.Name)
+	}
+
+	// Инициализация описаний полей сущностей
+	for _, val := range entCodes {
+		fields, err := c.Sender.Fields(val.Etype)
+		if err != nil {
+			fmt.Printf("Ошибка загрузки полей с описаниями: %v\n", err)
+		}
+		c.rl.MakeFieldsDescription(fields)
+	}
+
+	return entCodes
+}
+
 // DisplayEntity отобразить сущность в консоли
 func (c *GophKeepClient) DisplayEntity(ent Entity) {
-	fmt.Println("------------------------")
-	fmt.Println(" " + c.rl.GetEtypeName(ent.Etype))
+	view := c.entityView(ent)
 	for _, val := range ent.Props {
-		fmt.Println("      " + c.rl.GetField(val.FieldId).Name + ": " + val.Value)
+		field := c.rl.GetField(val.FieldId)
+		view.Fields = append(view.Fields, FieldValue{Name: field.Name, Value: c.displayValue(ent, val, field)})
 	}
-	for _, val := range ent.Metainfo {
-		fmt.Println("      " + val.Title + ": " + val.Value)
+
+	err := c.Presenter.Entity(view)
+	if err != nil {
+		fmt.Println(err.Error())
 	}
-	fmt.Println("------------------------")
 }
 
 // DisplayEntityBinary отобразить сущность в консоли и показать путь к загруженному файлу
 func (c *GophKeepClient) DisplayEntityBinary(ent Entity, filePath string) {
-	fmt.Println("------------------------")
-	fmt.Println(" " + c.rl.GetEtypeName(ent.Etype))
-	fmt.Println("Путь к загруженному файлу: " + filePath)
+	view := c.entityView(ent)
+	view.Fields = []FieldValue{{Name: "Путь к загруженному файлу", Value: filePath}}
+
+	err := c.Presenter.Entity(view)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+}
+
+// entityView сущность для вывода: тип и метаданные, поля заполняет вызывающий
+func (c *GophKeepClient) entityView(ent Entity) *EntityView {
+	view := &EntityView{Id: ent.Id, Etype: ent.Etype, TypeName: c.rl.GetEtypeName(ent.Etype)}
 	for _, val := range ent.Metainfo {
-		fmt.Println("      " + val.Title + ": " + val.Value)
+		view.Metainfo = append(view.Metainfo, FieldValue{Name: val.Title, Value: val.Value})
+	}
+
+	return view
+}
+
+// menuItems пункты меню выбора из списка сущностей, пронумерованные в порядке возрастания ID
+func menuItems(list map[int32]string) []MenuItem {
+	items := make([]MenuItem, 0, len(list))
+	for id, title := range list {
+		items = append(items, MenuItem{Id: id, Title: title})
 	}
-	fmt.Println("------------------------")
+	sort.Slice(items, func(i, j int) bool { return items[i].Id < items[j].Id })
+	for i := range items {
+		items[i].Index = i + 1
+	}
+
+	return items
 }
 
 // FilestorageDir получение директории для хранения файлов, полученных с сервера
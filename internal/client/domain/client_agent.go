@@ -0,0 +1,339 @@
+// Встроенный ssh-agent, выдающий SSH ключи из хранилища без записи их на диск
+package domain
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+var (
+	errAgentReadOnly = errors.New("ключи агента берутся из хранилища, изменение набора ключей не поддерживается")
+	errAgentLocked   = errors.New("агент заблокирован")
+	errAgentNoKey    = errors.New("ключ не найден")
+	errAgentDenied   = errors.New("подпись отклонена пользователем")
+)
+
+// agentKey SSH ключ, выдаваемый агентом
+type agentKey struct {
+	entityID int32      // ID сущности в хранилище
+	signer   ssh.Signer // расшифрованный приватный ключ
+	comment  string     // комментарий ключа
+	confirm  bool       // запрашивать подтверждение перед каждой подписью
+}
+
+// confirmFunc запрос подтверждения подписи данных ключом
+type confirmFunc func(key *agentKey) bool
+
+// vaultAgent реализация протокола ssh-agent поверх ключей из хранилища
+// Набор ключей только для чтения: ssh-add может лишь заблокировать и разблокировать агента
+type vaultAgent struct {
+	mu         sync.Mutex
+	keys       []*agentKey
+	confirm    confirmFunc
+	locked     bool
+	passphrase []byte
+}
+
+// newVaultAgent конструктор
+func newVaultAgent(keys []*agentKey, confirm confirmFunc) *vaultAgent {
+	return &vaultAgent{
+		keys:    keys,
+		confirm: confirm,
+	}
+}
+
+// List список публичных ключей агента
+func (a *vaultAgent) List() ([]*agent.Key, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.locked {
+		return nil, nil
+	}
+
+	list := make([]*agent.Key, 0, len(a.keys))
+	for _, key := range a.keys {
+		pub := key.signer.PublicKey()
+		list = append(list, &agent.Key{
+			Format:  pub.Type(),
+			Blob:    pub.Marshal(),
+			Comment: key.comment,
+		})
+	}
+
+	return list, nil
+}
+
+// Sign подпись данных ключом
+func (a *vaultAgent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(key, data, 0)
+}
+
+// SignWithFlags подпись данных ключом с учетом запрошенного алгоритма RSA подписи
+// Блокировка держится и на время запроса подтверждения, чтобы запросы не перемешивались в консоли
+func (a *vaultAgent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.locked {
+		return nil, errAgentLocked
+	}
+
+	wanted := key.Marshal()
+	for _, k := range a.keys {
+		if !bytes.Equal(k.signer.PublicKey().Marshal(), wanted) {
+			continue
+		}
+
+		if k.confirm && (a.confirm == nil || !a.confirm(k)) {
+			return nil, errAgentDenied
+		}
+
+		algorithm := ""
+		switch {
+		case flags&agent.SignatureFlagRsaSha256 != 0:
+			algorithm = ssh.KeyAlgoRSASHA256
+		case flags&agent.SignatureFlagRsaSha512 != 0:
+			algorithm = ssh.KeyAlgoRSASHA512
+		}
+
+		if algorithm == "" {
+			return k.signer.Sign(nil, data)
+		}
+
+		algSigner, ok := k.signer.(ssh.AlgorithmSigner)
+		if !ok {
+			return nil, fmt.Errorf("ключ %v не поддерживает алгоритм подписи %v", k.comment, algorithm)
+		}
+
+		return algSigner.SignWithAlgorithm(nil, data, algorithm)
+	}
+
+	return nil, errAgentNoKey
+}
+
+// Add добавление ключа (не поддерживается)
+func (a *vaultAgent) Add(key agent.AddedKey) error {
+	return errAgentReadOnly
+}
+
+// Remove удаление ключа (не поддерживается)
+func (a *vaultAgent) Remove(key ssh.PublicKey) error {
+	return errAgentReadOnly
+}
+
+// RemoveAll удаление всех ключей (не поддерживается)
+func (a *vaultAgent) RemoveAll() error {
+	return errAgentReadOnly
+}
+
+// Lock блокировка агента паролем
+func (a *vaultAgent) Lock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.locked {
+		return errAgentLocked
+	}
+	a.locked = true
+	a.passphrase = passphrase
+
+	return nil
+}
+
+// Unlock разблокировка агента
+func (a *vaultAgent) Unlock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.locked {
+		return errors.New("агент не заблокирован")
+	}
+	if subtle.ConstantTimeCompare(passphrase, a.passphrase) != 1 {
+		return errors.New("неверный пароль")
+	}
+	a.locked = false
+	a.passphrase = nil
+
+	return nil
+}
+
+// Signers список ключей для подписи (внутри процесса не используется)
+func (a *vaultAgent) Signers() ([]ssh.Signer, error) {
+	return nil, errAgentReadOnly
+}
+
+// Extension расширения протокола не поддерживаются
+func (a *vaultAgent) Extension(extensionType string, contents []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
+
+// metaEnabled признак, включенный в метаданных сущности значением yes/true/1/да
+func metaEnabled(metas []*Metainfo, title string) bool {
+	for _, meta := range metas {
+		if !strings.EqualFold(strings.TrimSpace(meta.Title), title) {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(meta.Value)) {
+		case "yes", "y", "true", "1", "да":
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadAgentKeys загрузка из хранилища SSH ключей, помеченных для использования агентом
+// Ключи, которые не удалось расшифровать, пропускаются с сообщением в консоль
+func (c *GophKeepClient) loadAgentKeys() ([]*agentKey, error) {
+	list, err := c.Sender.EntityList(constants.SSHKeyEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*agentKey
+	for id := range list {
+		ent, err := c.Sender.Entity(id)
+		if err != nil {
+			return nil, err
+		}
+		if !metaEnabled(ent.Metainfo, constants.MetaSSHAgent) {
+			continue
+		}
+
+		signer, err := parseSSHKey(propValueByFtype(ent.Props, constants.FieldTypeSSHKey, c.rl.GetField), propValueByFtype(ent.Props, constants.FieldTypeSSHPassphrase, c.rl.GetField))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Ключ #%v пропущен: %v\n", id, err)
+			continue
+		}
+
+		comment := propValueByFtype(ent.Props, constants.FieldTypeSSHComment, c.rl.GetField)
+		if comment == "" {
+			comment = fmt.Sprintf("gophkeeper #%v", id)
+		}
+
+		keys = append(keys, &agentKey{
+			entityID: id,
+			signer:   signer,
+			comment:  comment,
+			confirm:  metaEnabled(ent.Metainfo, constants.MetaSSHAgentConfirm),
+		})
+	}
+
+	return keys, nil
+}
+
+// confirmSign запрос подтверждения подписи в консоли
+func (c *GophKeepClient) confirmSign(key *agentKey) bool {
+	fmt.Fprintf(os.Stderr, "\nЗапрошена подпись ключом %v (%v)\n", key.comment, ssh.FingerprintSHA256(key.signer.PublicKey()))
+	answer, err := c.rl.input("Разрешить (Y or N)>>", "required", `{"required": "Неверный выбор"}`)
+	if err != nil {
+		return false
+	}
+
+	return strings.ToLower(answer) == "y"
+}
+
+// SSHAgent аутентификация в хранилище и обслуживание протокола ssh-agent на unix сокете
+// Работает в текущем процессе до закрытия канала stop, после чего сокет удаляется.
+// В stdout выводится только строка SSH_AUTH_SOCK, сообщения и запросы подтверждения - в stderr
+func (c *GophKeepClient) SSHAgent(socketPath string, stop <-chan struct{}) error {
+	err := c.login()
+	if err != nil {
+		return err
+	}
+
+	fields, err := c.Sender.Fields(constants.SSHKeyEntity)
+	if err != nil {
+		return err
+	}
+	c.rl.MakeFieldsDescription(fields)
+
+	keys, err := c.loadAgentKeys()
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		fmt.Fprintf(os.Stderr, "Нет SSH ключей с метаданными %v: yes\n", constants.MetaSSHAgent)
+	}
+
+	listener, err := listenUnixPrivate(socketPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(socketPath)
+
+	go func() {
+		<-stop
+		listener.Close()
+	}()
+
+	fmt.Fprintf(os.Stderr, "Агент запущен, ключей: %v\n", len(keys))
+	fmt.Printf("SSH_AUTH_SOCK=%v; export SSH_AUTH_SOCK;\n", socketPath)
+
+	return serveAgent(listener, newVaultAgent(keys, c.confirmSign))
+}
+
+// listenUnixPrivate unix сокет, доступный только владельцу
+// Сокет создается в новой папке с правами 0700, получает права 0600 и только затем переносится на путь socketPath,
+// поэтому другие пользователи не могут подключиться к нему до смены прав
+func listenUnixPrivate(socketPath string) (net.Listener, error) {
+	if _, err := os.Lstat(socketPath); err == nil {
+		return nil, fmt.Errorf("файл %v уже существует", socketPath)
+	}
+
+	dir, err := os.MkdirTemp(filepath.Dir(socketPath), ".gophkeeper-agent-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, "agent.sock")
+	listener, err := net.Listen("unix", tmpPath)
+	if err != nil {
+		return nil, err
+	}
+	// после переноса по временному пути сокета нет, удаляет его вызывающий
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+
+	err = os.Chmod(tmpPath, 0600)
+	if err == nil {
+		err = os.Rename(tmpPath, socketPath)
+	}
+	if err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// serveAgent прием соединений и обслуживание протокола ssh-agent до закрытия listener
+func serveAgent(listener net.Listener, a agent.Agent) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		go func() {
+			defer conn.Close()
+			_ = agent.ServeAgent(a, conn)
+		}()
+	}
+}
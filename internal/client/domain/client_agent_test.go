@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// newAgentClient клиент агента, подключенный к vaultAgent через unix сокет
+func newAgentClient(t *testing.T, a agent.Agent) agent.ExtendedAgent {
+	socketPath := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := listenUnixPrivate(socketPath)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- serveAgent(listener, a)
+	}()
+	t.Cleanup(func() {
+		listener.Close()
+		assert.NoError(t, <-done)
+	})
+
+	conn, err := net.Dial("unix", socketPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return agent.NewClient(conn)
+}
+
+func TestListenUnixPrivate(t *testing.T) {
+	dir := t.TempDir()
+	socketPath := filepath.Join(dir, "agent.sock")
+	listener, err := listenUnixPrivate(socketPath)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	assert.NotZero(t, info.Mode()&os.ModeSocket)
+
+	// временная папка удалена, в папке остался только сокет
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	conn, err := net.Dial("unix", socketPath)
+	require.NoError(t, err)
+	conn.Close()
+
+	// существующий файл не перезаписывается
+	_, err = listenUnixPrivate(socketPath)
+	assert.Error(t, err)
+}
+
+func TestVaultAgent(t *testing.T) {
+	plain, plainPub := newTestSSHKey(t, "")
+	guarded, guardedPub := newTestSSHKey(t, "")
+	plainSigner, err := ssh.ParsePrivateKey([]byte(plain))
+	require.NoError(t, err)
+	guardedSigner, err := ssh.ParsePrivateKey([]byte(guarded))
+	require.NoError(t, err)
+
+	allow := false
+	confirmed := 0
+	keys := []*agentKey{
+		{entityID: 1, signer: plainSigner, comment: "plain"},
+		{entityID: 2, signer: guardedSigner, comment: "guarded", confirm: true},
+	}
+	client := newAgentClient(t, newVaultAgent(keys, func(key *agentKey) bool {
+		confirmed++
+		return allow
+	}))
+
+	list, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "plain", list[0].Comment)
+	assert.Equal(t, plainPub.Marshal(), list[0].Blob)
+
+	data := []byte("данные для подписи")
+
+	// ключ без подтверждения подписывает сразу
+	sig, err := client.Sign(plainPub, data)
+	require.NoError(t, err)
+	assert.NoError(t, plainPub.Verify(data, sig))
+	assert.Equal(t, 0, confirmed)
+
+	// ключ с подтверждением: отказ и согласие пользователя
+	_, err = client.Sign(guardedPub, data)
+	assert.Error(t, err)
+	allow = true
+	sig, err = client.Sign(guardedPub, data)
+	require.NoError(t, err)
+	assert.NoError(t, guardedPub.Verify(data, sig))
+	assert.Equal(t, 2, confirmed)
+
+	// неизвестный ключ
+	_, otherPub := newTestSSHKey(t, "")
+	_, err = client.Sign(otherPub, data)
+	assert.Error(t, err)
+
+	// набор ключей только для чтения
+	assert.Error(t, client.RemoveAll())
+	list, err = client.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	// блокировка
+	require.NoError(t, client.Lock([]byte("pass")))
+	list, err = client.List()
+	require.NoError(t, err)
+	assert.Len(t, list, 0)
+	_, err = client.Sign(plainPub, data)
+	assert.Error(t, err)
+	assert.Error(t, client.Unlock([]byte("wrong")))
+	require.NoError(t, client.Unlock([]byte("pass")))
+	_, err = client.Sign(plainPub, data)
+	assert.NoError(t, err)
+}
+
+func TestLoadAgentKeys(t *testing.T) {
+	client := newSSHKeyClient(t)
+	sender := NewMockSender(gomock.NewController(t))
+	client.Sender = sender
+
+	plain, plainPub := newTestSSHKey(t, "")
+	encrypted, encPub := newTestSSHKey(t, "secret")
+
+	sender.EXPECT().EntityList(constants.SSHKeyEntity).Return(map[int32]string{1: "", 2: "", 3: "", 4: ""}, nil)
+	entities := map[int32]*Entity{
+		// доступен агенту, подпись с подтверждением
+		1: {Id: 1, Etype: constants.SSHKeyEntity, Props: sshKeyProps(plain, "", "", "work"), Metainfo: []*Metainfo{
+			{Title: constants.MetaSSHAgent, Value: "yes"},
+			{Title: constants.MetaSSHAgentConfirm, Value: "yes"},
+		}},
+		// зашифрованный ключ доступен агенту
+		2: {Id: 2, Etype: constants.SSHKeyEntity, Props: sshKeyProps(encrypted, "secret", "", ""), Metainfo: []*Metainfo{
+			{Title: constants.MetaSSHAgent, Value: "true"},
+		}},
+		// не помечен для агента
+		3: {Id: 3, Etype: constants.SSHKeyEntity, Props: sshKeyProps(plain, "", "", ""), Metainfo: []*Metainfo{
+			{Title: constants.MetaSSHAgent, Value: "no"},
+		}},
+		// неверный пароль ключа - пропускается
+		4: {Id: 4, Etype: constants.SSHKeyEntity, Props: sshKeyProps(encrypted, "wrong", "", ""), Metainfo: []*Metainfo{
+			{Title: constants.MetaSSHAgent, Value: "yes"},
+		}},
+	}
+	sender.EXPECT().Entity(gomock.Any()).DoAndReturn(func(id int32) (*Entity, error) {
+		return entities[id], nil
+	}).Times(4)
+
+	keys, err := client.loadAgentKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	byID := make(map[int32]*agentKey)
+	for _, key := range keys {
+		byID[key.entityID] = key
+	}
+	require.Contains(t, byID, int32(1))
+	require.Contains(t, byID, int32(2))
+	assert.Equal(t, "work", byID[1].comment)
+	assert.True(t, byID[1].confirm)
+	assert.Equal(t, plainPub.Marshal(), byID[1].signer.PublicKey().Marshal())
+	assert.Equal(t, "gophkeeper #2", byID[2].comment)
+	assert.False(t, byID[2].confirm)
+	assert.Equal(t, encPub.Marshal(), byID[2].signer.PublicKey().Marshal())
+}
@@ -0,0 +1,131 @@
+// Работа с вложениями сущности в консоли (просмотр, добавление, скачивание и удаление)
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Attachments работа с вложениями сущности в консоли
+// Возвращается в меню действий с вложениями до выбора "Назад"
+func (c *GophKeepClient) Attachments(entityID int32) (string, error) {
+	for {
+		list, err := c.Sender.ListAttachments(entityID)
+		if err != nil {
+			return WorkAgain, err
+		}
+
+		fmt.Println("")
+		if len(list) == 0 {
+			fmt.Println("Вложений нет")
+		} else {
+			fmt.Println("Вложения:")
+			for i, att := range list {
+				fmt.Printf("[%v] %v\n", i+1, attachmentDescription(att))
+			}
+		}
+
+		fmt.Println("")
+		fmt.Println("Выберите дальнейшее действие:")
+		fmt.Println("[1] Добавить вложение")
+		if len(list) > 0 {
+			fmt.Println("[2] Скачать вложение")
+			fmt.Println("[3] Удалить вложение")
+		}
+		fmt.Println("[0] Назад")
+		action, err := c.rl.input("Действия с вложениями>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
+		if c.rl.interrupt(action, err) == loopBreak {
+			return WorkStop, err
+		}
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+
+		switch action {
+		case "1":
+			file, err := c.rl.input("Путь к файлу>>", "required,file", `{"required": "Путь к файлу не может быть пустым", "file": "Файла не существует"}`)
+			if err != nil {
+				fmt.Println(err.Error())
+				continue
+			}
+
+			att, err := c.Sender.AddAttachment(entityID, file)
+			if err != nil {
+				fmt.Println("Вложение не добавлено: " + err.Error())
+				continue
+			}
+			fmt.Printf("Вложение %v добавлено!\n", attachmentDescription(att))
+
+		case "2":
+			att, ok := c.selectAttachment(list)
+			if !ok {
+				continue
+			}
+
+			file, err := c.Sender.DownloadAttachment(att)
+			if err != nil {
+				fmt.Println("Вложение не скачано: " + err.Error())
+				continue
+			}
+			fmt.Printf("Вложение сохранено в файл %v\n", file)
+
+		case "3":
+			att, ok := c.selectAttachment(list)
+			if !ok {
+				continue
+			}
+
+			areYouSure, err := c.rl.input("Удалить вложение "+att.Name+" (Y or N)>>", "required", `{"required": "Неверный выбор"}`)
+			if err != nil {
+				fmt.Println(err.Error())
+				continue
+			}
+			if strings.ToLower(areYouSure) != "y" {
+				continue
+			}
+
+			err = c.Sender.DeleteAttachment(att.Id)
+			if err != nil {
+				fmt.Println(err.Error())
+				continue
+			}
+			fmt.Println("Вложение удалено!")
+
+		case "0":
+			return WorkAgain, nil
+		}
+	}
+}
+
+// selectAttachment выбор вложения по номеру в списке, 0 - отказ от выбора
+func (c *GophKeepClient) selectAttachment(list []*Attachment) (*Attachment, bool) {
+	if len(list) == 0 {
+		return nil, false
+	}
+
+	for {
+		itemStr, err := c.rl.input("Номер вложения (0 - отмена)>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+
+		index, _ := strconv.Atoi(itemStr)
+		if index == 0 {
+			return nil, false
+		}
+		if index < 0 || index > len(list) {
+			fmt.Println("Неверный номер!")
+			continue
+		}
+
+		return list[index-1], true
+	}
+}
+
+// attachmentDescription имя, размер и время добавления вложения
+func attachmentDescription(att *Attachment) string {
+	return fmt.Sprintf("%v (%v байт, добавлено %v)", att.Name, att.Size, att.CreatedAt.Format("02.01.2006 15:04"))
+}
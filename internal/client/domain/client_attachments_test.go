@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sender := NewMockSender(ctrl)
+	mockReadline := NewMockReadline(ctrl)
+
+	client, err := NewGophKeepClient(mockReadline, sender)
+	require.NoError(t, err)
+
+	att := &Attachment{Id: 3, EntityId: 7, Name: "scan.pdf", Size: 1024, Digest: "abc", CreatedAt: time.Now()}
+	mockReadline.EXPECT().interrupt(gomock.Any(), gomock.Any()).Return(loopNone).AnyTimes()
+
+	t.Run("list error", func(t *testing.T) {
+		sender.EXPECT().ListAttachments(int32(7)).Return(nil, errors.New("testerr"))
+
+		res, err := client.Attachments(7)
+		require.Error(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+
+	t.Run("add", func(t *testing.T) {
+		gomock.InOrder(
+			sender.EXPECT().ListAttachments(int32(7)).Return(nil, nil),
+			sender.EXPECT().ListAttachments(int32(7)).Return([]*Attachment{att}, nil),
+		)
+		mockReadline.EXPECT().input("Действия с вложениями>>", gomock.Any(), gomock.Any()).Return("1", nil)
+		mockReadline.EXPECT().input("Путь к файлу>>", gomock.Any(), gomock.Any()).Return("/tmp/scan.pdf", nil)
+		sender.EXPECT().AddAttachment(int32(7), "/tmp/scan.pdf").Return(att, nil)
+		mockReadline.EXPECT().input("Действия с вложениями>>", gomock.Any(), gomock.Any()).Return("0", nil)
+
+		res, err := client.Attachments(7)
+		require.NoError(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+
+	t.Run("download and delete", func(t *testing.T) {
+		sender.EXPECT().ListAttachments(int32(7)).Return([]*Attachment{att}, nil).Times(3)
+		gomock.InOrder(
+			mockReadline.EXPECT().input("Действия с вложениями>>", gomock.Any(), gomock.Any()).Return("2", nil),
+			mockReadline.EXPECT().input("Действия с вложениями>>", gomock.Any(), gomock.Any()).Return("3", nil),
+			mockReadline.EXPECT().input("Действия с вложениями>>", gomock.Any(), gomock.Any()).Return("0", nil),
+		)
+		gomock.InOrder(
+			mockReadline.EXPECT().input("Номер вложения (0 - отмена)>>", gomock.Any(), gomock.Any()).Return("2", nil),
+			mockReadline.EXPECT().input("Номер вложения (0 - отмена)>>", gomock.Any(), gomock.Any()).Return("1", nil),
+			mockReadline.EXPECT().input("Номер вложения (0 - отмена)>>", gomock.Any(), gomock.Any()).Return("1", nil),
+		)
+		sender.EXPECT().DownloadAttachment(att).Return("/tmp/1_scan.pdf", nil)
+		mockReadline.EXPECT().input("Удалить вложение scan.pdf (Y or N)>>", gomock.Any(), gomock.Any()).Return("y", nil)
+		sender.EXPECT().DeleteAttachment(int32(3)).Return(nil)
+
+		res, err := client.Attachments(7)
+		require.NoError(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+}
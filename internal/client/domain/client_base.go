@@ -18,15 +18,20 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 		return WorkStop, fmt.Errorf("коды сущностей не указаны")
 	}
 
+	quick := c.quickAccess()
+
 	fmt.Println("Доступна работа со следующими объектами:")
 	for i, val := range entCodes {
-		fmt.Printf("[%v] %v\n", i+1, val.Name)
+		fmt.Printf("[%v] %v\n", i+1, typeTitle(val))
 	}
+	trashIndex := len(entCodes) + 1
+	fmt.Printf("[%v] Корзина\n", trashIndex)
+	fmt.Println("/текст - поиск по всем объектам")
 
 	var objStr string
 	var err error
 	for {
-		objStr, err = c.rl.input("Выберите номер объекта:", "required,number", `{"required": "Не может быть пустым", "number": "Только число"}`)
+		objStr, err = c.rl.input("Выберите номер объекта:", menuMainRules, menuMainMessages)
 		if c.rl.interrupt(objStr, err) == loopBreak {
 			return WorkStop, err
 		}
@@ -36,7 +41,20 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 		}
 		break
 	}
+	if strings.HasPrefix(objStr, "/") {
+		return c.SearchMenu(strings.TrimPrefix(objStr, "/"))
+	}
+	if id, ok := quickChoice(objStr, quick); ok {
+		return c.QuickEntity(id)
+	}
 	objIndex, _ := strconv.Atoi(objStr)
+	if objIndex == trashIndex {
+		return c.Trash()
+	}
+	if objIndex < 1 || objIndex > len(entCodes) {
+		fmt.Println("Неверный выбор!")
+		return WorkAgain, nil
+	}
 	entCode := entCodes[objIndex-1]
 	fmt.Println("")
 	fmt.Printf("Для объекта \"%v\" доступны следующие действия:\n", entCode.Name)
@@ -55,8 +73,12 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 		}
 
 		switch doStr {
-		// Добавление сущности, поочередно вводим данные в поля
+		// Добавление сущности, поля формы - описания полей типа в порядке, заданном сервером
 		case "1":
+			if entCode.Archived {
+				fmt.Println("Тип в архиве, новые объекты не добавляются")
+				continue
+			}
 			var props []*Property
 			var metas []*Metainfo
 			entity := Entity{
@@ -69,7 +91,7 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 
 			// Заполняем обязательные поля
 			for _, val := range c.rl.GetFieldsGroup(entCode.Etype) {
-				fieldData, err := c.rl.input(val.Name+":", val.ValidateRules, val.ValidateMessages)
+				fieldData, err := c.rl.inputField(val, "")
 				if err != nil {
 					fmt.Println(err.Error())
 					continue
@@ -77,9 +99,13 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 				props = append(props, &Property{
 					EntityId: 0,
 					FieldId:  val.Id,
-					Value:    fieldData,
+					Value:    normalizeValue(val, fieldData),
 				})
 			}
+			if err := completeProps(entCode.Etype, props, c.rl.GetField); err != nil {
+				fmt.Println(err.Error())
+				return WorkAgain, nil
+			}
 			// Заполняем поля метаданных
 			// Добавить или перейти дальше
 			nextTag := false
@@ -171,23 +197,39 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 				return WorkAgain, nil
 			}
 
+			query := "" // строка поиска по объектам выбранного типа
 			for {
-				fmt.Printf("\n%v. Выберите номер объекта, данные которого хотите получить:\n", c.rl.GetEtypeName(entCode.Etype))
+				fmt.Printf("\n%v. Выберите номер объекта, данные которого хотите получить (/текст - поиск, / - все объекты):\n", c.rl.GetEtypeName(entCode.Etype))
 
 				// соответствие межну консольными номерами сущностей и реальными идентификаторами
-				index := 0
 				mapIndexToEntityID := make(map[int]int32, len(list))
-				for key, val := range list {
-					index++
-					fmt.Printf("[%v] %v\n", index, val)
-					mapIndexToEntityID[index] = key
+				items := menuItems(list)
+				if query != "" {
+					items, err = c.searchItems(query, entCode.Etype)
+					if err != nil {
+						fmt.Println(err.Error())
+					}
+					if len(items) == 0 {
+						fmt.Println("Ничего не найдено!")
+					}
+				}
+				for _, item := range items {
+					mapIndexToEntityID[item.Index] = item.Id
+				}
+				err = c.Presenter.Menu(items)
+				if err != nil {
+					fmt.Println(err.Error())
 				}
 
-				entityIndex, err := c.rl.input("Просмотр объекта>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
+				entityIndex, err := c.rl.input("Просмотр объекта>>", menuSearchRules, menuSearchMessages)
 				if err != nil {
 					fmt.Println(err.Error())
 					continue
 				}
+				if strings.HasPrefix(entityIndex, "/") {
+					query = strings.TrimSpace(strings.TrimPrefix(entityIndex, "/"))
+					continue
+				}
 				entIndex, err := strconv.Atoi(entityIndex)
 				if err != nil {
 					fmt.Println("Неверный номер!")
@@ -195,29 +237,10 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 				}
 
 				entityID := mapIndexToEntityID[entIndex]
-				ent, err := c.Sender.Entity(entityID)
-
-				// Если бинарные данные или произвольный текст - скачиваем файл
-				if entCode.Etype == constants.BinaryEntity || entCode.Etype == constants.TextEntity {
-					fd := &BinaryFileProperty{}
-					err = json.Unmarshal([]byte(ent.Props[0].Value), fd)
-					if err != nil {
-						fmt.Println(err.Error())
-						continue
-					}
-
-					pathDownload, err := c.Sender.DownloadCryptoBinary(entityID, path.Base(fd.Clientname))
-					if err != nil {
-						fmt.Println(err.Error())
-						continue
-					}
-					ent.Props[0].Value = pathDownload
-					c.DisplayEntityBinary(*ent, pathDownload)
-
-				} else {
-
-					c.DisplayEntity(*ent)
-
+				ent, err := c.showEntity(entityID)
+				if err != nil {
+					fmt.Println(err.Error())
+					continue
 				}
 
 				for {
@@ -225,6 +248,8 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 					fmt.Println("Выберите дальнейшее действие:")
 					fmt.Println("[1] Изменить")
 					fmt.Println("[2] Удалить")
+					fmt.Printf("[3] %v\n", favoriteAction(ent.Favorite))
+					fmt.Println("[4] Вложения")
 					fmt.Println("[0] Начать все сначала")
 					againOrSave, err := c.rl.input("Действия с объектом>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
 					if err != nil {
@@ -239,8 +264,15 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 							field := c.rl.GetField(propVal.FieldId)
 							if ent.Etype == constants.BinaryEntity || ent.Etype == constants.TextEntity {
 								ent.Props[propKey].Value, err = c.rl.edit(field.Name+":", "", field.ValidateRules, field.ValidateMessages)
+							} else if field.Ftype == constants.FieldTypeSSHKey {
+								// содержимое ключа не редактируем, пустой путь к файлу - оставить прежний ключ
+								keyPath, _ := c.rl.edit(field.Name+" (пусто - оставить прежний):", "", "omitempty,file,sshkey", field.ValidateMessages)
+								if keyPath != "" {
+									ent.Props[propKey].Value = normalizeValue(field, keyPath)
+								}
 							} else {
-								ent.Props[propKey].Value, err = c.rl.edit(field.Name+":", propVal.Value, field.ValidateRules, field.ValidateMessages)
+								ent.Props[propKey].Value, err = c.rl.inputField(field, propVal.Value)
+								ent.Props[propKey].Value = normalizeValue(field, ent.Props[propKey].Value)
 							}
 						}
 
@@ -249,6 +281,11 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 							ent.Metainfo[metaKey].Value, err = c.rl.edit("Значение метаданных:", metaVal.Value, "required", `{"required": "Укажите значение поля метаданных"}`)
 						}
 
+						if err := completeProps(ent.Etype, ent.Props, c.rl.GetField); err != nil {
+							fmt.Println(err.Error())
+							return WorkAgain, nil
+						}
+
 						id, err := c.Sender.SaveEntity(*ent)
 						if err != nil || id <= 0 {
 							return WorkAgain, err
@@ -276,14 +313,15 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 							continue
 						}
 
-						// Удаляем
+						// Перемещаем в корзину
 						if strings.ToLower(areYouSure) == "y" {
 							err = c.Sender.DeleteEntity(entityID)
 							if err != nil {
 								fmt.Println(err.Error())
+								return WorkAgain, nil
 							}
 
-							fmt.Println("Запись успешно удалена!")
+							fmt.Println("Запись перемещена в корзину! Восстановить ее можно в разделе \"Корзина\"")
 
 							return WorkAgain, nil
 						}
@@ -291,6 +329,17 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 						// Пропускаем
 						break
 
+					case "3":
+						c.toggleFavorite(ent)
+						return WorkAgain, nil
+
+					case "4":
+						result, err := c.Attachments(entityID)
+						if result == WorkStop || err != nil {
+							return result, err
+						}
+						continue
+
 					case "0":
 						return WorkAgain, nil
 					default:
@@ -306,11 +355,37 @@ func (c *GophKeepClient) Base(entCodes []*EntityCode) (string, error) {
 			fmt.Println("Неверный выбор!")
 			continue
 		}
+	}
+}
 
-		break
+// showEntity получение и отображение сущности
+// Файл бинарных данных или произвольного текста скачивается, в свойство записывается путь к скачанному файлу
+func (c *GophKeepClient) showEntity(id int32) (*Entity, error) {
+	ent, err := c.Sender.OpenEntity(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ent.Etype == constants.BinaryEntity || ent.Etype == constants.TextEntity {
+		fd := &BinaryFileProperty{}
+		err = json.Unmarshal([]byte(ent.Props[0].Value), fd)
+		if err != nil {
+			return nil, err
+		}
+
+		pathDownload, err := c.Sender.DownloadCryptoBinary(id, path.Base(fd.Clientname))
+		if err != nil {
+			return nil, err
+		}
+		ent.Props[0].Value = pathDownload
+		c.DisplayEntityBinary(*ent, pathDownload)
+
+		return ent, nil
 	}
 
-	return WorkAgain, nil
+	c.DisplayEntity(*ent)
+
+	return ent, nil
 }
 
 // createMetainfo ввод метаинформации
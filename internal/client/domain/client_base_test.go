@@ -16,6 +16,7 @@ func TestCreateMetainfo(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	var metas []*Metainfo
 	controller := gomock.NewController(t)
@@ -38,10 +39,11 @@ func TestBaseAddEntity(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil).AnyTimes()
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil).AnyTimes()
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("1", nil)
 
@@ -56,21 +58,21 @@ func TestBaseAddEntity(t *testing.T) {
 		Id:               4,
 		Name:             "Месяц/Год (mm/yy) до которого действует карта",
 		Etype:            "card",
-		Ftype:            "string",
-		ValidateRules:    "len=5",
-		ValidateMessages: `{"len": "Месяц/год должны быть в формате mm/dd"}`,
+		Ftype:            "monthyear",
+		ValidateRules:    "",
+		ValidateMessages: `{}`,
 	}, &Field{
 		Id:               5,
 		Name:             "Код проверки подлинности",
 		Etype:            "card",
-		Ftype:            "string",
+		Ftype:            "secret",
 		ValidateRules:    "len=3,number",
 		ValidateMessages: `{"len": "Код должен состоять из трех цифр", "number": "Только число"}`,
 	}}
 
-	mockReadline.EXPECT().input("Номер банковской карты:", "credit_card", gomock.Any()).Return("12345678901234", nil)
-	mockReadline.EXPECT().input("Месяц/Год (mm/yy) до которого действует карта:", "len=5", gomock.Any()).Return("12/24", nil)
-	mockReadline.EXPECT().input("Код проверки подлинности:", "len=3,number", gomock.Any()).Return("12/24", nil)
+	mockReadline.EXPECT().inputField(fieldGroup[0], "").Return("12345678901234", nil)
+	mockReadline.EXPECT().inputField(fieldGroup[1], "").Return("12/24", nil)
+	mockReadline.EXPECT().inputField(fieldGroup[2], "").Return("123", nil)
 	mockReadline.EXPECT().GetFieldsGroup("card").Return(fieldGroup)
 
 	mockReadline.EXPECT().input("Метаданные или сохранение>>", "required,number", gomock.Any()).Return("1", nil).AnyTimes()
@@ -108,10 +110,11 @@ func TestBaseViewEntity(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil).AnyTimes()
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil).AnyTimes()
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("2", nil)
 
@@ -119,8 +122,8 @@ func TestBaseViewEntity(t *testing.T) {
 	entList = map[int32]string{1: "111", 2: "222"}
 	sender.EXPECT().EntityList(gomock.Any()).Return(entList, nil).AnyTimes()
 
-	mockReadline.EXPECT().input("Просмотр объекта>>", "required,number", gomock.Any()).Return("1", nil)
-	sender.EXPECT().Entity(gomock.Any()).Return(&Entity{
+	mockReadline.EXPECT().input("Просмотр объекта>>", menuSearchRules, gomock.Any()).Return("1", nil)
+	sender.EXPECT().OpenEntity(gomock.Any()).Return(&Entity{
 		Id:       1,
 		UserID:   1,
 		Etype:    "card",
@@ -153,7 +156,7 @@ func TestBaseViewEntity(t *testing.T) {
 	}}
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("2", nil)
 	mockReadline.EXPECT().GetEtypeName("binary").Return("").AnyTimes()
-	mockReadline.EXPECT().input("Просмотр объекта>>", "required,number", gomock.Any()).Return("1", nil)
+	mockReadline.EXPECT().input("Просмотр объекта>>", menuSearchRules, gomock.Any()).Return("1", nil)
 
 	props := []*Property{&Property{
 		EntityId: 1,
@@ -166,7 +169,7 @@ func TestBaseViewEntity(t *testing.T) {
 		Value:    "meta",
 	}}
 
-	sender.EXPECT().Entity(gomock.Any()).Return(&Entity{
+	sender.EXPECT().OpenEntity(gomock.Any()).Return(&Entity{
 		Id:       1,
 		UserID:   1,
 		Etype:    "binary",
@@ -200,10 +203,11 @@ func TestDelete(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil).AnyTimes()
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil).AnyTimes()
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("2", nil)
 
@@ -211,8 +215,8 @@ func TestDelete(t *testing.T) {
 	entList = map[int32]string{1: "111", 2: "222"}
 	sender.EXPECT().EntityList(gomock.Any()).Return(entList, nil).AnyTimes()
 
-	mockReadline.EXPECT().input("Просмотр объекта>>", "required,number", gomock.Any()).Return("1", nil)
-	sender.EXPECT().Entity(gomock.Any()).Return(&Entity{
+	mockReadline.EXPECT().input("Просмотр объекта>>", menuSearchRules, gomock.Any()).Return("1", nil)
+	sender.EXPECT().OpenEntity(gomock.Any()).Return(&Entity{
 		Id:       1,
 		UserID:   1,
 		Etype:    "card",
@@ -250,13 +254,14 @@ func TestBaseNegative(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", errors.New("testerr"))
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", errors.New("testerr"))
 	mockReadline.EXPECT().interrupt("1", errors.New("testerr")).Return(loopNone)
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil)
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("1", errors.New("testerr"))
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("1", nil)
 
@@ -269,7 +274,7 @@ func TestBaseNegative(t *testing.T) {
 		ValidateMessages: `{"credit_card": "Неправильный формат номера карты"}`,
 	}}
 	mockReadline.EXPECT().GetFieldsGroup("card").Return(fieldGroup).AnyTimes()
-	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("testerr"))
+	mockReadline.EXPECT().inputField(gomock.Any(), "").Return("", errors.New("testerr"))
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("testerr"))
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("5", nil)
@@ -294,6 +299,7 @@ func TestBaseNegative2(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
@@ -301,7 +307,7 @@ func TestBaseNegative2(t *testing.T) {
 	client, err := NewGophKeepClient(mockReadline, sender)
 	require.NoError(t, err)
 
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil)
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("2", nil)
@@ -320,7 +326,7 @@ func TestBaseNegative2(t *testing.T) {
 		ValidateMessages: `{"required": "Не может быть пустым"}`,
 	}}
 	mockReadline.EXPECT().GetFieldsGroup(constants.BinaryEntity).Return(fieldGroup).AnyTimes()
-	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("test", nil)
+	mockReadline.EXPECT().inputField(gomock.Any(), "").Return("test", nil)
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("2", nil)
 
 	mockReadline.EXPECT().GetEtypeName("binary").Return("").AnyTimes()
@@ -341,6 +347,7 @@ func TestBaseActions(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
@@ -348,7 +355,7 @@ func TestBaseActions(t *testing.T) {
 	client, err := NewGophKeepClient(mockReadline, sender)
 	require.NoError(t, err)
 
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil)
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("2", nil)
@@ -367,7 +374,7 @@ func TestBaseActions(t *testing.T) {
 		ValidateMessages: `{"required": "Не может быть пустым"}`,
 	}}
 	mockReadline.EXPECT().GetFieldsGroup(constants.BinaryEntity).Return(fieldGroup).AnyTimes()
-	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("test", nil)
+	mockReadline.EXPECT().inputField(gomock.Any(), "").Return("test", nil)
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("2", nil)
 
 	mockReadline.EXPECT().GetEtypeName("binary").Return("").AnyTimes()
@@ -385,6 +392,7 @@ func TestBaseActions2(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
@@ -392,7 +400,7 @@ func TestBaseActions2(t *testing.T) {
 	client, err := NewGophKeepClient(mockReadline, sender)
 	require.NoError(t, err)
 
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil)
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("2", nil)
@@ -411,7 +419,7 @@ func TestBaseActions2(t *testing.T) {
 		ValidateMessages: `{"required": "Не может быть пустым"}`,
 	}}
 	mockReadline.EXPECT().GetFieldsGroup(constants.BinaryEntity).Return(fieldGroup).AnyTimes()
-	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("test", nil)
+	mockReadline.EXPECT().inputField(gomock.Any(), "").Return("test", nil)
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("2", nil)
 
 	mockReadline.EXPECT().GetEtypeName("binary").Return("").AnyTimes()
@@ -430,6 +438,7 @@ func TestBaseActions3(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
@@ -437,7 +446,7 @@ func TestBaseActions3(t *testing.T) {
 	client, err := NewGophKeepClient(mockReadline, sender)
 	require.NoError(t, err)
 
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil)
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil)
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("2", nil)
 
@@ -458,10 +467,11 @@ func TestBaseActionsNegative(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
-	mockReadline.EXPECT().input("Выберите номер объекта:", "required,number", gomock.Any()).Return("1", nil).AnyTimes()
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("1", nil).AnyTimes()
 	mockReadline.EXPECT().interrupt("1", nil).Return(loopNone)
 	mockReadline.EXPECT().input("Действия для объекта>>", "required,number", gomock.Any()).Return("2", nil)
 
@@ -475,7 +485,7 @@ func TestBaseActionsNegative(t *testing.T) {
 		Value:    "",
 	}}
 
-	sender.EXPECT().Entity(gomock.Any()).Return(&Entity{
+	sender.EXPECT().OpenEntity(gomock.Any()).Return(&Entity{
 		Id:       1,
 		UserID:   1,
 		Etype:    constants.BinaryEntity,
@@ -497,7 +507,7 @@ func TestBaseActionsNegative(t *testing.T) {
 	mockReadline.EXPECT().input(gomock.Any(), gomock.Any(), gomock.Any()).Return("1", nil)
 
 	props[0].Value = `{"servername":"/servername","clientname":"/clientname","chunkcount":59}`
-	sender.EXPECT().Entity(gomock.Any()).Return(&Entity{
+	sender.EXPECT().OpenEntity(gomock.Any()).Return(&Entity{
 		Id:       1,
 		UserID:   1,
 		Etype:    constants.BinaryEntity,
@@ -518,3 +528,37 @@ func TestBaseActionsNegative(t *testing.T) {
 	require.Equal(t, "again", res)
 
 }
+
+// Избранное и недавние в главном меню
+func TestBaseQuickAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", ListFilter{Favorite: true}).Return([]*EntitySummary{
+		{Id: 7, Etype: "card", Title: "Банк:Тест. ", Favorite: true},
+	}, nil)
+	sender.EXPECT().EntitySummaries("", ListFilter{Recent: constants.RecentEntities}).Return([]*EntitySummary{
+		{Id: 9, Etype: "card", Title: "Банк:Новый. "},
+		{Id: 7, Etype: "card", Title: "Банк:Тест. ", Favorite: true},
+	}, nil)
+
+	mockReadline := NewMockReadline(ctrl)
+	mockReadline.EXPECT().GetEtypeName("card").Return("Банковская карта").AnyTimes()
+	mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("r1", nil)
+	mockReadline.EXPECT().interrupt("r1", nil).Return(loopNone)
+	sender.EXPECT().OpenEntity(int32(9)).Return(&Entity{Id: 9, Etype: "card"}, nil)
+	mockReadline.EXPECT().input("Действия с объектом>>", "required,number", gomock.Any()).Return("1", nil)
+	sender.EXPECT().SetFavorite(int32(9), true).Return(nil)
+
+	client, err := NewGophKeepClient(mockReadline, sender)
+	require.NoError(t, err)
+
+	res, err := client.Base([]*EntityCode{{Etype: "card", Name: "Банковская карта"}})
+	require.NoError(t, err)
+	require.Equal(t, WorkAgain, res)
+
+	choice, ok := quickChoice(" F1", map[string]int32{"f1": 7})
+	require.True(t, ok)
+	require.Equal(t, int32(7), choice)
+}
@@ -0,0 +1,117 @@
+// Избранное и последние открытые объекты в консольном меню
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// правила ввода в главном меню: номер объекта, /текст для поиска, fN - избранное, rN - недавние
+const (
+	menuMainRules    = "required,number|startswith=/|startswith=f|startswith=r"
+	menuMainMessages = `{"required": "Не может быть пустым", "number|startswith=/|startswith=f|startswith=r": "Только число, /текст для поиска, fN или rN для быстрого доступа"}`
+)
+
+// префиксы выбора объектов быстрого доступа
+const (
+	favoritePrefix = "f"
+	recentPrefix   = "r"
+)
+
+// quickAccess вывод разделов "Избранное" и "Недавние" главного меню
+// Возвращает соответствие выбора (f1, r1 ...) и ID сущности, ошибка получения раздела только выводится
+func (c *GophKeepClient) quickAccess() map[string]int32 {
+	sections := []struct {
+		title  string
+		prefix string
+		filter ListFilter
+	}{
+		{"Избранное:", favoritePrefix, ListFilter{Favorite: true}},
+		{"Недавние:", recentPrefix, ListFilter{Recent: constants.RecentEntities}},
+	}
+
+	choices := make(map[string]int32)
+	for _, section := range sections {
+		list, err := c.Sender.EntitySummaries("", section.filter)
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+		if len(list) == 0 {
+			continue
+		}
+
+		fmt.Println(section.title)
+		for i, item := range list {
+			choice := fmt.Sprintf("%v%v", section.prefix, i+1)
+			choices[choice] = item.Id
+			fmt.Printf("[%v] %v: %v\n", choice, c.rl.GetEtypeName(item.Etype), item.Title)
+		}
+		fmt.Println("")
+	}
+
+	return choices
+}
+
+// QuickEntity просмотр объекта из разделов быстрого доступа
+func (c *GophKeepClient) QuickEntity(id int32) (string, error) {
+	ent, err := c.showEntity(id)
+	if err != nil {
+		fmt.Println(err.Error())
+		return WorkAgain, nil
+	}
+
+	for {
+		fmt.Println("")
+		fmt.Println("Выберите дальнейшее действие:")
+		fmt.Printf("[1] %v\n", favoriteAction(ent.Favorite))
+		fmt.Println("[0] Начать все сначала")
+		line, err := c.rl.input("Действия с объектом>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+
+		switch line {
+		case "1":
+			c.toggleFavorite(ent)
+			return WorkAgain, nil
+		case "0":
+			return WorkAgain, nil
+		}
+	}
+}
+
+// toggleFavorite добавление объекта в избранное или удаление из него
+func (c *GophKeepClient) toggleFavorite(ent *Entity) {
+	err := c.Sender.SetFavorite(ent.Id, !ent.Favorite)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	ent.Favorite = !ent.Favorite
+	if ent.Favorite {
+		fmt.Println("Объект добавлен в избранное")
+	} else {
+		fmt.Println("Объект убран из избранного")
+	}
+}
+
+// favoriteAction название пункта меню для изменения избранного
+func favoriteAction(favorite bool) string {
+	if favorite {
+		return "Убрать из избранного"
+	}
+
+	return "Добавить в избранное"
+}
+
+// quickChoice выбор объекта быстрого доступа (f1, R2 ...)
+func quickChoice(line string, choices map[string]int32) (int32, bool) {
+	id, ok := choices[strings.ToLower(strings.TrimSpace(line))]
+
+	return id, ok
+}
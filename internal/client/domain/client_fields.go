@@ -0,0 +1,97 @@
+// Обработка значений полей особых типов (OTP, SSH ключи) при вводе и отображении
+package domain
+
+import (
+	"os"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// normalizeValue приведение введенного значения поля к виду, в котором оно хранится
+// Секрет OTP сохраняется в виде URI otpauth:// с явно указанными параметрами генерации,
+// для приватного SSH ключа вводится путь к файлу, а сохраняется его содержимое
+func normalizeValue(field *Field, value string) string {
+	if field == nil {
+		return value
+	}
+
+	switch field.Ftype {
+	case constants.FieldTypeOTP:
+		return otpValue(value)
+	case constants.FieldTypeSSHKey:
+		data, err := os.ReadFile(value)
+		if err != nil {
+			return value
+		}
+		return string(data)
+	}
+
+	return value
+}
+
+// displayValue значение свойства для отображения в консоли
+// Вместо секрета OTP показывается текущий код, вместо приватного SSH ключа - его отпечаток,
+// значения полей типа secret и пароль SSH ключа скрываются
+func (c *GophKeepClient) displayValue(ent Entity, prop *Property, field *Field) string {
+	switch field.Ftype {
+	case constants.FieldTypeOTP:
+		return otpDescription(prop.Value, time.Now())
+	case constants.FieldTypeSSHKey:
+		return sshKeyDescription(prop.Value, propValueByFtype(ent.Props, constants.FieldTypeSSHPassphrase, c.rl.GetField))
+	case constants.FieldTypeSSHPassphrase, constants.FieldTypeSecret:
+		if prop.Value == "" {
+			return ""
+		}
+		return secretMask
+	}
+
+	return prop.Value
+}
+
+// isSecretField значение поля секретно: не попадает в индекс поиска и скрывается при просмотре
+// Признак задается сервером в описании поля, у встроенных типов открыты имена файлов,
+// публичные SSH ключи и комментарии к ним. Поле типа secret секретно всегда
+func isSecretField(field *Field) bool {
+	return field.Secret || field.Ftype == constants.FieldTypeSecret
+}
+
+// isMaskedField значение поля вводится без отображения: строки типа secret (пароли, код проверки карты) и пароль SSH ключа
+// Признак секретности на ввод не влияет: логин пользовательского типа, секрет OTP и путь к файлу SSH ключа видны при вводе
+func isMaskedField(field *Field) bool {
+	return field.Ftype == constants.FieldTypeSecret || field.Ftype == constants.FieldTypeSSHPassphrase
+}
+
+// fieldLookup получение описания поля по ID
+type fieldLookup func(fieldID int32) *Field
+
+// propValueByFtype значение первого свойства с полем указанного типа
+func propValueByFtype(props []*Property, ftype string, getField fieldLookup) string {
+	prop := propByFtype(props, ftype, getField)
+	if prop == nil {
+		return ""
+	}
+
+	return prop.Value
+}
+
+// propByFtype первое свойство с полем указанного типа
+func propByFtype(props []*Property, ftype string, getField fieldLookup) *Property {
+	for _, prop := range props {
+		field := getField(prop.FieldId)
+		if field != nil && field.Ftype == ftype {
+			return prop
+		}
+	}
+
+	return nil
+}
+
+// completeProps проверка согласованности свойств сущности после ввода и вычисление производных значений
+func completeProps(etype string, props []*Property, getField fieldLookup) error {
+	if etype == constants.SSHKeyEntity {
+		return completeSSHKey(props, getField)
+	}
+
+	return nil
+}
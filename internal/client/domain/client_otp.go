@@ -0,0 +1,58 @@
+// Работа с секретами одноразовых паролей (OTP)
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/otp"
+)
+
+// otpauthValidationTag правило валидации секрета OTP (base32 или otpauth:// URI)
+const otpauthValidationTag = "otpauth"
+
+// validateOTPAuth проверка секрета OTP при вводе
+func validateOTPAuth(fl validator.FieldLevel) bool {
+	return otp.IsValid(fl.Field().String())
+}
+
+// otpValue секрет OTP в виде URI otpauth:// с явно указанными параметрами генерации
+func otpValue(value string) string {
+	k, err := otp.Parse(value)
+	if err != nil {
+		return value
+	}
+
+	return k.URI()
+}
+
+// otpDescription текущий код одноразового пароля для отображения вместо секрета
+func otpDescription(value string, now time.Time) string {
+	k, err := otp.Parse(value)
+	if err != nil {
+		return "неверный секрет OTP: " + err.Error()
+	}
+
+	code, err := k.Code(now)
+	if err != nil {
+		return "ошибка генерации кода: " + err.Error()
+	}
+
+	var parts []string
+	if k.Type == otp.TypeTOTP {
+		parts = append(parts, fmt.Sprintf("%v (осталось %v сек.)", code, int(k.Remaining(now).Seconds())))
+	} else {
+		parts = append(parts, fmt.Sprintf("%v (счетчик %v)", code, k.Counter))
+	}
+
+	label := strings.Trim(k.Issuer+": "+k.Account, ": ")
+	if label != "" {
+		parts = append(parts, label)
+	}
+	parts = append(parts, fmt.Sprintf("%v, %v цифр", k.Algorithm, k.Digits))
+
+	return strings.Join(parts, ", ")
+}
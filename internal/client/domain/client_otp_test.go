@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func TestValidateOTPAuth(t *testing.T) {
+	vdr := validator.New()
+	require.NoError(t, vdr.RegisterValidation(otpauthValidationTag, validateOTPAuth))
+
+	assert.NoError(t, vdr.Var("JBSWY3DPEHPK3PXP", "required,otpauth"))
+	assert.NoError(t, vdr.Var("otpauth://totp/ACME:john?secret=JBSWY3DPEHPK3PXP", "required,otpauth"))
+	assert.Error(t, vdr.Var("секрет", "required,otpauth"))
+	assert.Error(t, vdr.Var("otpauth://totp/ACME:john", "required,otpauth"))
+}
+
+func TestNormalizeValue(t *testing.T) {
+	otpField := &Field{Id: 1, Ftype: constants.FieldTypeOTP}
+	stringField := &Field{Id: 2, Ftype: constants.FieldTypeString}
+
+	assert.Equal(t, "otpauth://totp/?algorithm=SHA1&digits=6&period=30&secret=JBSWY3DPEHPK3PXP", normalizeValue(otpField, "jbsw y3dp ehpk 3pxp"))
+	assert.Equal(t, "jbsw y3dp ehpk 3pxp", normalizeValue(stringField, "jbsw y3dp ehpk 3pxp"))
+	assert.Equal(t, "не секрет", normalizeValue(otpField, "не секрет"))
+}
+
+func TestOTPDescription(t *testing.T) {
+	// RFC 6238: секрет "12345678901234567890", время 59 -> 94287082
+	uri := "otpauth://totp/ACME:john?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&digits=8"
+	assert.Equal(t, "94287082 (осталось 1 сек.), ACME: john, SHA1, 8 цифр", otpDescription(uri, time.Unix(59, 0)))
+
+	// RFC 4226: счетчик 1 -> 287082
+	uri = "otpauth://hotp/john?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&counter=1"
+	assert.Equal(t, "287082 (счетчик 1), john, SHA1, 6 цифр", otpDescription(uri, time.Now()))
+
+	assert.Contains(t, otpDescription("не секрет", time.Now()), "неверный секрет OTP")
+}
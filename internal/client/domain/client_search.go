@@ -0,0 +1,90 @@
+// Поиск по объектам в консольных меню
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// правила ввода в меню, где кроме номера объекта можно ввести /текст для поиска
+const (
+	menuSearchRules    = "required,number|startswith=/"
+	menuSearchMessages = `{"required": "Не может быть пустым", "number|startswith=/": "Только число или /текст для поиска"}`
+)
+
+// SearchMenu поиск по объектам всех типов и просмотр найденного
+func (c *GophKeepClient) SearchMenu(query string) (string, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		line, err := c.rl.input("Поиск>>", "required", `{"required": "Введите текст для поиска"}`)
+		if err != nil {
+			fmt.Println(err.Error())
+			return WorkAgain, nil
+		}
+		query = line
+	}
+
+	items, err := c.searchItems(query, "")
+	if err != nil {
+		fmt.Println(err.Error())
+		return WorkAgain, nil
+	}
+	if len(items) == 0 {
+		fmt.Println("Ничего не найдено!")
+		return WorkAgain, nil
+	}
+
+	fmt.Printf("\nНайдено по запросу \"%v\". Выберите номер объекта:\n", query)
+	err = c.Presenter.Menu(items)
+	if err != nil {
+		fmt.Println(err.Error())
+	}
+	fmt.Println("[0] Начать сначала")
+
+	for {
+		line, err := c.rl.input("Просмотр объекта>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+		index, _ := strconv.Atoi(line)
+		if index == 0 {
+			return WorkAgain, nil
+		}
+		if index < 0 || index > len(items) {
+			fmt.Println("Неверный номер!")
+			continue
+		}
+
+		_, err = c.showEntity(items[index-1].Id)
+		if err != nil {
+			fmt.Println(err.Error())
+		}
+
+		return WorkAgain, nil
+	}
+}
+
+// searchItems пункты меню для найденных объектов указанного типа или всех типов
+// Индекс строится при первом поиске и дальше обновляется при изменениях в текущей сессии
+func (c *GophKeepClient) searchItems(query string, etype string) ([]MenuItem, error) {
+	if !c.index.Built() {
+		err := c.index.Build()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	results := c.index.Search(query, etype, 0)
+	items := make([]MenuItem, 0, len(results))
+	for i, res := range results {
+		title := res.Title
+		if etype == "" {
+			title = c.rl.GetEtypeName(res.Etype) + ": " + title
+		}
+		items = append(items, MenuItem{Index: i + 1, Id: res.Id, Title: title})
+	}
+
+	return items, nil
+}
@@ -0,0 +1,100 @@
+// Работа с SSH ключами
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// правила валидации SSH ключей
+const (
+	sshKeyValidationTag       = "sshkey" // файл с приватным ключом
+	sshPublicKeyValidationTag = "sshpub" // публичный ключ в формате authorized_keys
+)
+
+// validateSSHKey проверка, что файл содержит приватный SSH ключ (зашифрованный ключ допускается)
+func validateSSHKey(fl validator.FieldLevel) bool {
+	data, err := os.ReadFile(fl.Field().String())
+	if err != nil {
+		return false
+	}
+
+	_, err = parseSSHKey(string(data), "")
+	var missing *ssh.PassphraseMissingError
+
+	return err == nil || errors.As(err, &missing)
+}
+
+// validateSSHPublicKey проверка публичного ключа в формате authorized_keys
+func validateSSHPublicKey(fl validator.FieldLevel) bool {
+	_, _, _, _, err := ssh.ParseAuthorizedKey([]byte(fl.Field().String()))
+	return err == nil
+}
+
+// parseSSHKey разбор приватного ключа, для зашифрованного ключа нужен пароль
+func parseSSHKey(private string, passphrase string) (ssh.Signer, error) {
+	if passphrase == "" {
+		return ssh.ParsePrivateKey([]byte(private))
+	}
+
+	return ssh.ParsePrivateKeyWithPassphrase([]byte(private), []byte(passphrase))
+}
+
+// sshKeyDescription тип и отпечаток приватного ключа для отображения вместо самого ключа
+func sshKeyDescription(private string, passphrase string) string {
+	signer, err := parseSSHKey(private, passphrase)
+	if err != nil {
+		return "неверный SSH ключ: " + err.Error()
+	}
+
+	pub := signer.PublicKey()
+
+	return fmt.Sprintf("%v, отпечаток %v", pub.Type(), ssh.FingerprintSHA256(pub))
+}
+
+// completeSSHKey проверка пароля приватного ключа и вычисление публичного ключа, если он не указан
+// Указанный вручную публичный ключ должен соответствовать приватному
+func completeSSHKey(props []*Property, getField fieldLookup) error {
+	private := propValueByFtype(props, constants.FieldTypeSSHKey, getField)
+	passphrase := propValueByFtype(props, constants.FieldTypeSSHPassphrase, getField)
+
+	signer, err := parseSSHKey(private, passphrase)
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if errors.As(err, &missing) {
+			return errors.New("ключ зашифрован, укажите пароль ключа")
+		}
+		return fmt.Errorf("неверный SSH ключ или пароль ключа: %w", err)
+	}
+	derived := signer.PublicKey()
+
+	pubProp := propByFtype(props, constants.FieldTypeSSHPublicKey, getField)
+	if pubProp == nil {
+		return nil
+	}
+
+	if strings.TrimSpace(pubProp.Value) == "" {
+		pubProp.Value = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(derived)))
+		if comment := propValueByFtype(props, constants.FieldTypeSSHComment, getField); comment != "" {
+			pubProp.Value += " " + comment
+		}
+		return nil
+	}
+
+	pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(pubProp.Value))
+	if err != nil {
+		return fmt.Errorf("неверный формат публичного ключа: %w", err)
+	}
+	if ssh.FingerprintSHA256(pub) != ssh.FingerprintSHA256(derived) {
+		return errors.New("публичный ключ не соответствует приватному")
+	}
+
+	return nil
+}
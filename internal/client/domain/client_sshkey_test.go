@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// newTestSSHKey генерация приватного ключа ed25519 в формате OpenSSH и его публичного ключа
+func newTestSSHKey(t *testing.T, passphrase string) (string, ssh.PublicKey) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var block *pem.Block
+	if passphrase == "" {
+		block, err = ssh.MarshalPrivateKey(priv, "test")
+	} else {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(priv, "test", []byte(passphrase))
+	}
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(block)), signer.PublicKey()
+}
+
+// newSSHKeyClient клиент со справочником полей сущности sshkey
+func newSSHKeyClient(t *testing.T) *GophKeepClient {
+	ctrl := gomock.NewController(t)
+	mockReadline := NewMockReadline(ctrl)
+	fields := map[int32]*Field{
+		1: {Id: 1, Etype: constants.SSHKeyEntity, Ftype: constants.FieldTypeSSHKey},
+		2: {Id: 2, Etype: constants.SSHKeyEntity, Ftype: constants.FieldTypeSSHPassphrase},
+		3: {Id: 3, Etype: constants.SSHKeyEntity, Ftype: constants.FieldTypeSSHPublicKey},
+		4: {Id: 4, Etype: constants.SSHKeyEntity, Ftype: constants.FieldTypeSSHComment},
+	}
+	mockReadline.EXPECT().GetField(gomock.Any()).DoAndReturn(func(id int32) *Field {
+		return fields[id]
+	}).AnyTimes()
+
+	client, err := NewGophKeepClient(mockReadline, NewMockSender(ctrl))
+	require.NoError(t, err)
+
+	return client
+}
+
+func sshKeyProps(private, passphrase, public, comment string) []*Property {
+	return []*Property{
+		{FieldId: 1, Value: private},
+		{FieldId: 2, Value: passphrase},
+		{FieldId: 3, Value: public},
+		{FieldId: 4, Value: comment},
+	}
+}
+
+func TestValidateSSHKey(t *testing.T) {
+	vdr := validator.New()
+	require.NoError(t, vdr.RegisterValidation(sshKeyValidationTag, validateSSHKey))
+	require.NoError(t, vdr.RegisterValidation(sshPublicKeyValidationTag, validateSSHPublicKey))
+
+	dir := t.TempDir()
+	plain, pub := newTestSSHKey(t, "")
+	encrypted, _ := newTestSSHKey(t, "secret")
+	files := map[string]string{"plain": plain, "encrypted": encrypted, "garbage": "не ключ"}
+	for name, data := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(data), 0600))
+	}
+
+	assert.NoError(t, vdr.Var(filepath.Join(dir, "plain"), "required,sshkey"))
+	assert.NoError(t, vdr.Var(filepath.Join(dir, "encrypted"), "required,sshkey"))
+	assert.Error(t, vdr.Var(filepath.Join(dir, "garbage"), "required,sshkey"))
+	assert.Error(t, vdr.Var(filepath.Join(dir, "absent"), "required,sshkey"))
+
+	assert.NoError(t, vdr.Var(string(ssh.MarshalAuthorizedKey(pub)), "omitempty,sshpub"))
+	assert.NoError(t, vdr.Var("", "omitempty,sshpub"))
+	assert.Error(t, vdr.Var("ssh-ed25519 AAAA", "omitempty,sshpub"))
+
+	field := &Field{Ftype: constants.FieldTypeSSHKey}
+	assert.Equal(t, plain, normalizeValue(field, filepath.Join(dir, "plain")))
+}
+
+func TestCompleteSSHKey(t *testing.T) {
+	client := newSSHKeyClient(t)
+	plain, pub := newTestSSHKey(t, "")
+	encrypted, encPub := newTestSSHKey(t, "secret")
+	authorized := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub)))
+
+	// публичный ключ вычисляется из приватного, комментарий дописывается в конец
+	props := sshKeyProps(plain, "", "", "user@host")
+	require.NoError(t, completeProps(constants.SSHKeyEntity, props, client.rl.GetField))
+	assert.Equal(t, authorized+" user@host", props[2].Value)
+
+	// указанный публичный ключ должен соответствовать приватному
+	props = sshKeyProps(plain, "", authorized, "")
+	require.NoError(t, completeProps(constants.SSHKeyEntity, props, client.rl.GetField))
+	props = sshKeyProps(plain, "", string(ssh.MarshalAuthorizedKey(encPub)), "")
+	assert.Error(t, completeProps(constants.SSHKeyEntity, props, client.rl.GetField))
+
+	// зашифрованный ключ требует правильный пароль
+	assert.Error(t, completeProps(constants.SSHKeyEntity, sshKeyProps(encrypted, "", "", ""), client.rl.GetField))
+	assert.Error(t, completeProps(constants.SSHKeyEntity, sshKeyProps(encrypted, "wrong", "", ""), client.rl.GetField))
+	props = sshKeyProps(encrypted, "secret", "", "")
+	require.NoError(t, completeProps(constants.SSHKeyEntity, props, client.rl.GetField))
+	assert.Equal(t, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(encPub))), props[2].Value)
+
+	// прочие типы сущностей не проверяются
+	assert.NoError(t, completeProps(constants.LogopasEntity, nil, client.rl.GetField))
+}
+
+func TestSSHKeyDisplay(t *testing.T) {
+	client := newSSHKeyClient(t)
+	encrypted, pub := newTestSSHKey(t, "secret")
+	ent := Entity{Etype: constants.SSHKeyEntity, Props: sshKeyProps(encrypted, "secret", "", "")}
+
+	value := client.displayValue(ent, ent.Props[0], client.rl.GetField(1))
+	assert.Equal(t, "ssh-ed25519, отпечаток "+ssh.FingerprintSHA256(pub), value)
+	assert.NotContains(t, value, "PRIVATE KEY")
+	assert.Equal(t, "********", client.displayValue(ent, ent.Props[1], client.rl.GetField(2)))
+
+	assert.Contains(t, sshKeyDescription(encrypted, ""), "неверный SSH ключ")
+}
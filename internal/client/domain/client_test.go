@@ -15,6 +15,7 @@ func TestStart(t *testing.T) {
 	defer ctrl.Finish()
 
 	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
 
 	controller := gomock.NewController(t)
 	mockReadline := NewMockReadline(controller)
@@ -0,0 +1,114 @@
+// Работа с корзиной в консоли (просмотр, восстановление и окончательное удаление сущностей)
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Trash работа с корзиной в консоли (просмотр, восстановление и окончательное удаление сущностей)
+func (c *GophKeepClient) Trash() (string, error) {
+
+	items, err := c.Sender.ListTrash()
+	if err != nil {
+		return WorkAgain, err
+	}
+
+	if len(items) == 0 {
+		fmt.Println("Корзина пуста!")
+		return WorkAgain, nil
+	}
+
+	fmt.Println("\nКорзина. Выберите номер объекта:")
+	for i, item := range items {
+		fmt.Printf("[%v] %v. %v(удален %v)\n", i+1, c.rl.GetEtypeName(item.Etype), trashItemDescription(item), item.DeletedAt.Format("02.01.2006 15:04"))
+	}
+	fmt.Println("[0] Начать сначала")
+
+	var item *TrashItem
+	for {
+		itemStr, err := c.rl.input("Объект в корзине>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
+		if c.rl.interrupt(itemStr, err) == loopBreak {
+			return WorkStop, err
+		}
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+
+		itemIndex, _ := strconv.Atoi(itemStr)
+		if itemIndex == 0 {
+			return WorkAgain, nil
+		}
+		if itemIndex < 0 || itemIndex > len(items) {
+			fmt.Println("Неверный номер!")
+			continue
+		}
+
+		item = items[itemIndex-1]
+		break
+	}
+
+	for {
+		fmt.Println("")
+		fmt.Println("Выберите дальнейшее действие:")
+		fmt.Println("[1] Восстановить")
+		fmt.Println("[2] Удалить навсегда")
+		fmt.Println("[0] Начать все сначала")
+		action, err := c.rl.input("Действия с удаленным объектом>>", "required,number", `{"required": "Неверный выбор", "number": "Только число"}`)
+		if err != nil {
+			fmt.Println(err.Error())
+			continue
+		}
+
+		switch action {
+		case "1":
+			err = c.Sender.RestoreEntity(item.Id)
+			if err != nil {
+				return WorkAgain, err
+			}
+
+			fmt.Println("Запись успешно восстановлена!")
+			return WorkAgain, nil
+
+		case "2":
+			areYouSure, err := c.rl.input("Удалить без возможности восстановления (Y or N)>>", "required", `{"required": "Неверный выбор"}`)
+			if err != nil {
+				fmt.Println(err.Error())
+				continue
+			}
+
+			if strings.ToLower(areYouSure) != "y" {
+				continue
+			}
+
+			err = c.Sender.PurgeEntity(item.Id)
+			if err != nil {
+				return WorkAgain, err
+			}
+
+			fmt.Println("Запись удалена навсегда!")
+			return WorkAgain, nil
+
+		case "0":
+			return WorkAgain, nil
+		default:
+			continue
+		}
+	}
+}
+
+// trashItemDescription описание удаленной сущности, составленное из ее метаинформации
+func trashItemDescription(item *TrashItem) string {
+	if len(item.Metainfo) == 0 {
+		return "нет описания. "
+	}
+
+	str := ""
+	for _, meta := range item.Metainfo {
+		str = str + meta.Title + ":" + meta.Value + ". "
+	}
+
+	return str
+}
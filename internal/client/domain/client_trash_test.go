@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sender := NewMockSender(ctrl)
+	sender.EXPECT().EntitySummaries("", gomock.Any()).Return(nil, nil).AnyTimes()
+	mockReadline := NewMockReadline(ctrl)
+
+	client, err := NewGophKeepClient(mockReadline, sender)
+	require.NoError(t, err)
+
+	items := []*TrashItem{&TrashItem{
+		Id:    5,
+		Etype: "card",
+		Metainfo: []*Metainfo{&Metainfo{
+			Title: "Банк",
+			Value: "Суслик Инвест",
+		}},
+		DeletedAt: time.Now(),
+	}}
+	mockReadline.EXPECT().GetEtypeName("card").Return("Банковская карта").AnyTimes()
+	mockReadline.EXPECT().interrupt(gomock.Any(), gomock.Any()).Return(loopNone).AnyTimes()
+
+	t.Run("empty", func(t *testing.T) {
+		sender.EXPECT().ListTrash().Return(nil, nil)
+
+		res, err := client.Trash()
+		require.NoError(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		sender.EXPECT().ListTrash().Return(nil, errors.New("testerr"))
+
+		res, err := client.Trash()
+		require.Error(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		sender.EXPECT().ListTrash().Return(items, nil)
+		mockReadline.EXPECT().input("Объект в корзине>>", gomock.Any(), gomock.Any()).Return("2", nil)
+		mockReadline.EXPECT().input("Объект в корзине>>", gomock.Any(), gomock.Any()).Return("1", nil)
+		mockReadline.EXPECT().input("Действия с удаленным объектом>>", gomock.Any(), gomock.Any()).Return("1", nil)
+		sender.EXPECT().RestoreEntity(int32(5)).Return(nil)
+
+		res, err := client.Trash()
+		require.NoError(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		sender.EXPECT().ListTrash().Return(items, nil)
+		mockReadline.EXPECT().input("Объект в корзине>>", gomock.Any(), gomock.Any()).Return("1", nil)
+		mockReadline.EXPECT().input("Действия с удаленным объектом>>", gomock.Any(), gomock.Any()).Return("2", nil).Times(2)
+		mockReadline.EXPECT().input("Удалить без возможности восстановления (Y or N)>>", gomock.Any(), gomock.Any()).Return("n", nil)
+		mockReadline.EXPECT().input("Удалить без возможности восстановления (Y or N)>>", gomock.Any(), gomock.Any()).Return("y", nil)
+		sender.EXPECT().PurgeEntity(int32(5)).Return(errors.New("testerr"))
+
+		res, err := client.Trash()
+		require.Error(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+
+	t.Run("from base menu", func(t *testing.T) {
+		entCodes := []*EntityCode{&EntityCode{
+			Etype: "card",
+			Name:  "Банковская карта",
+		}}
+		mockReadline.EXPECT().input("Выберите номер объекта:", menuMainRules, gomock.Any()).Return("2", nil)
+		sender.EXPECT().ListTrash().Return(items, nil)
+		mockReadline.EXPECT().input("Объект в корзине>>", gomock.Any(), gomock.Any()).Return("0", nil)
+
+		res, err := client.Base(entCodes)
+		require.NoError(t, err)
+		require.Equal(t, WorkAgain, res)
+	})
+}
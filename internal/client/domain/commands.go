@@ -0,0 +1,787 @@
+// Неинтерактивные команды клиента для использования в скриптах и CI
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/hibp"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+var (
+	ErrNotFound   = errors.New("не найдено")                // сущность, тип сущности или поле не найдены
+	ErrValidation = errors.New("данные не прошли проверку") // значение поля не прошло валидацию
+)
+
+// ListItem элемент списка сущностей
+type ListItem struct {
+	Id    int32  `json:"id" yaml:"id"`       // ID сущности
+	Etype string `json:"type" yaml:"type"`   // тип сущности
+	Title string `json:"title" yaml:"title"` // описание, составленное из метаданных
+}
+
+// FieldValue значение поля сущности с названием поля
+type FieldValue struct {
+	Name  string `json:"name" yaml:"name"`   // название поля
+	Value string `json:"value" yaml:"value"` // значение
+}
+
+// EntityView сущность с названиями полей для вывода
+type EntityView struct {
+	Id       int32        `json:"id" yaml:"id"`                                 // ID сущности
+	Etype    string       `json:"type" yaml:"type"`                             // тип сущности
+	TypeName string       `json:"typeName,omitempty" yaml:"typeName,omitempty"` // название типа сущности
+	Fields   []FieldValue `json:"fields" yaml:"fields"`                         // значения полей
+	Metainfo []FieldValue `json:"metainfo" yaml:"metainfo"`                     // метаданные
+	Folder   string       `json:"folder,omitempty" yaml:"folder,omitempty"`     // путь папки
+	Tags     []string     `json:"tags,omitempty" yaml:"tags,omitempty"`         // теги
+}
+
+// Placement размещение сущности в папке и теги
+type Placement struct {
+	Folder string   // путь или ID папки; при изменении пустая строка - не менять, FolderSeparator - вынести из папок
+	Tags   []string // добавляемые теги
+	Untags []string // удаляемые теги (при изменении)
+}
+
+// Commands неинтерактивные команды поверх Sender: список, просмотр, добавление, изменение,
+// удаление сущностей, загрузка и скачивание файлов
+type Commands struct {
+	Sender    Sender              // отправка-получение данных на/с сервера
+	validator *validator.Validate // валидатор значений полей
+	fields    map[string][]*Field // описания полей по типам сущностей
+	codes     []*EntityCode       // справочник типов сущностей
+	resolver  *SecretResolver     // получение значения отдельного поля
+	Breaches  *hibp.Checker       // локальная база утечек паролей, nil - пароли не проверяются
+	breached  []AuditItem         // пароли последней сохраненной сущности, найденные в базе утечек
+	breachErr error               // ошибка проверки паролей последней сохраненной сущности
+}
+
+// NewCommands конструктор, Sender должен быть уже аутентифицирован
+func NewCommands(sender Sender) (*Commands, error) {
+	vdr, err := newValidator()
+	if err != nil {
+		return nil, err
+	}
+
+	resolver, err := NewSecretResolver(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Commands{
+		Sender:    sender,
+		validator: vdr,
+		fields:    make(map[string][]*Field),
+		resolver:  resolver,
+	}, nil
+}
+
+// List список сущностей указанного типа или всех типов, если тип не указан, в порядке возрастания ID
+func (c *Commands) List(etype string) ([]ListItem, error) {
+	return c.ListFiltered(etype, ListFilter{})
+}
+
+// ListFiltered список сущностей с отбором по папке и тегу
+func (c *Commands) ListFiltered(etype string, filter ListFilter) ([]ListItem, error) {
+	etypes := []string{etype}
+	if etype == "" {
+		codes, err := c.entityCodes()
+		if err != nil {
+			return nil, err
+		}
+		etypes = etypes[:0]
+		for _, code := range codes {
+			etypes = append(etypes, code.Etype)
+		}
+	} else if _, err := c.fieldsOf(etype); err != nil {
+		return nil, err
+	}
+
+	var items []ListItem
+	for _, et := range etypes {
+		var list map[int32]string
+		var err error
+		if filter.Empty() {
+			list, err = c.Sender.EntityList(et)
+		} else {
+			list, err = c.Sender.EntityListFiltered(et, filter)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for id, title := range list {
+			items = append(items, ListItem{Id: id, Etype: et, Title: strings.TrimSpace(title)})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Id < items[j].Id })
+
+	return items, nil
+}
+
+// Get сущность с названиями полей
+// Для файловых полей вместо служебного описания выводится имя загруженного файла
+func (c *Commands) Get(id int32) (*EntityView, error) {
+	ent, err := c.entity(id)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := c.fieldsOf(ent.Etype)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &EntityView{Id: ent.Id, Etype: ent.Etype}
+	for _, prop := range ent.Props {
+		field := fieldByID(fields, prop.FieldId)
+		if field == nil {
+			continue
+		}
+		value := prop.Value
+		if field.Ftype == constants.FieldTypePath {
+			fd := &BinaryFileProperty{}
+			if json.Unmarshal([]byte(value), fd) == nil {
+				value = path.Base(fd.Clientname)
+			}
+		}
+		view.Fields = append(view.Fields, FieldValue{Name: field.Name, Value: value})
+	}
+	for _, meta := range ent.Metainfo {
+		view.Metainfo = append(view.Metainfo, FieldValue{Name: meta.Title, Value: meta.Value})
+	}
+	view.Tags = ent.Tags
+	if ent.FolderId != 0 {
+		folders, err := c.Sender.Folders()
+		if err != nil {
+			return nil, err
+		}
+		view.Folder = FolderPath(folders, ent.FolderId)
+	}
+
+	return view, nil
+}
+
+// Search нечеткий поиск по сущностям указанного типа или всех типов, если тип не указан
+// Сущности загружаются и расшифровываются целиком, поиск идет по описаниям, метаданным и открытым полям
+func (c *Commands) Search(query string, etype string, limit int) ([]SearchResult, error) {
+	var etypes []string
+	if etype != "" {
+		if _, err := c.fieldsOf(etype); err != nil {
+			return nil, err
+		}
+		etypes = append(etypes, etype)
+	}
+
+	index := NewSearchIndex(c.Sender)
+	err := index.Build(etypes...)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Search(query, etype, limit), nil
+}
+
+// SearchServer поиск на сервере по слепому индексу метаинформации без загрузки всех сущностей
+// Находятся сущности, в метаинформации которых есть все слова запроса (целиком, без учета регистра)
+// и все пары название=значение. Результаты упорядочены по ID, limit <= 0 - без ограничения.
+func (c *Commands) SearchServer(query string, etype string, limit int) ([]SearchResult, error) {
+	if etype != "" {
+		if _, err := c.fieldsOf(etype); err != nil {
+			return nil, err
+		}
+	}
+	terms := BlindQueryTerms(query)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("%w: пустой запрос поиска", ErrValidation)
+	}
+
+	found, err := c.Sender.SearchEntities(query, etype)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SearchResult, 0, len(found))
+	for _, ent := range found {
+		results = append(results, SearchResult{
+			Id:    ent.Id,
+			Etype: ent.Etype,
+			Title: metaTitle(ent.Metainfo),
+			Match: blindMatch(ent.Metainfo, terms[0]),
+			Score: len(terms),
+		})
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Id < results[j].Id
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+// Fields описания полей указанного типа сущности или всех типов, если тип не указан
+func (c *Commands) Fields(etype string) ([]*Field, error) {
+	if etype != "" {
+		return c.fieldsOf(etype)
+	}
+
+	codes, err := c.entityCodes()
+	if err != nil {
+		return nil, err
+	}
+	var fields []*Field
+	for _, code := range codes {
+		list, err := c.fieldsOf(code.Etype)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, list...)
+	}
+
+	return fields, nil
+}
+
+// GetField значение одного поля сущности (для OTP - текущий код)
+func (c *Commands) GetField(id int32, field string) (string, error) {
+	value, err := c.resolver.Resolve(fmt.Sprintf("%v/%v", id, field))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrNotFound, err)
+	}
+
+	return value, nil
+}
+
+// Add добавление сущности
+// values - значения полей по названиям, metas - метаданные, place - папка и теги
+func (c *Commands) Add(etype string, values map[string]string, metas []*Metainfo, place Placement) (int32, error) {
+	fields, err := c.fieldsOf(etype)
+	if err != nil {
+		return 0, err
+	}
+	if isFileEntity(etype) {
+		return 0, fmt.Errorf("%w: файлы загружаются командой upload", ErrValidation)
+	}
+
+	err = checkFieldNames(fields, values)
+	if err != nil {
+		return 0, err
+	}
+
+	props := make([]*Property, 0, len(fields))
+	for _, field := range fields {
+		value, err := c.validate(field, lookupValue(values, field.Name))
+		if err != nil {
+			return 0, err
+		}
+		props = append(props, &Property{FieldId: field.Id, Value: value})
+	}
+
+	err = completeProps(etype, props, fieldLookupOf(fields))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+
+	ent := Entity{Etype: etype, Props: props, Metainfo: metas}
+	err = c.place(&ent, place)
+	if err != nil {
+		return 0, err
+	}
+
+	ent.Id, err = c.Sender.AddEntity(ent)
+	if err != nil {
+		return 0, err
+	}
+	c.checkBreaches(&ent, fields)
+
+	return ent.Id, nil
+}
+
+// Edit изменение указанных полей, метаданных, папки и тегов сущности
+// Метаданные с уже существующим названием заменяются, с новым - добавляются
+func (c *Commands) Edit(id int32, values map[string]string, metas []*Metainfo, place Placement) error {
+	ent, err := c.entity(id)
+	if err != nil {
+		return err
+	}
+	if isFileEntity(ent.Etype) && len(values) > 0 {
+		return fmt.Errorf("%w: файлы загружаются командой upload", ErrValidation)
+	}
+
+	fields, err := c.fieldsOf(ent.Etype)
+	if err != nil {
+		return err
+	}
+	err = checkFieldNames(fields, values)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		raw, ok := lookupValueOk(values, field.Name)
+		if !ok {
+			continue
+		}
+		value, err := c.validate(field, raw)
+		if err != nil {
+			return err
+		}
+
+		prop := propByFieldID(ent.Props, field.Id)
+		if prop == nil {
+			prop = &Property{EntityId: ent.Id, FieldId: field.Id}
+			ent.Props = append(ent.Props, prop)
+		}
+		prop.Value = value
+	}
+
+	for _, meta := range metas {
+		replaced := false
+		for _, old := range ent.Metainfo {
+			if strings.EqualFold(old.Title, meta.Title) {
+				old.Value = meta.Value
+				replaced = true
+			}
+		}
+		if !replaced {
+			ent.Metainfo = append(ent.Metainfo, &Metainfo{EntityId: ent.Id, Title: meta.Title, Value: meta.Value})
+		}
+	}
+
+	err = completeProps(ent.Etype, ent.Props, fieldLookupOf(fields))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+
+	err = c.place(ent, place)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Sender.SaveEntity(*ent)
+	if err != nil {
+		return err
+	}
+	c.checkBreaches(ent, fields)
+
+	return nil
+}
+
+// Remove перемещение сущности в корзину
+func (c *Commands) Remove(id int32) error {
+	_, err := c.entity(id)
+	if err != nil {
+		return err
+	}
+
+	return c.Sender.DeleteEntity(id)
+}
+
+// Upload загрузка файла в новую сущность типа binary или text
+// Возвращает ID сущности и размер загруженных данных
+func (c *Commands) Upload(etype string, file string, metas []*Metainfo, place Placement) (int32, int32, error) {
+	if !isFileEntity(etype) {
+		return 0, 0, fmt.Errorf("%w: тип %v не содержит файлов", ErrValidation, etype)
+	}
+
+	fields, err := c.fieldsOf(etype)
+	if err != nil {
+		return 0, 0, err
+	}
+	value, err := c.validate(fields[0], file)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ent := Entity{
+		Etype:    etype,
+		Props:    []*Property{{FieldId: fields[0].Id, Value: value}},
+		Metainfo: metas,
+	}
+	err = c.place(&ent, place)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	id, err := c.Sender.AddEntity(ent)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size, err := c.Sender.UploadCryptoBinary(id, value)
+	if err != nil {
+		return id, 0, err
+	}
+
+	return id, size, nil
+}
+
+// Download скачивание файла сущности типа binary или text
+// Если out не указан, файл остается в директории загрузок клиента. Возвращает путь к файлу
+func (c *Commands) Download(id int32, out string) (string, error) {
+	ent, err := c.entity(id)
+	if err != nil {
+		return "", err
+	}
+	if !isFileEntity(ent.Etype) {
+		return "", fmt.Errorf("%w: сущность %v не содержит файла", ErrValidation, id)
+	}
+
+	fd := &BinaryFileProperty{}
+	err = json.Unmarshal([]byte(ent.Props[0].Value), fd)
+	if err != nil {
+		return "", err
+	}
+
+	downloaded, err := c.Sender.DownloadCryptoBinary(id, path.Base(fd.Clientname))
+	if err != nil {
+		return "", err
+	}
+	if out == "" {
+		return downloaded, nil
+	}
+
+	err = moveFile(downloaded, out)
+	if err != nil {
+		return "", err
+	}
+
+	return out, nil
+}
+
+// Folders дерево папок пользователя
+func (c *Commands) Folders() ([]FolderNode, error) {
+	folders, err := c.Sender.Folders()
+	if err != nil {
+		return nil, err
+	}
+
+	return FolderTree(folders), nil
+}
+
+// MakeFolder создание папки по пути, недостающие родительские папки создаются
+// Возвращает ID последней папки пути, если она уже существует - ошибка ErrValidation
+func (c *Commands) MakeFolder(folderPath string) (int32, error) {
+	names := splitFolderPath(folderPath)
+	if len(names) == 0 {
+		return 0, fmt.Errorf("%w: не указано название папки", ErrValidation)
+	}
+
+	folders, err := c.Sender.Folders()
+	if err != nil {
+		return 0, err
+	}
+
+	var parent int32
+	created := false
+	for i, name := range names {
+		id, err := ResolveFolder(folders, strings.Join(names[:i+1], FolderSeparator))
+		if err == nil {
+			parent = id
+			continue
+		}
+		id, err = c.Sender.CreateFolder(parent, name)
+		if err != nil {
+			return 0, err
+		}
+		folders = append(folders, &Folder{Id: id, ParentId: parent, Name: name})
+		parent, created = id, true
+	}
+	if !created {
+		return 0, fmt.Errorf("%w: папка %q уже существует", ErrValidation, folderPath)
+	}
+
+	return parent, nil
+}
+
+// MoveFolder переименование и перемещение папки: to - новый путь, родительская папка должна существовать
+func (c *Commands) MoveFolder(ref string, to string) (int32, error) {
+	folders, err := c.Sender.Folders()
+	if err != nil {
+		return 0, err
+	}
+	id, err := ResolveFolder(folders, ref)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, fmt.Errorf("%w: корень нельзя переместить", ErrValidation)
+	}
+
+	names := splitFolderPath(to)
+	if len(names) == 0 {
+		return 0, fmt.Errorf("%w: не указан новый путь папки", ErrValidation)
+	}
+	parent, err := ResolveFolder(folders, strings.Join(names[:len(names)-1], FolderSeparator))
+	if err != nil {
+		return 0, err
+	}
+
+	return id, c.Sender.UpdateFolder(Folder{Id: id, ParentId: parent, Name: names[len(names)-1]})
+}
+
+// RemoveFolder удаление папки, вложенные папки и сущности переносятся в родительскую папку
+func (c *Commands) RemoveFolder(ref string) (int32, error) {
+	folders, err := c.Sender.Folders()
+	if err != nil {
+		return 0, err
+	}
+	id, err := ResolveFolder(folders, ref)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, fmt.Errorf("%w: корень нельзя удалить", ErrValidation)
+	}
+
+	return id, c.Sender.DeleteFolder(id)
+}
+
+// FolderFilter отбор списка по папке (путь или ID, FolderSeparator - вне папок) и тегу
+func (c *Commands) FolderFilter(folder string, tag string) (ListFilter, error) {
+	filter := ListFilter{Tag: strings.TrimSpace(tag)}
+	if folder == "" {
+		return filter, nil
+	}
+
+	folders, err := c.Sender.Folders()
+	if err != nil {
+		return filter, err
+	}
+	filter.FolderId, err = ResolveFolder(folders, folder)
+	if filter.FolderId == 0 && err == nil {
+		filter.FolderId = constants.RootFolder
+	}
+
+	return filter, err
+}
+
+// place размещение сущности: папка и изменение набора тегов
+func (c *Commands) place(ent *Entity, place Placement) error {
+	if place.Folder != "" {
+		folders, err := c.Sender.Folders()
+		if err != nil {
+			return err
+		}
+		ent.FolderId, err = ResolveFolder(folders, place.Folder)
+		if err != nil {
+			return err
+		}
+	}
+
+	removed := make(map[string]bool, len(place.Untags))
+	for _, tag := range place.Untags {
+		removed[strings.ToLower(strings.TrimSpace(tag))] = true
+	}
+	var tags []string
+	for _, tag := range normalizeTags(append(append([]string(nil), ent.Tags...), place.Tags...)) {
+		if !removed[strings.ToLower(tag)] {
+			tags = append(tags, tag)
+		}
+	}
+	ent.Tags = tags
+
+	return nil
+}
+
+// entity получение сущности, отсутствие сущности - ошибка ErrNotFound
+func (c *Commands) entity(id int32) (*Entity, error) {
+	return c.getEntity(id, c.Sender.Entity)
+}
+
+// openEntity получение сущности, открытой пользователем, с отметкой в списке последних открытых
+func (c *Commands) openEntity(id int32) (*Entity, error) {
+	return c.getEntity(id, c.Sender.OpenEntity)
+}
+
+// getEntity получение сущности функцией get, отсутствие сущности - ошибка ErrNotFound
+func (c *Commands) getEntity(id int32, get func(id int32) (*Entity, error)) (*Entity, error) {
+	ent, err := get(id)
+	if err != nil {
+		return nil, err
+	}
+	if ent == nil || ent.Etype == "" {
+		return nil, fmt.Errorf("%w: сущность %v", ErrNotFound, id)
+	}
+
+	return ent, nil
+}
+
+// entityCodes справочник типов сущностей
+func (c *Commands) entityCodes() ([]*EntityCode, error) {
+	if c.codes != nil {
+		return c.codes, nil
+	}
+
+	codes, err := c.Sender.EntityCodes()
+	if err != nil {
+		return nil, err
+	}
+	c.codes = codes
+
+	return codes, nil
+}
+
+// fieldsOf описания полей типа сущности в порядке следования в форме
+func (c *Commands) fieldsOf(etype string) ([]*Field, error) {
+	if fields, ok := c.fields[etype]; ok {
+		return fields, nil
+	}
+
+	fields, err := c.Sender.Fields(etype)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: тип сущности %v", ErrNotFound, etype)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].Position != fields[j].Position {
+			return fields[i].Position < fields[j].Position
+		}
+		return fields[i].Id < fields[j].Id
+	})
+	c.fields[etype] = fields
+
+	return fields, nil
+}
+
+// validate проверка значения поля по правилам валидации и типу поля и приведение к виду, в котором оно хранится
+func (c *Commands) validate(field *Field, value string) (string, error) {
+	if field.ValidateRules != "" {
+		err := c.validator.Var(value, field.ValidateRules)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v: %v", ErrValidation, field.Name, validationMessage(err, field.ValidateMessages))
+		}
+	}
+	value, err := fieldTypeValue(field, value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v: %v", ErrValidation, field.Name, err)
+	}
+
+	return normalizeValue(field, value), nil
+}
+
+// validationMessage сообщения о непройденной валидации из описания поля
+func validationMessage(err error, validateMessages string) string {
+	var vm map[string]string
+	_ = json.Unmarshal([]byte(validateMessages), &vm)
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return err.Error()
+	}
+
+	messages := make([]string, 0, len(verrs))
+	for _, verr := range verrs {
+		message := verr.Error()
+		if val, ok := vm[verr.Tag()]; ok {
+			message = strings.Replace(val, "<param>", verr.Param(), -1)
+		}
+		messages = append(messages, message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// checkFieldNames проверка, что все переданные названия полей есть у типа сущности
+func checkFieldNames(fields []*Field, values map[string]string) error {
+	for name := range values {
+		found := false
+		for _, field := range fields {
+			if strings.EqualFold(field.Name, name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: поле %q", ErrNotFound, name)
+		}
+	}
+
+	return nil
+}
+
+// lookupValue значение по названию поля без учета регистра
+func lookupValue(values map[string]string, name string) string {
+	value, _ := lookupValueOk(values, name)
+	return value
+}
+
+// lookupValueOk значение по названию поля без учета регистра и признак его наличия
+func lookupValueOk(values map[string]string, name string) (string, bool) {
+	for key, value := range values {
+		if strings.EqualFold(key, name) {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+// fieldLookupOf получение описания поля по ID из списка полей
+func fieldLookupOf(fields []*Field) fieldLookup {
+	return func(fieldID int32) *Field {
+		return fieldByID(fields, fieldID)
+	}
+}
+
+// propByFieldID свойство сущности по ID поля
+func propByFieldID(props []*Property, fieldID int32) *Property {
+	for _, prop := range props {
+		if prop.FieldId == fieldID {
+			return prop
+		}
+	}
+
+	return nil
+}
+
+// isFileEntity тип сущности, данные которой хранятся в файле
+func isFileEntity(etype string) bool {
+	return etype == constants.BinaryEntity || etype == constants.TextEntity
+}
+
+// moveFile перемещение файла, между разными файловыми системами - копированием
+func moveFile(from string, to string) error {
+	err := os.MkdirAll(filepath.Dir(to), 0755)
+	if err != nil {
+		return err
+	}
+	if os.Rename(from, to) == nil {
+		return nil
+	}
+
+	src, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(to, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		dst.Close()
+		return err
+	}
+	err = dst.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Remove(from)
+}
@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// newTestCommands команды над хранилищем с полями logopas, card и binary
+func newTestCommands(t *testing.T) (*Commands, *MockSender) {
+	sender := NewMockSender(gomock.NewController(t))
+
+	fields := map[string][]*Field{
+		constants.LogopasEntity: {
+			{Id: 1, Etype: constants.LogopasEntity, Name: "Логин", Ftype: constants.FieldTypeString, ValidateRules: "required", ValidateMessages: `{"required": "Логин не может быть пустым"}`},
+			{Id: 2, Etype: constants.LogopasEntity, Name: "Пароль", Ftype: constants.FieldTypeString, ValidateRules: "required", ValidateMessages: `{"required": "Пароль не может быть пустым"}`},
+		},
+		constants.CardEntity: {
+			{Id: 3, Etype: constants.CardEntity, Name: "Номер банковской карты", Ftype: constants.FieldTypeString, ValidateRules: "credit_card", ValidateMessages: `{"credit_card": "Неправильный формат номера карты"}`},
+		},
+		constants.BinaryEntity: {
+			{Id: 5, Etype: constants.BinaryEntity, Name: "Произвольные бинарные данные (путь к файлу)", Ftype: constants.FieldTypePath, ValidateRules: "required,file", ValidateMessages: `{"file": "Файла не существует"}`},
+		},
+	}
+	sender.EXPECT().Fields(gomock.Any()).DoAndReturn(func(etype string) ([]*Field, error) {
+		return fields[etype], nil
+	}).AnyTimes()
+
+	cmds, err := NewCommands(sender)
+	require.NoError(t, err)
+
+	return cmds, sender
+}
+
+func TestCommandsList(t *testing.T) {
+	cmds, sender := newTestCommands(t)
+
+	sender.EXPECT().EntityCodes().Return([]*EntityCode{{Etype: constants.LogopasEntity}, {Etype: constants.CardEntity}}, nil)
+	sender.EXPECT().EntityList(constants.LogopasEntity).Return(map[int32]string{7: "сайт:github. ", 2: "сайт:gitlab. "}, nil).Times(2)
+	sender.EXPECT().EntityList(constants.CardEntity).Return(map[int32]string{5: "банк:Альфа. "}, nil)
+
+	items, err := cmds.List("")
+	require.NoError(t, err)
+	assert.Equal(t, []ListItem{
+		{Id: 2, Etype: constants.LogopasEntity, Title: "сайт:gitlab."},
+		{Id: 5, Etype: constants.CardEntity, Title: "банк:Альфа."},
+		{Id: 7, Etype: constants.LogopasEntity, Title: "сайт:github."},
+	}, items)
+
+	items, err = cmds.List(constants.LogopasEntity)
+	require.NoError(t, err)
+	assert.Len(t, items, 2)
+
+	_, err = cmds.List("unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCommandsGet(t *testing.T) {
+	cmds, sender := newTestCommands(t)
+
+	sender.EXPECT().Entity(int32(7)).Return(logopas(7, "alice", "s3cret", &Metainfo{Title: "сайт", Value: "github"}), nil).AnyTimes()
+	sender.EXPECT().Entity(int32(8)).Return(&Entity{Id: 8, Etype: constants.BinaryEntity, Props: []*Property{
+		{FieldId: 5, Value: `{"servername": "/srv/1", "clientname": "/home/alice/report.pdf", "chunkcount": 1}`},
+	}}, nil)
+	sender.EXPECT().Entity(int32(9)).Return(&Entity{Id: 9}, nil).AnyTimes()
+
+	view, err := cmds.Get(7)
+	require.NoError(t, err)
+	assert.Equal(t, &EntityView{
+		Id:       7,
+		Etype:    constants.LogopasEntity,
+		Fields:   []FieldValue{{Name: "Логин", Value: "alice"}, {Name: "Пароль", Value: "s3cret"}},
+		Metainfo: []FieldValue{{Name: "сайт", Value: "github"}},
+	}, view)
+
+	view, err = cmds.Get(8)
+	require.NoError(t, err)
+	assert.Equal(t, "report.pdf", view.Fields[0].Value)
+
+	value, err := cmds.GetField(7, "Пароль")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", value)
+
+	_, err = cmds.GetField(7, "Пин")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = cmds.Get(9)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, cmds.Remove(9), ErrNotFound)
+}
+
+func TestCommandsAddEdit(t *testing.T) {
+	cmds, sender := newTestCommands(t)
+
+	metas := []*Metainfo{{Title: "сайт", Value: "github"}}
+	sender.EXPECT().AddEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, constants.LogopasEntity, ent.Etype)
+		assert.Equal(t, []*Property{{FieldId: 1, Value: "alice"}, {FieldId: 2, Value: "s3cret"}}, ent.Props)
+		assert.Equal(t, metas, ent.Metainfo)
+		return 7, nil
+	})
+	id, err := cmds.Add(constants.LogopasEntity, map[string]string{"логин": "alice", "Пароль": "s3cret"}, metas, Placement{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), id)
+
+	// непройденная валидация, неизвестное поле и тип, файловая сущность
+	_, err = cmds.Add(constants.LogopasEntity, map[string]string{"Логин": "alice"}, nil, Placement{})
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Contains(t, err.Error(), "Пароль не может быть пустым")
+	_, err = cmds.Add(constants.CardEntity, map[string]string{"Номер банковской карты": "1234"}, nil, Placement{})
+	assert.ErrorIs(t, err, ErrValidation)
+	assert.Contains(t, err.Error(), "Неправильный формат номера карты")
+	_, err = cmds.Add(constants.LogopasEntity, map[string]string{"Логин": "alice", "Пароль": "1", "Пин": "1"}, nil, Placement{})
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = cmds.Add("unknown", nil, nil, Placement{})
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = cmds.Add(constants.BinaryEntity, nil, nil, Placement{})
+	assert.ErrorIs(t, err, ErrValidation)
+
+	// изменение пароля, замена и добавление метаданных
+	sender.EXPECT().Entity(int32(7)).Return(logopas(7, "alice", "s3cret", &Metainfo{EntityId: 7, Title: "сайт", Value: "github"}), nil).AnyTimes()
+	sender.EXPECT().SaveEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, "alice", ent.Props[0].Value)
+		assert.Equal(t, "new", ent.Props[1].Value)
+		assert.Equal(t, []*Metainfo{{EntityId: 7, Title: "сайт", Value: "gitlab"}, {EntityId: 7, Title: "env", Value: "prod"}}, ent.Metainfo)
+		return 7, nil
+	})
+	require.NoError(t, cmds.Edit(7, map[string]string{"Пароль": "new"}, []*Metainfo{{Title: "Сайт", Value: "gitlab"}, {Title: "env", Value: "prod"}}, Placement{}))
+
+	assert.ErrorIs(t, cmds.Edit(7, map[string]string{"Пароль": ""}, nil, Placement{}), ErrValidation)
+}
+
+func TestCommandsFiles(t *testing.T) {
+	cmds, sender := newTestCommands(t)
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "report.pdf")
+	require.NoError(t, os.WriteFile(file, []byte("pdf"), 0600))
+
+	sender.EXPECT().AddEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, constants.BinaryEntity, ent.Etype)
+		assert.Equal(t, file, ent.Props[0].Value)
+		return 8, nil
+	})
+	sender.EXPECT().UploadCryptoBinary(int32(8), file).Return(int32(3), nil)
+	id, size, err := cmds.Upload(constants.BinaryEntity, file, nil, Placement{})
+	require.NoError(t, err)
+	assert.Equal(t, int32(8), id)
+	assert.Equal(t, int32(3), size)
+
+	_, _, err = cmds.Upload(constants.BinaryEntity, filepath.Join(dir, "absent"), nil, Placement{})
+	assert.ErrorIs(t, err, ErrValidation)
+	_, _, err = cmds.Upload(constants.LogopasEntity, file, nil, Placement{})
+	assert.ErrorIs(t, err, ErrValidation)
+
+	// скачанный в директорию загрузок файл перемещается в указанное место
+	downloaded := filepath.Join(dir, "1_report.pdf")
+	require.NoError(t, os.WriteFile(downloaded, []byte("pdf"), 0600))
+	sender.EXPECT().Entity(int32(8)).Return(&Entity{Id: 8, Etype: constants.BinaryEntity, Props: []*Property{
+		{FieldId: 5, Value: `{"servername": "/srv/1", "clientname": "/home/alice/report.pdf", "chunkcount": 1}`},
+	}}, nil)
+	sender.EXPECT().DownloadCryptoBinary(int32(8), "report.pdf").Return(downloaded, nil)
+
+	out := filepath.Join(dir, "out", "report.pdf")
+	path, err := cmds.Download(8, out)
+	require.NoError(t, err)
+	assert.Equal(t, out, path)
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "pdf", string(data))
+	assert.NoFileExists(t, downloaded)
+}
@@ -0,0 +1,202 @@
+// Пользовательские типы сущностей: создание, изменение и перемещение в архив
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// опции описания поля пользовательского типа (остальные опции - правила валидации)
+const (
+	fieldOptionSecret  = "secret"   // значение поля секретно
+	fieldOptionType    = "type="    // тип поля: string, secret, multiline, url, date, monthyear, number, select
+	fieldOptionOptions = "options=" // варианты значения поля select через |
+)
+
+// ParseFieldDef разбор описания поля пользовательского типа вида "Название[:опция,опция...]"
+// Опция secret делает поле секретным, type=<тип> задает тип поля, options=a|b|c - варианты значения поля select,
+// остальные опции - правила валидации (required, email, max=64 ...)
+func ParseFieldDef(def string) (*Field, error) {
+	name, options, _ := strings.Cut(def, ":")
+	field := &Field{Name: strings.TrimSpace(name), Ftype: constants.FieldTypeString}
+	if field.Name == "" {
+		return nil, fmt.Errorf("%w: не указано название поля в описании %q", ErrValidation, def)
+	}
+
+	var rules []string
+	for _, option := range strings.Split(options, ",") {
+		option = strings.TrimSpace(option)
+		switch {
+		case option == "":
+		case option == fieldOptionSecret:
+			field.Secret = true
+		case strings.HasPrefix(option, fieldOptionType):
+			field.Ftype = strings.TrimSpace(strings.TrimPrefix(option, fieldOptionType))
+		case strings.HasPrefix(option, fieldOptionOptions):
+			for _, o := range strings.Split(strings.TrimPrefix(option, fieldOptionOptions), "|") {
+				if o = strings.TrimSpace(o); o != "" {
+					field.Options = append(field.Options, o)
+				}
+			}
+		default:
+			rules = append(rules, option)
+		}
+	}
+	field.ValidateRules = strings.Join(rules, ",")
+	if field.Ftype == constants.FieldTypeSelect && len(field.Options) == 0 {
+		return nil, fmt.Errorf("%w: не указаны варианты значения поля %q (options=a|b)", ErrValidation, field.Name)
+	}
+
+	return field, nil
+}
+
+// Types справочник типов сущностей: встроенные и созданные пользователем
+func (c *Commands) Types() ([]*EntityCode, error) {
+	c.codes = nil
+
+	return c.entityCodes()
+}
+
+// MakeType создание пользовательского типа сущности с полями по описаниям ParseFieldDef
+// Порядок полей в форме - порядок описаний
+func (c *Commands) MakeType(name string, defs []string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", fmt.Errorf("%w: не указано название типа", ErrValidation)
+	}
+	if len(defs) == 0 {
+		return "", fmt.Errorf("%w: не указано ни одного поля", ErrValidation)
+	}
+
+	fields := make([]*Field, 0, len(defs))
+	for _, def := range defs {
+		field, err := ParseFieldDef(def)
+		if err != nil {
+			return "", err
+		}
+		fields = append(fields, field)
+	}
+
+	etype, err := c.Sender.CreateEntityType(name, fields)
+	if err != nil {
+		return "", err
+	}
+	c.codes = nil
+
+	return etype, nil
+}
+
+// EditType изменение пользовательского типа сущности
+// name - новое название (пустое - не менять), renames - переименование полей (старое название: новое).
+// Описания defs с названием существующего поля заменяют его опции, с новым названием - добавляют поле.
+// Поля из defs идут в форме первыми в указанном порядке, за ними - остальные поля в прежнем порядке
+func (c *Commands) EditType(etype string, name string, defs []string, renames map[string]string) error {
+	code, err := c.customType(etype)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(name) == "" {
+		name = code.Name
+	}
+
+	current, err := c.fieldsOf(etype)
+	if err != nil {
+		return err
+	}
+	for old := range renames {
+		if fieldByName(current, old) == nil {
+			return fmt.Errorf("%w: поле %q типа %v", ErrNotFound, old, etype)
+		}
+	}
+
+	fields := make([]*Field, 0, len(current)+len(defs))
+	used := make(map[int32]bool, len(current))
+	for _, def := range defs {
+		field, err := ParseFieldDef(def)
+		if err != nil {
+			return err
+		}
+		if existing := fieldByName(current, field.Name); existing != nil {
+			field.Id, field.Name = existing.Id, existing.Name
+			used[existing.Id] = true
+		}
+		fields = append(fields, field)
+	}
+	for _, field := range current {
+		if !used[field.Id] {
+			f := *field
+			fields = append(fields, &f)
+		}
+	}
+	for _, field := range fields {
+		if newName, ok := lookupValueOk(renames, field.Name); ok && field.Id != 0 {
+			field.Name = newName
+		}
+	}
+
+	err = c.Sender.UpdateEntityType(etype, name, fields)
+	if err != nil {
+		return err
+	}
+	c.codes = nil
+	delete(c.fields, etype)
+
+	return nil
+}
+
+// ArchiveType перемещение пользовательского типа в архив (archived = true) или возврат из архива
+// Сущности архивного типа остаются доступными, новые не добавляются
+func (c *Commands) ArchiveType(etype string, archived bool) error {
+	_, err := c.customType(etype)
+	if err != nil {
+		return err
+	}
+
+	err = c.Sender.ArchiveEntityType(etype, archived)
+	if err != nil {
+		return err
+	}
+	c.codes = nil
+
+	return nil
+}
+
+// customType пользовательский тип сущности, встроенные типы не изменяются
+func (c *Commands) customType(etype string) (*EntityCode, error) {
+	codes, err := c.Types()
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range codes {
+		if code.Etype != etype {
+			continue
+		}
+		if !code.Custom {
+			return nil, fmt.Errorf("%w: встроенный тип %v изменить нельзя", ErrValidation, etype)
+		}
+		return code, nil
+	}
+
+	return nil, fmt.Errorf("%w: тип сущности %v", ErrNotFound, etype)
+}
+
+// fieldByName поиск поля по названию без учета регистра
+func fieldByName(fields []*Field, name string) *Field {
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, strings.TrimSpace(name)) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// typeTitle название типа в меню, архивные типы помечаются
+func typeTitle(code *EntityCode) string {
+	if code.Archived {
+		return code.Name + " (в архиве)"
+	}
+
+	return code.Name
+}
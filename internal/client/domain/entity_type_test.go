@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func TestParseFieldDef(t *testing.T) {
+	field, err := ParseFieldDef(" Пароль :secret, required ,max=64")
+	require.NoError(t, err)
+	assert.Equal(t, &Field{Name: "Пароль", Ftype: constants.FieldTypeString, Secret: true, ValidateRules: "required,max=64"}, field)
+
+	field, err = ParseFieldDef("SSID")
+	require.NoError(t, err)
+	assert.Equal(t, &Field{Name: "SSID", Ftype: constants.FieldTypeString}, field)
+
+	field, err = ParseFieldDef("Шифрование:type=select,options=WPA2|WPA3,required")
+	require.NoError(t, err)
+	assert.Equal(t, &Field{Name: "Шифрование", Ftype: constants.FieldTypeSelect, Options: []string{"WPA2", "WPA3"}, ValidateRules: "required"}, field)
+
+	_, err = ParseFieldDef(":secret")
+	assert.ErrorIs(t, err, ErrValidation)
+	_, err = ParseFieldDef("Шифрование:type=select")
+	assert.ErrorIs(t, err, ErrValidation)
+}
+
+func TestCommandsTypes(t *testing.T) {
+	sender := NewMockSender(gomock.NewController(t))
+	cmds, err := NewCommands(sender)
+	require.NoError(t, err)
+
+	const wifi = "u1_0a0b0c0d"
+	sender.EXPECT().EntityCodes().Return([]*EntityCode{
+		{Etype: constants.LogopasEntity, Name: "Логин/пароль"},
+		{Etype: wifi, Name: "Wi-Fi", Custom: true},
+	}, nil).AnyTimes()
+	sender.EXPECT().Fields(wifi).Return([]*Field{
+		{Id: 21, Etype: wifi, Name: "SSID", Ftype: constants.FieldTypeString, Position: 1},
+		{Id: 22, Etype: wifi, Name: "Пароль", Ftype: constants.FieldTypeString, Secret: true, Position: 2},
+	}, nil).AnyTimes()
+
+	_, err = cmds.MakeType("Сервер", nil)
+	assert.ErrorIs(t, err, ErrValidation)
+	sender.EXPECT().CreateEntityType("Сервер", []*Field{
+		{Name: "Хост", Ftype: constants.FieldTypeString, ValidateRules: "required"},
+		{Name: "Пароль", Ftype: constants.FieldTypeString, Secret: true},
+	}).Return("u1_01020304", nil)
+	etype, err := cmds.MakeType("Сервер", []string{"Хост:required", "Пароль:secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "u1_01020304", etype)
+
+	// встроенные типы не изменяются
+	assert.ErrorIs(t, cmds.EditType(constants.LogopasEntity, "x", nil, nil), ErrValidation)
+	assert.ErrorIs(t, cmds.ArchiveType("nosuch", true), ErrNotFound)
+	assert.ErrorIs(t, cmds.EditType(wifi, "", nil, map[string]string{"Нет": "Да"}), ErrNotFound)
+
+	// новое поле первым, существующее поле меняет опции, переименование сохраняет ID
+	sender.EXPECT().UpdateEntityType(wifi, "Wi-Fi", []*Field{
+		{Name: "Комментарий", Ftype: constants.FieldTypeString},
+		{Id: 22, Name: "Пароль", Ftype: constants.FieldTypeString, ValidateRules: "required"},
+		{Id: 21, Etype: wifi, Name: "Сеть", Ftype: constants.FieldTypeString, Position: 1},
+	}).Return(nil)
+	err = cmds.EditType(wifi, "", []string{"Комментарий", "пароль:required"}, map[string]string{"SSID": "Сеть"})
+	require.NoError(t, err)
+
+	sender.EXPECT().ArchiveEntityType(wifi, true).Return(nil)
+	require.NoError(t, cmds.ArchiveType(wifi, true))
+	assert.Equal(t, "Wi-Fi (в архиве)", typeTitle(&EntityCode{Name: "Wi-Fi", Archived: true}))
+}
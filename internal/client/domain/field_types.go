@@ -0,0 +1,216 @@
+// Типы полей с особым поведением при вводе и отображении: секретные строки, многострочный текст,
+// адреса, даты, числа и выбор из списка вариантов
+package domain
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+const (
+	dateLayout      = "2006-01-02" // формат хранения поля date
+	monthYearLayout = "01/06"      // формат хранения поля monthyear
+	secretMask      = "********"   // секретное значение при просмотре
+)
+
+// dateLayouts допустимые форматы ввода даты
+var dateLayouts = []string{dateLayout, "02.01.2006", "02/01/2006", "2.1.2006"}
+
+// monthYearLayouts допустимые форматы ввода месяца и года
+var monthYearLayouts = []string{monthYearLayout, "1/06", "01/2006", "1/2006", "01.06", "01.2006", "2006-01"}
+
+// fieldTypeValue проверка значения по типу поля и приведение к виду, в котором оно хранится
+// Пустое значение не проверяется: обязательность поля задается правилом валидации required
+func fieldTypeValue(field *Field, value string) (string, error) {
+	if field == nil || strings.TrimSpace(value) == "" {
+		return value, nil
+	}
+
+	switch field.Ftype {
+	case constants.FieldTypeURL:
+		value = strings.TrimSpace(value)
+		u, err := url.ParseRequestURI(value)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return "", fmt.Errorf("ожидается адрес вида https://example.com")
+		}
+		return value, nil
+
+	case constants.FieldTypeDate:
+		t, ok := parseTime(strings.TrimSpace(value), dateLayouts)
+		if !ok {
+			return "", fmt.Errorf("ожидается дата в формате ГГГГ-ММ-ДД или ДД.ММ.ГГГГ")
+		}
+		return t.Format(dateLayout), nil
+
+	case constants.FieldTypeMonthYear:
+		t, ok := parseTime(strings.TrimSpace(value), monthYearLayouts)
+		if !ok {
+			return "", fmt.Errorf("ожидаются месяц и год в формате ММ/ГГ")
+		}
+		return t.Format(monthYearLayout), nil
+
+	case constants.FieldTypeNumber:
+		value = strings.ReplaceAll(strings.TrimSpace(value), ",", ".")
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsInf(n, 0) || math.IsNaN(n) {
+			return "", fmt.Errorf("ожидается число")
+		}
+		return value, nil
+
+	case constants.FieldTypeSelect:
+		return selectOption(field, value)
+	}
+
+	return value, nil
+}
+
+// parseTime разбор времени по первому подходящему формату
+func parseTime(value string, layouts []string) (time.Time, bool) {
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// selectOption вариант значения поля select по названию (без учета регистра) или номеру в списке
+func selectOption(field *Field, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	for _, option := range field.Options {
+		if strings.EqualFold(option, value) {
+			return option, nil
+		}
+	}
+	if n, err := strconv.Atoi(value); err == nil && n >= 1 && n <= len(field.Options) {
+		return field.Options[n-1], nil
+	}
+
+	return "", fmt.Errorf("допустимые значения: %v", strings.Join(field.Options, ", "))
+}
+
+// fieldTypeHint подсказка к полю ввода по типу поля
+func fieldTypeHint(field *Field) string {
+	switch field.Ftype {
+	case constants.FieldTypeURL:
+		return "https://..."
+	case constants.FieldTypeDate:
+		return "ГГГГ-ММ-ДД"
+	case constants.FieldTypeMonthYear:
+		return "ММ/ГГ"
+	case constants.FieldTypeNumber:
+		return "число"
+	case constants.FieldTypeSelect:
+		return strings.Join(field.Options, " | ")
+	case constants.FieldTypeMultiline:
+		return `\n - перевод строки`
+	}
+
+	return ""
+}
+
+// editorCommand команда редактора многострочных значений: $VISUAL, $EDITOR или vi
+func editorCommand() []string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if cmd := strings.Fields(os.Getenv(env)); len(cmd) > 0 {
+			return cmd
+		}
+	}
+
+	return []string{"vi"}
+}
+
+// editText редактирование многострочного значения во внешнем редакторе
+// Значение передается через файл в новой папке, доступной только владельцу (в $XDG_RUNTIME_DIR, обычно tmpfs,
+// иначе во временной папке системы). После редактирования файлы папки затираются и папка удаляется
+func editText(initial string) (string, error) {
+	base := os.Getenv("XDG_RUNTIME_DIR")
+	if base == "" {
+		base = os.TempDir()
+	}
+	dir, err := os.MkdirTemp(base, "gophkeeper-*")
+	if err != nil {
+		return "", err
+	}
+	defer wipeDir(dir)
+
+	name := filepath.Join(dir, "value.txt")
+	err = os.WriteFile(name, []byte(initial), 0600)
+	if err != nil {
+		return "", err
+	}
+
+	editor := editorCommand()
+	cmd := exec.Command(editor[0], append(editor[1:], name)...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	err = cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("редактор %v: %w", editor[0], err)
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	// редакторы добавляют перевод строки в конец файла
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// wipeDir затирание нулями файлов папки (значение и резервные копии редактора) и удаление папки
+func wipeDir(dir string) {
+	entries, _ := os.ReadDir(dir)
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+		file, err := os.OpenFile(filepath.Join(dir, entry.Name()), os.O_WRONLY, 0)
+		if err != nil {
+			continue
+		}
+		if info, err := file.Stat(); err == nil {
+			file.Write(make([]byte, info.Size()))
+			file.Sync()
+		}
+		file.Close()
+	}
+	os.RemoveAll(dir)
+}
+
+// escapeMultiline многострочное значение в одну строку для однострочного поля ввода
+func escapeMultiline(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(value)
+}
+
+// unescapeMultiline многострочное значение из однострочного поля ввода
+func unescapeMultiline(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			switch value[i+1] {
+			case 'n':
+				b.WriteByte('\n')
+				i++
+				continue
+			case '\\':
+				b.WriteByte('\\')
+				i++
+				continue
+			}
+		}
+		b.WriteByte(value[i])
+	}
+
+	return b.String()
+}
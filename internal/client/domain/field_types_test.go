@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func TestFieldTypeValue(t *testing.T) {
+	tests := []struct {
+		ftype string
+		in    string
+		want  string
+		ok    bool
+	}{
+		{constants.FieldTypeString, " как есть ", " как есть ", true},
+		{constants.FieldTypeURL, " https://example.com/login ", "https://example.com/login", true},
+		{constants.FieldTypeURL, "example.com", "", false},
+		{constants.FieldTypeDate, "2024-02-29", "2024-02-29", true},
+		{constants.FieldTypeDate, "01.02.2024", "2024-02-01", true},
+		{constants.FieldTypeDate, "30.02.2024", "", false},
+		{constants.FieldTypeMonthYear, "12/24", "12/24", true},
+		{constants.FieldTypeMonthYear, "3/2027", "03/27", true},
+		{constants.FieldTypeMonthYear, "13/24", "", false},
+		{constants.FieldTypeNumber, "-12,5", "-12.5", true},
+		{constants.FieldTypeNumber, "12 руб", "", false},
+		{constants.FieldTypeSelect, "wpa3", "WPA3", true},
+		{constants.FieldTypeSelect, "1", "WPA2", true},
+		{constants.FieldTypeSelect, "WEP", "", false},
+		{constants.FieldTypeNumber, "", "", true}, // пустое значение проверяется правилом required
+	}
+	for _, tt := range tests {
+		field := &Field{Name: "f", Ftype: tt.ftype, Options: []string{"WPA2", "WPA3"}}
+		got, err := fieldTypeValue(field, tt.in)
+		if !tt.ok {
+			assert.Error(t, err, tt.ftype+" "+tt.in)
+			continue
+		}
+		require.NoError(t, err, tt.ftype+" "+tt.in)
+		assert.Equal(t, tt.want, got, tt.ftype+" "+tt.in)
+	}
+}
+
+func TestMultilineEscape(t *testing.T) {
+	value := "строка 1\nстрока 2 \\n не перевод"
+	escaped := escapeMultiline(value)
+	assert.NotContains(t, escaped, "\n")
+	assert.Equal(t, value, unescapeMultiline(escaped))
+	assert.Equal(t, "a\nb", unescapeMultiline(`a\nb`))
+}
+
+func TestEditText(t *testing.T) {
+	// редактор дописывает строку в файл и запоминает путь к нему
+	runtimeDir, tmp := t.TempDir(), t.TempDir()
+	editor := filepath.Join(tmp, "editor.sh")
+	pathFile := filepath.Join(tmp, "path")
+	require.NoError(t, os.WriteFile(editor, []byte("#!/bin/sh\nprintf '\\nвторая\\n' >> \"$1\"\nprintf '%s' \"$1\" > "+pathFile+"\n"), 0o700))
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", editor)
+	t.Setenv("XDG_RUNTIME_DIR", runtimeDir)
+	value, err := editText("первая")
+	require.NoError(t, err)
+	assert.Equal(t, "первая\nвторая", value)
+
+	// файл создавался в отдельной папке в $XDG_RUNTIME_DIR и удален вместе с ней
+	path, err := os.ReadFile(pathFile)
+	require.NoError(t, err)
+	assert.Equal(t, runtimeDir, filepath.Dir(filepath.Dir(string(path))))
+	assert.NoDirExists(t, filepath.Dir(string(path)))
+}
+
+func TestWipeDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "edit")
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	name := filepath.Join(dir, "value.txt")
+	require.NoError(t, os.WriteFile(name, []byte("секрет"), 0o600))
+
+	// ссылка на затертый файл сохраняет его данные после удаления папки
+	link := filepath.Join(t.TempDir(), "link")
+	require.NoError(t, os.Link(name, link))
+
+	wipeDir(dir)
+	assert.NoDirExists(t, dir)
+	data, err := os.ReadFile(link)
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, len("секрет")), data)
+}
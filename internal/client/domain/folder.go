@@ -0,0 +1,142 @@
+// Папки и теги для группировки сущностей
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FolderSeparator разделитель названий папок в пути
+const FolderSeparator = "/"
+
+// Folder папка пользователя
+type Folder struct {
+	Id       int32  // ID папки
+	ParentId int32  // ID родительской папки, 0 - папка верхнего уровня
+	Name     string // название
+}
+
+// ListFilter отбор сущностей в списке
+type ListFilter struct {
+	FolderId int32  // ID папки, 0 - без отбора, constants.RootFolder - только сущности вне папок
+	Tag      string // тег, пустая строка - без отбора
+	Favorite bool   // только избранные сущности
+	Recent   int32  // только N последних открытых сущностей, 0 - без отбора
+}
+
+// Empty отбор не задан
+func (f ListFilter) Empty() bool {
+	return f.FolderId == 0 && f.Tag == "" && !f.Favorite && f.Recent == 0
+}
+
+// FolderNode папка с полным путем и глубиной вложенности для вывода дерева
+type FolderNode struct {
+	Id       int32  `json:"id" yaml:"id"`             // ID папки
+	ParentId int32  `json:"parentId" yaml:"parentId"` // ID родительской папки
+	Name     string `json:"name" yaml:"name"`         // название
+	Path     string `json:"path" yaml:"path"`         // путь от корня через FolderSeparator
+	Depth    int    `json:"-" yaml:"-"`               // уровень вложенности, 0 - верхний уровень
+}
+
+// FolderTree папки в порядке обхода дерева: за каждой папкой следуют вложенные, соседние - по названию
+func FolderTree(folders []*Folder) []FolderNode {
+	children := make(map[int32][]*Folder)
+	known := make(map[int32]bool, len(folders))
+	for _, f := range folders {
+		known[f.Id] = true
+	}
+	for _, f := range folders {
+		parent := f.ParentId
+		if !known[parent] {
+			parent = 0
+		}
+		children[parent] = append(children[parent], f)
+	}
+	for _, list := range children {
+		sort.Slice(list, func(i, j int) bool {
+			if list[i].Name != list[j].Name {
+				return list[i].Name < list[j].Name
+			}
+			return list[i].Id < list[j].Id
+		})
+	}
+
+	nodes := make([]FolderNode, 0, len(folders))
+	var walk func(parent int32, prefix string, depth int)
+	walk = func(parent int32, prefix string, depth int) {
+		for _, f := range children[parent] {
+			p := prefix + f.Name
+			nodes = append(nodes, FolderNode{Id: f.Id, ParentId: f.ParentId, Name: f.Name, Path: p, Depth: depth})
+			walk(f.Id, p+FolderSeparator, depth+1)
+		}
+	}
+	walk(0, "", 0)
+
+	return nodes
+}
+
+// FolderPath полный путь папки, пустая строка - папка не найдена
+func FolderPath(folders []*Folder, id int32) string {
+	for _, node := range FolderTree(folders) {
+		if node.Id == id {
+			return node.Path
+		}
+	}
+
+	return ""
+}
+
+// ResolveFolder ID папки по пути или числовому ID
+// Пустая строка и FolderSeparator - корень (0), путь сравнивается без учета регистра
+func ResolveFolder(folders []*Folder, ref string) (int32, error) {
+	ref = strings.Join(splitFolderPath(ref), FolderSeparator)
+	if ref == "" {
+		return 0, nil
+	}
+
+	if num, err := strconv.ParseInt(ref, 10, 32); err == nil {
+		for _, f := range folders {
+			if f.Id == int32(num) {
+				return f.Id, nil
+			}
+		}
+	}
+	for _, node := range FolderTree(folders) {
+		if strings.EqualFold(node.Path, ref) {
+			return node.Id, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: папка %q", ErrNotFound, ref)
+}
+
+// normalizeTags теги без крайних пробелов и повторов (без учета регистра), в исходном порядке
+func normalizeTags(tags []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// splitFolderPath названия папок пути без пустых частей
+func splitFolderPath(folderPath string) []string {
+	var names []string
+	for _, name := range strings.Split(folderPath, FolderSeparator) {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func TestFolderTree(t *testing.T) {
+	folders := []*Folder{
+		{Id: 4, ParentId: 3, Name: "team"},
+		{Id: 3, Name: "work"},
+		{Id: 5, Name: "home"},
+		{Id: 6, ParentId: 99, Name: "orphan"}, // родитель недоступен - на верхний уровень
+	}
+
+	nodes := FolderTree(folders)
+	paths := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		paths = append(paths, node.Path)
+	}
+	assert.Equal(t, []string{"home", "orphan", "work", "work/team"}, paths)
+	assert.Equal(t, 1, nodes[3].Depth)
+	assert.Equal(t, "work/team", FolderPath(folders, 4))
+
+	for ref, want := range map[string]int32{"": 0, "/": 0, "Work/Team": 4, " /work/ team/": 4, "5": 5} {
+		id, err := ResolveFolder(folders, ref)
+		require.NoError(t, err, ref)
+		assert.Equal(t, want, id, ref)
+	}
+	_, err := ResolveFolder(folders, "work/absent")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCommandsFolders(t *testing.T) {
+	cmds, sender := newTestCommands(t)
+
+	sender.EXPECT().Folders().Return([]*Folder{{Id: 3, Name: "work"}}, nil).AnyTimes()
+
+	// недостающие папки пути создаются
+	sender.EXPECT().CreateFolder(int32(3), "team").Return(int32(4), nil)
+	sender.EXPECT().CreateFolder(int32(4), "ci").Return(int32(5), nil)
+	id, err := cmds.MakeFolder("work/team/ci")
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), id)
+	_, err = cmds.MakeFolder("work")
+	assert.ErrorIs(t, err, ErrValidation)
+
+	sender.EXPECT().UpdateFolder(Folder{Id: 3, Name: "job"}).Return(nil)
+	_, err = cmds.MoveFolder("work", "/job")
+	require.NoError(t, err)
+
+	sender.EXPECT().DeleteFolder(int32(3)).Return(nil)
+	_, err = cmds.RemoveFolder("3")
+	require.NoError(t, err)
+	_, err = cmds.RemoveFolder("/")
+	assert.ErrorIs(t, err, ErrValidation)
+
+	filter, err := cmds.FolderFilter("/", "dev")
+	require.NoError(t, err)
+	assert.Equal(t, ListFilter{FolderId: constants.RootFolder, Tag: "dev"}, filter)
+
+	// папка и теги при изменении: теги добавляются и удаляются без учета регистра
+	sender.EXPECT().Entity(int32(7)).Return(&Entity{
+		Id:    7,
+		Etype: constants.LogopasEntity,
+		Props: []*Property{{EntityId: 7, FieldId: 1, Value: "alice"}, {EntityId: 7, FieldId: 2, Value: "s3cret"}},
+		Tags:  []string{"dev", "old"},
+	}, nil)
+	sender.EXPECT().SaveEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, int32(3), ent.FolderId)
+		assert.Equal(t, []string{"dev", "prod"}, ent.Tags)
+		return 7, nil
+	})
+	require.NoError(t, cmds.Edit(7, nil, nil, Placement{Folder: "work", Tags: []string{"prod", "DEV"}, Untags: []string{"OLD"}}))
+}
@@ -0,0 +1,337 @@
+// Режим git credential helper: выдача и сохранение логинов и паролей для git по протоколу credential helper
+package domain
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// действия git credential helper
+const (
+	GitCredentialGet   = "get"   // выдать логин и пароль
+	GitCredentialStore = "store" // сохранить подошедшие логин и пароль
+	GitCredentialErase = "erase" // удалить отвергнутые сервером логин и пароль
+)
+
+// названия полей логина и пароля встроенного типа logopas
+const (
+	loginFieldName    = "Логин"
+	passwordFieldName = "Пароль"
+)
+
+// GitCredential git credential helper поверх сущностей logopas
+// Сущность подходит запросу git, если ее метаданные url или host указывают на тот же сервер
+type GitCredential struct {
+	Sender   Sender // отправка-получение данных на/с сервера
+	login    *Field // поле логина сущности logopas
+	password *Field // поле пароля сущности logopas
+}
+
+// gitRequest запрос git: protocol, host, path, username, password
+type gitRequest map[string]string
+
+// NewGitCredential конструктор, загружает описания полей сущности logopas
+// Sender должен быть уже аутентифицирован
+func NewGitCredential(sender Sender) (*GitCredential, error) {
+	fields, err := sender.Fields(constants.LogopasEntity)
+	if err != nil {
+		return nil, err
+	}
+	login, password := credentialFields(fields)
+	if login == nil || password == nil {
+		return nil, errors.New("не найдены описания полей логина и пароля")
+	}
+
+	return &GitCredential{
+		Sender:   sender,
+		login:    login,
+		password: password,
+	}, nil
+}
+
+// credentialFields поля логина и пароля сущности logopas
+// Поля выбираются по названию, а при его отсутствии - по типу: пароль - первое поле типа secret,
+// логин - первое открытое строковое поле формы. Порядок и состав полей типа значения не имеют
+func credentialFields(fields []*Field) (*Field, *Field) {
+	sorted := append([]*Field(nil), fields...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Position != sorted[j].Position {
+			return sorted[i].Position < sorted[j].Position
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	var login, password *Field
+	for _, field := range sorted {
+		switch {
+		case strings.EqualFold(field.Name, loginFieldName):
+			login = field
+		case strings.EqualFold(field.Name, passwordFieldName):
+			password = field
+		}
+	}
+	for _, field := range sorted {
+		switch {
+		case password == nil && field.Ftype == constants.FieldTypeSecret && field != login:
+			password = field
+		case login == nil && field.Ftype == constants.FieldTypeString && !isSecretField(field) && field != password:
+			login = field
+		}
+	}
+
+	return login, password
+}
+
+// Run выполнение действия git: запрос читается из in, ответ пишется в out
+func (g *GitCredential) Run(action string, in io.Reader, out io.Writer) error {
+	req, err := parseGitRequest(in)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case GitCredentialGet:
+		return g.get(req, out)
+	case GitCredentialStore:
+		return g.store(req)
+	case GitCredentialErase:
+		return g.erase(req)
+	}
+
+	return fmt.Errorf("неизвестное действие %v", action)
+}
+
+// parseGitRequest разбор строк key=value до пустой строки или конца ввода
+func parseGitRequest(in io.Reader) (gitRequest, error) {
+	req := make(gitRequest)
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			break
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("неверная строка запроса git: %v", line)
+		}
+		req[key] = value
+	}
+
+	return req, scanner.Err()
+}
+
+// get вывод логина и пароля первой подходящей сущности, если ничего не подошло - пустой ответ
+func (g *GitCredential) get(req gitRequest, out io.Writer) error {
+	matches, err := g.find(req)
+	if err != nil || len(matches) == 0 {
+		return err
+	}
+
+	ent := matches[0]
+	_, err = fmt.Fprintf(out, "username=%v\npassword=%v\n", g.value(ent, g.login), g.value(ent, g.password))
+
+	return err
+}
+
+// store сохранение логина и пароля: пароль существующей сущности обновляется, иначе создается новая сущность
+func (g *GitCredential) store(req gitRequest) error {
+	if req["host"] == "" || req["username"] == "" || req["password"] == "" {
+		return nil
+	}
+
+	matches, err := g.find(gitRequest{"protocol": req["protocol"], "host": req["host"], "path": req["path"], "username": req["username"]})
+	if err != nil {
+		return err
+	}
+
+	if len(matches) > 0 {
+		ent := matches[0]
+		if g.value(ent, g.password) == req["password"] {
+			return nil
+		}
+		for _, prop := range ent.Props {
+			if prop.FieldId == g.password.Id {
+				prop.Value = req["password"]
+			}
+		}
+		_, err = g.Sender.SaveEntity(*ent)
+
+		return err
+	}
+
+	_, err = g.Sender.AddEntity(Entity{
+		Etype: constants.LogopasEntity,
+		Props: []*Property{
+			{FieldId: g.login.Id, Value: req["username"]},
+			{FieldId: g.password.Id, Value: req["password"]},
+		},
+		Metainfo: []*Metainfo{
+			{Title: constants.MetaURL, Value: req.url()},
+		},
+	})
+
+	return err
+}
+
+// erase перемещение в корзину сущностей с отвергнутыми сервером логином и паролем
+// Удаляются только сущности с тем же логином, метаданные которых указывают ровно на сервер и путь из запроса
+func (g *GitCredential) erase(req gitRequest) error {
+	if req["host"] == "" || req["username"] == "" {
+		return nil
+	}
+
+	matches, err := g.find(req)
+	if err != nil {
+		return err
+	}
+
+	for _, ent := range matches {
+		if !req.matchesExactly(ent.Metainfo) {
+			continue
+		}
+		if req["password"] != "" && g.value(ent, g.password) != req["password"] {
+			continue
+		}
+		err = g.Sender.DeleteEntity(ent.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// find сущности logopas, подходящие запросу git, в порядке возрастания ID
+func (g *GitCredential) find(req gitRequest) ([]*Entity, error) {
+	if req["host"] == "" {
+		return nil, nil
+	}
+
+	list, err := g.Sender.EntityList(constants.LogopasEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(list))
+	for id := range list {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var matches []*Entity
+	for _, id := range ids {
+		ent, err := g.Sender.Entity(id)
+		if err != nil {
+			return nil, err
+		}
+		if req["username"] != "" && g.value(ent, g.login) != req["username"] {
+			continue
+		}
+		if req.matches(ent.Metainfo) {
+			matches = append(matches, ent)
+		}
+	}
+
+	return matches, nil
+}
+
+// value значение поля сущности
+func (g *GitCredential) value(ent *Entity, field *Field) string {
+	for _, prop := range ent.Props {
+		if prop.FieldId == field.Id {
+			return prop.Value
+		}
+	}
+
+	return ""
+}
+
+// url адрес сервера из запроса для сохранения в метаданных
+func (r gitRequest) url() string {
+	u := url.URL{Scheme: r["protocol"], Host: r["host"]}
+	if r["path"] != "" {
+		u.Path = "/" + strings.TrimPrefix(r["path"], "/")
+	}
+	if u.Scheme == "" {
+		return u.Host + u.Path
+	}
+
+	return u.String()
+}
+
+// matches проверка, что метаданные url или host указывают на сервер из запроса
+// Схема и путь в url сравниваются, только если они указаны и в метаданных, и в запросе
+func (r gitRequest) matches(metas []*Metainfo) bool {
+	for _, meta := range metas {
+		title := strings.ToLower(strings.TrimSpace(meta.Title))
+		value := strings.TrimSpace(meta.Value)
+
+		switch title {
+		case constants.MetaHost:
+			if strings.EqualFold(value, r["host"]) {
+				return true
+			}
+		case constants.MetaURL:
+			u, ok := r.sameServer(value)
+			if !ok {
+				continue
+			}
+			metaPath := strings.Trim(u.Path, "/")
+			reqPath := strings.Trim(r["path"], "/")
+			if metaPath != "" && reqPath != "" && reqPath != metaPath && !strings.HasPrefix(reqPath, metaPath+"/") {
+				continue
+			}
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesExactly проверка, что метаданные url или host указывают ровно на сервер и путь из запроса
+func (r gitRequest) matchesExactly(metas []*Metainfo) bool {
+	reqPath := strings.Trim(r["path"], "/")
+	for _, meta := range metas {
+		title := strings.ToLower(strings.TrimSpace(meta.Title))
+		value := strings.TrimSpace(meta.Value)
+
+		switch title {
+		case constants.MetaHost:
+			if strings.EqualFold(value, r["host"]) && reqPath == "" {
+				return true
+			}
+		case constants.MetaURL:
+			u, ok := r.sameServer(value)
+			if ok && strings.Trim(u.Path, "/") == reqPath {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sameServer разбор url из метаданных и проверка, что он указывает на сервер из запроса
+// Схема сравнивается, только если она указана и в метаданных, и в запросе
+func (r gitRequest) sameServer(value string) (*url.URL, bool) {
+	if !strings.Contains(value, "://") {
+		value = "//" + value
+	}
+	u, err := url.Parse(value)
+	if err != nil || !strings.EqualFold(u.Host, r["host"]) {
+		return nil, false
+	}
+	if u.Scheme != "" && r["protocol"] != "" && !strings.EqualFold(u.Scheme, r["protocol"]) {
+		return nil, false
+	}
+
+	return u, true
+}
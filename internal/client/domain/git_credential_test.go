@@ -0,0 +1,168 @@
+package domain
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// newGitCredential helper с набором сущностей logopas
+func newGitCredential(t *testing.T, entities map[int32]*Entity) (*GitCredential, *MockSender) {
+	sender := NewMockSender(gomock.NewController(t))
+	sender.EXPECT().Fields(constants.LogopasEntity).Return([]*Field{
+		{Id: 2, Etype: constants.LogopasEntity, Name: "Пароль", Ftype: constants.FieldTypeString},
+		{Id: 1, Etype: constants.LogopasEntity, Name: "Логин", Ftype: constants.FieldTypeString},
+	}, nil)
+
+	list := make(map[int32]string, len(entities))
+	for id := range entities {
+		list[id] = ""
+	}
+	sender.EXPECT().EntityList(constants.LogopasEntity).Return(list, nil).AnyTimes()
+	sender.EXPECT().Entity(gomock.Any()).DoAndReturn(func(id int32) (*Entity, error) {
+		return entities[id], nil
+	}).AnyTimes()
+
+	helper, err := NewGitCredential(sender)
+	require.NoError(t, err)
+
+	return helper, sender
+}
+
+func logopas(id int32, login, password string, metas ...*Metainfo) *Entity {
+	return &Entity{
+		Id:    id,
+		Etype: constants.LogopasEntity,
+		Props: []*Property{
+			{EntityId: id, FieldId: 1, Value: login},
+			{EntityId: id, FieldId: 2, Value: password},
+		},
+		Metainfo: metas,
+	}
+}
+
+func TestGitCredentialGet(t *testing.T) {
+	helper, _ := newGitCredential(t, map[int32]*Entity{
+		1: logopas(1, "bank", "1", &Metainfo{Title: "Банк", Value: "github.com"}),
+		2: logopas(2, "alice", "s3cret", &Metainfo{Title: "URL", Value: "https://github.com/acme"}),
+		3: logopas(3, "bob", "pa55", &Metainfo{Title: "host", Value: "git.example.com:8443"}),
+	})
+
+	tests := []struct {
+		name    string
+		request string
+		want    string
+	}{
+		{"url", "protocol=https\nhost=github.com\n\n", "username=alice\npassword=s3cret\n"},
+		{"url path", "protocol=https\nhost=github.com\npath=acme/repo.git\n", "username=alice\npassword=s3cret\n"},
+		{"other path", "protocol=https\nhost=github.com\npath=acmex/repo.git\n", ""},
+		{"other protocol", "protocol=http\nhost=github.com\n", ""},
+		{"host", "protocol=https\nhost=git.example.com:8443\n", "username=bob\npassword=pa55\n"},
+		{"username", "protocol=https\nhost=git.example.com:8443\nusername=alice\n", ""},
+		{"unknown host", "protocol=https\nhost=gitlab.com\n", ""},
+		{"no host", "protocol=https\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, helper.Run(GitCredentialGet, strings.NewReader(tt.request), &out))
+			assert.Equal(t, tt.want, out.String())
+		})
+	}
+
+	assert.Error(t, helper.Run(GitCredentialGet, strings.NewReader("host\n"), &bytes.Buffer{}))
+	assert.Error(t, helper.Run("list", strings.NewReader("host=github.com\n"), &bytes.Buffer{}))
+}
+
+func TestGitCredentialStore(t *testing.T) {
+	helper, sender := newGitCredential(t, map[int32]*Entity{
+		2: logopas(2, "alice", "old", &Metainfo{Title: constants.MetaURL, Value: "https://github.com"}),
+	})
+
+	// новый логин - новая сущность с метаданными url
+	sender.EXPECT().AddEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, constants.LogopasEntity, ent.Etype)
+		require.Len(t, ent.Props, 2)
+		assert.Equal(t, &Property{FieldId: 1, Value: "bob"}, ent.Props[0])
+		assert.Equal(t, &Property{FieldId: 2, Value: "pa55"}, ent.Props[1])
+		assert.Equal(t, []*Metainfo{{Title: constants.MetaURL, Value: "https://github.com/acme/repo.git"}}, ent.Metainfo)
+		return 3, nil
+	})
+	require.NoError(t, helper.Run(GitCredentialStore, strings.NewReader("protocol=https\nhost=github.com\npath=acme/repo.git\nusername=bob\npassword=pa55\n"), nil))
+
+	// существующий логин - обновление пароля
+	sender.EXPECT().SaveEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, int32(2), ent.Id)
+		assert.Equal(t, "new", ent.Props[1].Value)
+		return 2, nil
+	})
+	require.NoError(t, helper.Run(GitCredentialStore, strings.NewReader("protocol=https\nhost=github.com\nusername=alice\npassword=new\n"), nil))
+
+	// тот же пароль - без изменений
+	require.NoError(t, helper.Run(GitCredentialStore, strings.NewReader("protocol=https\nhost=github.com\nusername=alice\npassword=new\n"), nil))
+}
+
+func TestGitCredentialErase(t *testing.T) {
+	helper, sender := newGitCredential(t, map[int32]*Entity{
+		2: logopas(2, "alice", "s3cret", &Metainfo{Title: constants.MetaHost, Value: "github.com"}),
+		3: logopas(3, "bob", "pa55", &Metainfo{Title: constants.MetaHost, Value: "github.com"}),
+	})
+
+	// пароль не совпадает - ничего не удаляется
+	require.NoError(t, helper.Run(GitCredentialErase, strings.NewReader("protocol=https\nhost=github.com\nusername=alice\npassword=other\n"), nil))
+
+	sender.EXPECT().DeleteEntity(int32(2)).Return(nil)
+	require.NoError(t, helper.Run(GitCredentialErase, strings.NewReader("protocol=https\nhost=github.com\nusername=alice\npassword=s3cret\n"), nil))
+}
+
+func TestGitCredentialEraseExact(t *testing.T) {
+	helper, sender := newGitCredential(t, map[int32]*Entity{
+		2: logopas(2, "alice", "s3cret", &Metainfo{Title: constants.MetaURL, Value: "https://github.com"}),
+		3: logopas(3, "alice", "s3cret", &Metainfo{Title: constants.MetaURL, Value: "https://github.com/org"}),
+		4: logopas(4, "alice", "s3cret", &Metainfo{Title: constants.MetaURL, Value: "https://github.com/org/repo.git"}),
+	})
+
+	// get подбирает запись и по префиксу пути, а erase удаляет только запись ровно для этого пути
+	var out bytes.Buffer
+	require.NoError(t, helper.Run(GitCredentialGet, strings.NewReader("protocol=https\nhost=github.com\npath=org/repo.git\n"), &out))
+	assert.Equal(t, "username=alice\npassword=s3cret\n", out.String())
+
+	sender.EXPECT().DeleteEntity(int32(4)).Return(nil)
+	require.NoError(t, helper.Run(GitCredentialErase, strings.NewReader("protocol=https\nhost=github.com\npath=org/repo.git\nusername=alice\n"), nil))
+
+	sender.EXPECT().DeleteEntity(int32(2)).Return(nil)
+	require.NoError(t, helper.Run(GitCredentialErase, strings.NewReader("protocol=https\nhost=github.com\nusername=alice\n"), nil))
+}
+
+func TestCredentialFields(t *testing.T) {
+	// порядок полей в форме и ID не важны, лишние поля пропускаются
+	login, password := credentialFields([]*Field{
+		{Id: 1, Name: "Пароль", Ftype: constants.FieldTypeSecret, Position: 2},
+		{Id: 2, Name: "Комментарий", Ftype: constants.FieldTypeString, Position: 0},
+		{Id: 3, Name: "Логин", Ftype: constants.FieldTypeString, Position: 1},
+	})
+	require.NotNil(t, login)
+	require.NotNil(t, password)
+	assert.Equal(t, int32(3), login.Id)
+	assert.Equal(t, int32(1), password.Id)
+
+	// без стандартных названий: пароль - поле secret, логин - первое открытое строковое поле
+	login, password = credentialFields([]*Field{
+		{Id: 7, Name: "Токен", Ftype: constants.FieldTypeSecret, Position: 0},
+		{Id: 8, Name: "Адрес", Ftype: constants.FieldTypeURL, Position: 1},
+		{Id: 9, Name: "Email", Ftype: constants.FieldTypeString, Position: 2},
+	})
+	require.NotNil(t, login)
+	require.NotNil(t, password)
+	assert.Equal(t, int32(9), login.Id)
+	assert.Equal(t, int32(7), password.Id)
+
+	_, password = credentialFields([]*Field{{Id: 1, Name: "Email", Ftype: constants.FieldTypeString}})
+	assert.Nil(t, password)
+}
@@ -202,6 +202,21 @@ func (mr *MockReadlineMockRecorder) input(prompt, validateRules, validateMessage
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "input", reflect.TypeOf((*MockReadline)(nil).input), prompt, validateRules, validateMessages)
 }
 
+// inputField mocks base method.
+func (m *MockReadline) inputField(field *Field, current string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "inputField", field, current)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// inputField indicates an expected call of inputField.
+func (mr *MockReadlineMockRecorder) inputField(field, current interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "inputField", reflect.TypeOf((*MockReadline)(nil).inputField), field, current)
+}
+
 // interrupt mocks base method.
 func (m *MockReadline) interrupt(line string, err error) string {
 	m.ctrl.T.Helper()
@@ -239,6 +254,21 @@ func (m *MockSender) EXPECT() *MockSenderMockRecorder {
 	return m.recorder
 }
 
+// AddAttachment mocks base method.
+func (m *MockSender) AddAttachment(entityId int32, file string) (*Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAttachment", entityId, file)
+	ret0, _ := ret[0].(*Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAttachment indicates an expected call of AddAttachment.
+func (mr *MockSenderMockRecorder) AddAttachment(entityId, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAttachment", reflect.TypeOf((*MockSender)(nil).AddAttachment), entityId, file)
+}
+
 // AddEntity mocks base method.
 func (m *MockSender) AddEntity(ae Entity) (int32, error) {
 	m.ctrl.T.Helper()
@@ -254,6 +284,64 @@ func (mr *MockSenderMockRecorder) AddEntity(ae interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEntity", reflect.TypeOf((*MockSender)(nil).AddEntity), ae)
 }
 
+// ArchiveEntityType mocks base method.
+func (m *MockSender) ArchiveEntityType(etype string, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveEntityType", etype, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchiveEntityType indicates an expected call of ArchiveEntityType.
+func (mr *MockSenderMockRecorder) ArchiveEntityType(etype, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveEntityType", reflect.TypeOf((*MockSender)(nil).ArchiveEntityType), etype, archived)
+}
+
+// CreateEntityType mocks base method.
+func (m *MockSender) CreateEntityType(name string, fields []*Field) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntityType", name, fields)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntityType indicates an expected call of CreateEntityType.
+func (mr *MockSenderMockRecorder) CreateEntityType(name, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntityType", reflect.TypeOf((*MockSender)(nil).CreateEntityType), name, fields)
+}
+
+// CreateFolder mocks base method.
+func (m *MockSender) CreateFolder(parentId int32, name string) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", parentId, name)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockSenderMockRecorder) CreateFolder(parentId, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockSender)(nil).CreateFolder), parentId, name)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockSender) DeleteAttachment(id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockSenderMockRecorder) DeleteAttachment(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockSender)(nil).DeleteAttachment), id)
+}
+
 // DeleteEntity mocks base method.
 func (m *MockSender) DeleteEntity(id int32) error {
 	m.ctrl.T.Helper()
@@ -268,6 +356,35 @@ func (mr *MockSenderMockRecorder) DeleteEntity(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntity", reflect.TypeOf((*MockSender)(nil).DeleteEntity), id)
 }
 
+// DeleteFolder mocks base method.
+func (m *MockSender) DeleteFolder(id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockSenderMockRecorder) DeleteFolder(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockSender)(nil).DeleteFolder), id)
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockSender) DownloadAttachment(att *Attachment) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", att)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockSenderMockRecorder) DownloadAttachment(att interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockSender)(nil).DownloadAttachment), att)
+}
+
 // DownloadBinary mocks base method.
 func (m *MockSender) DownloadBinary(entityId int32, fileName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -343,6 +460,36 @@ func (mr *MockSenderMockRecorder) EntityList(etype interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntityList", reflect.TypeOf((*MockSender)(nil).EntityList), etype)
 }
 
+// EntityListFiltered mocks base method.
+func (m *MockSender) EntityListFiltered(etype string, filter ListFilter) (map[int32]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EntityListFiltered", etype, filter)
+	ret0, _ := ret[0].(map[int32]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EntityListFiltered indicates an expected call of EntityListFiltered.
+func (mr *MockSenderMockRecorder) EntityListFiltered(etype, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntityListFiltered", reflect.TypeOf((*MockSender)(nil).EntityListFiltered), etype, filter)
+}
+
+// EntitySummaries mocks base method.
+func (m *MockSender) EntitySummaries(etype string, filter ListFilter) ([]*EntitySummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EntitySummaries", etype, filter)
+	ret0, _ := ret[0].([]*EntitySummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EntitySummaries indicates an expected call of EntitySummaries.
+func (mr *MockSenderMockRecorder) EntitySummaries(etype, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EntitySummaries", reflect.TypeOf((*MockSender)(nil).EntitySummaries), etype, filter)
+}
+
 // Fields mocks base method.
 func (m *MockSender) Fields(etype string) ([]*Field, error) {
 	m.ctrl.T.Helper()
@@ -358,6 +505,51 @@ func (mr *MockSenderMockRecorder) Fields(etype interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fields", reflect.TypeOf((*MockSender)(nil).Fields), etype)
 }
 
+// Folders mocks base method.
+func (m *MockSender) Folders() ([]*Folder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Folders")
+	ret0, _ := ret[0].([]*Folder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Folders indicates an expected call of Folders.
+func (mr *MockSenderMockRecorder) Folders() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Folders", reflect.TypeOf((*MockSender)(nil).Folders))
+}
+
+// ListAttachments mocks base method.
+func (m *MockSender) ListAttachments(entityId int32) ([]*Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", entityId)
+	ret0, _ := ret[0].([]*Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockSenderMockRecorder) ListAttachments(entityId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockSender)(nil).ListAttachments), entityId)
+}
+
+// ListTrash mocks base method.
+func (m *MockSender) ListTrash() ([]*TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash")
+	ret0, _ := ret[0].([]*TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockSenderMockRecorder) ListTrash() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockSender)(nil).ListTrash))
+}
+
 // Login mocks base method.
 func (m *MockSender) Login(login, password string) (string, error) {
 	m.ctrl.T.Helper()
@@ -373,6 +565,35 @@ func (mr *MockSenderMockRecorder) Login(login, password interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockSender)(nil).Login), login, password)
 }
 
+// OpenEntity mocks base method.
+func (m *MockSender) OpenEntity(id int32) (*Entity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenEntity", id)
+	ret0, _ := ret[0].(*Entity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenEntity indicates an expected call of OpenEntity.
+func (mr *MockSenderMockRecorder) OpenEntity(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenEntity", reflect.TypeOf((*MockSender)(nil).OpenEntity), id)
+}
+
+// PurgeEntity mocks base method.
+func (m *MockSender) PurgeEntity(id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeEntity", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeEntity indicates an expected call of PurgeEntity.
+func (mr *MockSenderMockRecorder) PurgeEntity(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeEntity", reflect.TypeOf((*MockSender)(nil).PurgeEntity), id)
+}
+
 // Registration mocks base method.
 func (m *MockSender) Registration(login, password, password2 string) (string, error) {
 	m.ctrl.T.Helper()
@@ -388,6 +609,20 @@ func (mr *MockSenderMockRecorder) Registration(login, password, password2 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Registration", reflect.TypeOf((*MockSender)(nil).Registration), login, password, password2)
 }
 
+// RestoreEntity mocks base method.
+func (m *MockSender) RestoreEntity(id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEntity", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreEntity indicates an expected call of RestoreEntity.
+func (mr *MockSenderMockRecorder) RestoreEntity(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEntity", reflect.TypeOf((*MockSender)(nil).RestoreEntity), id)
+}
+
 // SaveEntity mocks base method.
 func (m *MockSender) SaveEntity(ae Entity) (int32, error) {
 	m.ctrl.T.Helper()
@@ -403,6 +638,63 @@ func (mr *MockSenderMockRecorder) SaveEntity(ae interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveEntity", reflect.TypeOf((*MockSender)(nil).SaveEntity), ae)
 }
 
+// SearchEntities mocks base method.
+func (m *MockSender) SearchEntities(query, etype string) ([]*FoundEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEntities", query, etype)
+	ret0, _ := ret[0].([]*FoundEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntities indicates an expected call of SearchEntities.
+func (mr *MockSenderMockRecorder) SearchEntities(query, etype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntities", reflect.TypeOf((*MockSender)(nil).SearchEntities), query, etype)
+}
+
+// SetFavorite mocks base method.
+func (m *MockSender) SetFavorite(id int32, favorite bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavorite", id, favorite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockSenderMockRecorder) SetFavorite(id, favorite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockSender)(nil).SetFavorite), id, favorite)
+}
+
+// UpdateEntityType mocks base method.
+func (m *MockSender) UpdateEntityType(etype, name string, fields []*Field) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntityType", etype, name, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEntityType indicates an expected call of UpdateEntityType.
+func (mr *MockSenderMockRecorder) UpdateEntityType(etype, name, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntityType", reflect.TypeOf((*MockSender)(nil).UpdateEntityType), etype, name, fields)
+}
+
+// UpdateFolder mocks base method.
+func (m *MockSender) UpdateFolder(folder Folder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFolder", folder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFolder indicates an expected call of UpdateFolder.
+func (mr *MockSenderMockRecorder) UpdateFolder(folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFolder", reflect.TypeOf((*MockSender)(nil).UpdateFolder), folder)
+}
+
 // UploadBinary mocks base method.
 func (m *MockSender) UploadBinary(entityId int32, file string) (int32, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,43 @@
+// Генерация паролей при вводе значений полей в консоли
+package domain
+
+import (
+	"strings"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/passgen"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// genCommand специальный ввод значения поля - сгенерировать пароль
+// Параметры - как у команды generate: ":gen -length 24 -no-symbols", ":gen -words 5"
+const genCommand = ":gen"
+
+// genArgs параметры генерации из введенного значения, ok = false - обычное значение
+// Генерация доступна только для строковых и секретных полей
+func genArgs(field *Field, value string) ([]string, bool) {
+	if field.Ftype != constants.FieldTypeString && field.Ftype != constants.FieldTypeSecret {
+		return nil, false
+	}
+
+	args := strings.Fields(value)
+	if len(args) == 0 || args[0] != genCommand {
+		return nil, false
+	}
+
+	return args[1:], true
+}
+
+// generatePassword генерация пароля по параметрам, возвращает пароль и оценку его стойкости в битах
+func generatePassword(args []string) (string, float64, error) {
+	spec, err := passgen.ParseArgs(args)
+	if err != nil {
+		return "", 0, err
+	}
+
+	pass, err := passgen.Generate(spec)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return pass, passgen.Entropy(spec), nil
+}
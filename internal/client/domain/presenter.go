@@ -0,0 +1,373 @@
+// Представление данных клиента: таблица для человека, JSON и YAML для других программ
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// форматы вывода
+const (
+	OutputTable = "table" // текст для чтения человеком
+	OutputJSON  = "json"  // документ JSON
+	OutputYAML  = "yaml"  // документ YAML
+)
+
+// MenuItem пункт меню выбора сущности
+type MenuItem struct {
+	Index int    `json:"index" yaml:"index"` // номер для выбора в консоли
+	Id    int32  `json:"id" yaml:"id"`       // ID сущности
+	Title string `json:"title" yaml:"title"` // описание, составленное из метаданных
+}
+
+// FieldDoc описание поля сущности
+type FieldDoc struct {
+	Id               int32             `json:"id" yaml:"id"`                               // ID поля
+	EntityType       string            `json:"entityType" yaml:"entityType"`               // тип сущности
+	Name             string            `json:"name" yaml:"name"`                           // название поля
+	Type             string            `json:"type" yaml:"type"`                           // тип поля
+	ValidateRules    string            `json:"validateRules" yaml:"validateRules"`         // правила валидации
+	ValidateMessages map[string]string `json:"validateMessages" yaml:"validateMessages"`   // сообщения валидации по правилам
+	Secret           bool              `json:"secret,omitempty" yaml:"secret,omitempty"`   // значение секретно
+	Options          []string          `json:"options,omitempty" yaml:"options,omitempty"` // варианты значения поля select
+}
+
+// TypeDoc тип сущности
+type TypeDoc struct {
+	Etype    string `json:"type" yaml:"type"`                             // код типа
+	Name     string `json:"name" yaml:"name"`                             // название типа
+	Custom   bool   `json:"custom,omitempty" yaml:"custom,omitempty"`     // тип создан пользователем
+	Archived bool   `json:"archived,omitempty" yaml:"archived,omitempty"` // тип в архиве
+}
+
+// ValueDoc значение одного поля сущности
+type ValueDoc struct {
+	Id    int32  `json:"id" yaml:"id"`       // ID сущности
+	Field string `json:"field" yaml:"field"` // название поля
+	Value string `json:"value" yaml:"value"` // значение
+}
+
+// GeneratedDoc сгенерированный пароль или парольная фраза
+type GeneratedDoc struct {
+	Password string `json:"password" yaml:"password"` // пароль или парольная фраза
+	Entropy  int    `json:"entropy" yaml:"entropy"`   // оценка стойкости в битах
+}
+
+// ResultDoc результат изменяющей команды
+type ResultDoc struct {
+	Action string `json:"action" yaml:"action"`                 // выполненное действие: login, added, edited, removed, uploaded, downloaded, created, moved, deleted, archived, restored
+	Id     int32  `json:"id" yaml:"id"`                         // ID сущности или папки
+	Etype  string `json:"type,omitempty" yaml:"type,omitempty"` // код пользовательского типа сущности
+	Size   int32  `json:"size,omitempty" yaml:"size,omitempty"` // размер загруженных данных
+	Path   string `json:"path,omitempty" yaml:"path,omitempty"` // путь к скачанному файлу
+}
+
+// результаты изменяющих команд
+const (
+	ActionLoggedIn   = "login"
+	ActionAdded      = "added"
+	ActionEdited     = "edited"
+	ActionRemoved    = "removed"
+	ActionUploaded   = "uploaded"
+	ActionDownloaded = "downloaded"
+	ActionCreated    = "created"  // папка создана
+	ActionMoved      = "moved"    // папка переименована или перемещена
+	ActionDeleted    = "deleted"  // папка удалена
+	ActionArchived   = "archived" // тип сущности перемещен в архив
+	ActionRestored   = "restored" // тип сущности возвращен из архива
+)
+
+// ErrorDoc ошибка выполнения команды
+type ErrorDoc struct {
+	Code    int    `json:"code" yaml:"code"`       // код завершения
+	Message string `json:"message" yaml:"message"` // текст ошибки
+}
+
+// Presenter вывод данных в выбранном формате
+type Presenter struct {
+	format string    // формат вывода
+	out    io.Writer // куда выводить
+}
+
+// NewPresenter конструктор, пустой формат - таблица
+func NewPresenter(format string, out io.Writer) (*Presenter, error) {
+	switch format {
+	case "":
+		format = OutputTable
+	case OutputTable, OutputJSON, OutputYAML:
+	default:
+		return nil, fmt.Errorf("неизвестный формат вывода %q (допустимы %v, %v, %v)", format, OutputTable, OutputJSON, OutputYAML)
+	}
+
+	return &Presenter{format: format, out: out}, nil
+}
+
+// Format формат вывода
+func (p *Presenter) Format() string {
+	return p.format
+}
+
+// Entity вывод сущности
+func (p *Presenter) Entity(view *EntityView) error {
+	if p.format != OutputTable {
+		if view.Fields == nil {
+			view.Fields = []FieldValue{}
+		}
+		if view.Metainfo == nil {
+			view.Metainfo = []FieldValue{}
+		}
+		return p.encode(view)
+	}
+
+	title := view.TypeName
+	if title == "" {
+		title = view.Etype
+	}
+	if view.Id > 0 {
+		title = fmt.Sprintf("%v #%v", title, view.Id)
+	}
+
+	var b strings.Builder
+	b.WriteString("------------------------\n")
+	b.WriteString(" " + title + "\n")
+	for _, val := range view.Fields {
+		b.WriteString("      " + val.Name + ": " + val.Value + "\n")
+	}
+	for _, val := range view.Metainfo {
+		b.WriteString("      " + val.Name + ": " + val.Value + "\n")
+	}
+	if view.Folder != "" {
+		b.WriteString("      Папка: " + view.Folder + "\n")
+	}
+	if len(view.Tags) > 0 {
+		b.WriteString("      Теги: " + strings.Join(view.Tags, ", ") + "\n")
+	}
+	b.WriteString("------------------------\n")
+
+	_, err := io.WriteString(p.out, b.String())
+
+	return err
+}
+
+// List вывод списка сущностей
+func (p *Presenter) List(items []ListItem) error {
+	if p.format != OutputTable {
+		if items == nil {
+			items = []ListItem{}
+		}
+		return p.encode(items)
+	}
+
+	return p.table([]string{"ID", "TYPE", "TITLE"}, len(items), func(i int) []string {
+		return []string{fmt.Sprint(items[i].Id), items[i].Etype, items[i].Title}
+	})
+}
+
+// SearchResults вывод результатов поиска
+func (p *Presenter) SearchResults(results []SearchResult) error {
+	if p.format != OutputTable {
+		if results == nil {
+			results = []SearchResult{}
+		}
+		return p.encode(results)
+	}
+
+	return p.table([]string{"ID", "TYPE", "TITLE", "MATCH"}, len(results), func(i int) []string {
+		return []string{fmt.Sprint(results[i].Id), results[i].Etype, results[i].Title, results[i].Match}
+	})
+}
+
+// Audit вывод паролей, найденных в базе утечек
+func (p *Presenter) Audit(items []AuditItem) error {
+	if p.format != OutputTable {
+		if items == nil {
+			items = []AuditItem{}
+		}
+		return p.encode(items)
+	}
+
+	return p.table([]string{"ID", "TITLE", "FIELD", "BREACHES"}, len(items), func(i int) []string {
+		return []string{fmt.Sprint(items[i].Id), items[i].Title, items[i].Field, fmt.Sprint(items[i].Count)}
+	})
+}
+
+// Folders вывод дерева папок, в табличном формате - с отступами по уровню вложенности
+func (p *Presenter) Folders(nodes []FolderNode) error {
+	if p.format != OutputTable {
+		if nodes == nil {
+			nodes = []FolderNode{}
+		}
+		return p.encode(nodes)
+	}
+
+	return p.table([]string{"ID", "FOLDER"}, len(nodes), func(i int) []string {
+		return []string{fmt.Sprint(nodes[i].Id), strings.Repeat("  ", nodes[i].Depth) + nodes[i].Name}
+	})
+}
+
+// Menu вывод пунктов меню выбора сущности
+func (p *Presenter) Menu(items []MenuItem) error {
+	if p.format != OutputTable {
+		if items == nil {
+			items = []MenuItem{}
+		}
+		return p.encode(items)
+	}
+
+	for _, item := range items {
+		_, err := fmt.Fprintf(p.out, "[%v] %v\n", item.Index, item.Title)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Fields вывод описаний полей сущностей
+func (p *Presenter) Fields(fields []*Field) error {
+	docs := make([]FieldDoc, 0, len(fields))
+	for _, f := range fields {
+		vm := make(map[string]string)
+		_ = json.Unmarshal([]byte(f.ValidateMessages), &vm)
+		docs = append(docs, FieldDoc{
+			Id:               f.Id,
+			EntityType:       f.Etype,
+			Name:             f.Name,
+			Type:             f.Ftype,
+			ValidateRules:    f.ValidateRules,
+			ValidateMessages: vm,
+			Secret:           isSecretField(f),
+			Options:          f.Options,
+		})
+	}
+
+	if p.format != OutputTable {
+		return p.encode(docs)
+	}
+
+	return p.table([]string{"ID", "ENTITY", "NAME", "TYPE", "RULES", "SECRET"}, len(docs), func(i int) []string {
+		ftype := docs[i].Type
+		if len(docs[i].Options) > 0 {
+			ftype += "(" + strings.Join(docs[i].Options, "|") + ")"
+		}
+		return []string{fmt.Sprint(docs[i].Id), docs[i].EntityType, docs[i].Name, ftype, docs[i].ValidateRules, yesNo(docs[i].Secret)}
+	})
+}
+
+// Types вывод справочника типов сущностей
+func (p *Presenter) Types(codes []*EntityCode) error {
+	docs := make([]TypeDoc, 0, len(codes))
+	for _, code := range codes {
+		docs = append(docs, TypeDoc{Etype: code.Etype, Name: code.Name, Custom: code.Custom, Archived: code.Archived})
+	}
+
+	if p.format != OutputTable {
+		return p.encode(docs)
+	}
+
+	return p.table([]string{"TYPE", "NAME", "CUSTOM", "ARCHIVED"}, len(docs), func(i int) []string {
+		return []string{docs[i].Etype, docs[i].Name, yesNo(docs[i].Custom), yesNo(docs[i].Archived)}
+	})
+}
+
+// yesNo значение признака в таблице
+func yesNo(flag bool) string {
+	if flag {
+		return "yes"
+	}
+
+	return ""
+}
+
+// Value вывод значения одного поля, в табличном формате - только само значение
+func (p *Presenter) Value(doc ValueDoc) error {
+	if p.format != OutputTable {
+		return p.encode(doc)
+	}
+
+	_, err := fmt.Fprintln(p.out, doc.Value)
+
+	return err
+}
+
+// Generated вывод сгенерированного пароля, в табличном формате - только сам пароль
+func (p *Presenter) Generated(doc GeneratedDoc) error {
+	if p.format != OutputTable {
+		return p.encode(doc)
+	}
+
+	_, err := fmt.Fprintln(p.out, doc.Password)
+
+	return err
+}
+
+// Result вывод результата изменяющей команды
+// В табличном формате выводится ok после входа, ID новой сущности или путь к скачанному файлу
+func (p *Presenter) Result(doc ResultDoc) error {
+	if p.format != OutputTable {
+		return p.encode(doc)
+	}
+
+	var err error
+	switch doc.Action {
+	case ActionLoggedIn:
+		_, err = fmt.Fprintln(p.out, "ok")
+	case ActionAdded, ActionUploaded, ActionCreated:
+		if doc.Etype != "" {
+			_, err = fmt.Fprintln(p.out, doc.Etype)
+			break
+		}
+		_, err = fmt.Fprintln(p.out, doc.Id)
+	case ActionDownloaded:
+		_, err = fmt.Fprintln(p.out, doc.Path)
+	}
+
+	return err
+}
+
+// Error вывод ошибки, в табличном формате - с префиксом
+func (p *Presenter) Error(prefix string, err error, code int) error {
+	if p.format != OutputTable {
+		return p.encode(map[string]ErrorDoc{"error": {Code: code, Message: err.Error()}})
+	}
+
+	_, werr := fmt.Fprintln(p.out, prefix+": "+err.Error())
+
+	return werr
+}
+
+// encode вывод документа JSON или YAML
+func (p *Presenter) encode(doc any) error {
+	if p.format == OutputYAML {
+		enc := yaml.NewEncoder(p.out)
+		enc.SetIndent(2)
+		err := enc.Encode(doc)
+		if err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+
+	enc := json.NewEncoder(p.out)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(doc)
+}
+
+// table вывод таблицы с выравниванием колонок
+func (p *Presenter) table(header []string, rows int, row func(i int) []string) error {
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for i := 0; i < rows; i++ {
+		fmt.Fprintln(w, strings.Join(row(i), "\t"))
+	}
+
+	return w.Flush()
+}
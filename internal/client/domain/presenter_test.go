@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func TestPresenterEntity(t *testing.T) {
+	view := &EntityView{
+		Id:       7,
+		Etype:    constants.LogopasEntity,
+		TypeName: "Логин/пароль",
+		Fields:   []FieldValue{{Name: "Логин", Value: "alice"}},
+	}
+
+	var out bytes.Buffer
+	p, err := NewPresenter("", &out)
+	require.NoError(t, err)
+	require.NoError(t, p.Entity(view))
+	assert.Equal(t, "------------------------\n Логин/пароль #7\n      Логин: alice\n------------------------\n", out.String())
+
+	out.Reset()
+	p, err = NewPresenter(OutputJSON, &out)
+	require.NoError(t, err)
+	require.NoError(t, p.Entity(view))
+	assert.JSONEq(t, `{"id": 7, "type": "logopas", "typeName": "Логин/пароль", "fields": [{"name": "Логин", "value": "alice"}], "metainfo": []}`, out.String())
+
+	_, err = NewPresenter("xml", &out)
+	assert.Error(t, err)
+}
+
+func TestPresenterDocuments(t *testing.T) {
+	var out bytes.Buffer
+	p, err := NewPresenter(OutputYAML, &out)
+	require.NoError(t, err)
+
+	require.NoError(t, p.List(nil))
+	assert.Equal(t, "[]\n", out.String())
+
+	out.Reset()
+	require.NoError(t, p.Fields([]*Field{{Id: 1, Etype: constants.LogopasEntity, Name: "Логин", Ftype: constants.FieldTypeString,
+		ValidateRules: "required", ValidateMessages: `{"required": "Логин не может быть пустым"}`}}))
+	assert.Equal(t, "- id: 1\n  entityType: logopas\n  name: Логин\n  type: string\n  validateRules: required\n  validateMessages:\n    required: Логин не может быть пустым\n", out.String())
+
+	out.Reset()
+	require.NoError(t, p.Audit([]AuditItem{{Id: 3, Title: "сайт:github.", Field: "Пароль", Count: 10}}))
+	assert.Equal(t, "- id: 3\n  title: сайт:github.\n  field: Пароль\n  count: 10\n", out.String())
+
+	out.Reset()
+	require.NoError(t, p.Error("get", errors.New("не найдено"), 4))
+	assert.Equal(t, "error:\n  code: 4\n  message: не найдено\n", out.String())
+
+	out.Reset()
+	p, err = NewPresenter(OutputTable, &out)
+	require.NoError(t, err)
+	require.NoError(t, p.Menu(menuItems(map[int32]string{9: "b", 3: "a"})))
+	require.NoError(t, p.Result(ResultDoc{Action: ActionDownloaded, Id: 9, Path: "/tmp/report.pdf"}))
+	require.NoError(t, p.Result(ResultDoc{Action: ActionRemoved, Id: 9}))
+	assert.Equal(t, "[1] a\n[2] b\n/tmp/report.pdf\n", out.String())
+}
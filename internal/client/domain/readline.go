@@ -8,6 +8,9 @@ import (
 
 	"github.com/chzyer/readline"
 	"github.com/go-playground/validator/v10"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/hibp"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
 )
 
 // CLIReader консольный клиент
@@ -18,8 +21,12 @@ type CLIReader struct {
 	etypes             map[string]string   // справочник типов сущностей (код_сущности: наименование)
 	fieldsByID         map[int32]*Field    // карта описаний полей сущности с ключом по ID поля из таблицы fields
 	fieldsGroup        map[string][]*Field // карта описаний полей сущности,сгруппированных по типу сущности (card, logopas, text, binary и т.д.)
+	Breaches           *hibp.Checker       // локальная база утечек паролей, nil - пароли не проверяются
 }
 
+// multilineEnd строка, завершающая построчный ввод многострочного значения
+const multilineEnd = "."
+
 const (
 	loopBreak    string = "break"    // прервать текущий цикл ввода
 	loopContinue string = "continue" // продолжить текущий цикл ввода
@@ -40,7 +47,10 @@ func NewCLIReadline(cfg *readline.Config) (*CLIReader, error) {
 		return nil, 0, false
 	})
 
-	vdr := validator.New(validator.WithRequiredStructEnabled())
+	vdr, err := newValidator()
+	if err != nil {
+		return nil, err
+	}
 
 	cli := &CLIReader{
 		rl,
@@ -49,11 +59,31 @@ func NewCLIReadline(cfg *readline.Config) (*CLIReader, error) {
 		make(map[string]string),
 		make(map[int32]*Field),
 		make(map[string][]*Field),
+		nil,
 	}
 
 	return cli, nil
 }
 
+// newValidator валидатор вводимых данных с дополнительными правилами для особых типов полей
+func newValidator() (*validator.Validate, error) {
+	vdr := validator.New(validator.WithRequiredStructEnabled())
+	err := vdr.RegisterValidation(otpauthValidationTag, validateOTPAuth)
+	if err != nil {
+		return nil, err
+	}
+	err = vdr.RegisterValidation(sshKeyValidationTag, validateSSHKey)
+	if err != nil {
+		return nil, err
+	}
+	err = vdr.RegisterValidation(sshPublicKeyValidationTag, validateSSHPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return vdr, nil
+}
+
 // MakeFieldsDescription Формирование карт описаний полей сущностей
 func (r *CLIReader) MakeFieldsDescription(fields []*Field) {
 	for _, val := range fields {
@@ -243,6 +273,184 @@ func (r *CLIReader) input(prompt string, validateRules string, validateMessages
 	return value, nil
 }
 
+// inputField ввод значения поля сущности с учетом типа поля, current - прежнее значение при редактировании
+// Секретные поля вводятся без отображения (пустой ввод оставляет прежнее значение), многострочные - в редакторе,
+// для поля select выводится список вариантов. Ввод :gen в строковое или секретное поле генерирует пароль.
+// Пароль logopas, найденный в базе утечек, сохраняется только после подтверждения.
+// Ввод повторяется, пока значение не пройдет проверку
+func (r *CLIReader) inputField(field *Field, current string) (string, error) {
+	var vm map[string]string
+	err := json.Unmarshal([]byte(field.ValidateMessages), &vm)
+	if err != nil {
+		return "", err
+	}
+
+	if field.Ftype == constants.FieldTypeSelect {
+		for i, option := range field.Options {
+			r.Writeln(fmt.Sprintf("%v. %v", i+1, option))
+		}
+	}
+
+	for {
+		value, err := r.readField(field, current)
+		if r.interrupt(value, err) == loopBreak {
+			return "", readline.ErrInterrupt
+		}
+		if err != nil {
+			r.Writeln(err.Error())
+			if field.Ftype == constants.FieldTypeMultiline {
+				return "", err
+			}
+			continue
+		}
+		if args, ok := genArgs(field, value); ok {
+			generated, entropy, err := generatePassword(args)
+			if err != nil {
+				r.Writeln(err.Error())
+				continue
+			}
+			r.Writeln(fmt.Sprintf("Сгенерировано (%.0f бит): %v", entropy, generated))
+			value = generated
+		}
+		if isMaskedField(field) && value == "" && current != "" {
+			return current, nil
+		}
+
+		if !r.valid(value, field.ValidateRules, vm) {
+			continue
+		}
+		value, err = fieldTypeValue(field, value)
+		if err != nil {
+			r.Writeln(err.Error())
+			continue
+		}
+		if !r.acceptBreached(field, value, current) {
+			continue
+		}
+
+		return value, nil
+	}
+}
+
+// acceptBreached проверка пароля по базе утечек, найденный пароль принимается только после подтверждения
+// Если базу прочитать не удалось, выводится предупреждение и пароль принимается
+func (r *CLIReader) acceptBreached(field *Field, value string, current string) bool {
+	if r.Breaches == nil || !isBreachChecked(field) || value == "" || value == current {
+		return true
+	}
+
+	count, err := r.Breaches.Count(value)
+	if err != nil {
+		r.Writeln("Проверка по базе утечек не выполнена: " + err.Error())
+		return true
+	}
+	if count == 0 {
+		return true
+	}
+
+	r.Writeln(BreachWarning(field.Name, count))
+	answer, err := r.input("Сохранить этот пароль? (Y or N)>>", "", "{}")
+	if err != nil {
+		return false
+	}
+
+	return strings.ToLower(answer) == "y"
+}
+
+// readField чтение значения поля из консоли или из редактора
+func (r *CLIReader) readField(field *Field, current string) (string, error) {
+	prompt := field.Name
+	if hint := fieldTypeHint(field); hint != "" && field.Ftype != constants.FieldTypeMultiline {
+		prompt += " (" + hint + ")"
+	}
+
+	switch {
+	case field.Ftype == constants.FieldTypeMultiline && isSecretField(field):
+		return r.readMultiline(field, current)
+
+	case field.Ftype == constants.FieldTypeMultiline:
+		r.Writeln(field.Name + ": значение вводится в редакторе " + editorCommand()[0])
+		return editText(current)
+
+	case isMaskedField(field):
+		if _, ok := genArgs(field, genCommand); ok {
+			prompt += " (" + genCommand + " - сгенерировать)"
+		}
+		if current != "" {
+			prompt += " (пусто - оставить прежнее)"
+		}
+		cfg := r.GenPasswordConfig()
+		cfg.SetListener(func(line []rune, pos int, key rune) (newLine []rune, newPos int, ok bool) {
+			r.SetPrompt(fmt.Sprintf("%v(%v): ", prompt, len(line)))
+			r.Refresh()
+			return nil, 0, false
+		})
+		r.SetPrompt(prompt + ": ")
+		value, err := r.ReadPasswordWithConfig(cfg)
+		return string(value), err
+	}
+
+	r.SetPrompt(prompt + ":")
+	if current != "" {
+		return r.ReadlineWithDefault(current)
+	}
+
+	return r.Readline()
+}
+
+// readMultiline построчный ввод многострочного значения в консоли, строка из одной точки завершает ввод
+// Секретное значение не передается редактору через файл и не сохраняется в истории ввода
+func (r *CLIReader) readMultiline(field *Field, current string) (string, error) {
+	hint := ": вводите строки, строка \"" + multilineEnd + "\" завершает ввод"
+	if current != "" {
+		hint += " (сразу \"" + multilineEnd + "\" - оставить прежнее)"
+	}
+	r.Writeln(field.Name + hint)
+
+	r.HistoryDisable()
+	defer r.HistoryEnable()
+
+	var lines []string
+	for {
+		r.SetPrompt(fmt.Sprintf("%v> ", len(lines)+1))
+		line, err := r.Readline()
+		if err != nil {
+			return line, err
+		}
+		if line == multilineEnd {
+			break
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 && current != "" {
+		return current, nil
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// valid проверка введенного значения по правилам валидации с выводом сообщений о нарушениях
+func (r *CLIReader) valid(value string, validateRules string, vm map[string]string) bool {
+	if validateRules == "" {
+		return true
+	}
+
+	errs := r.validator.Var(value, validateRules)
+	errors, okAssert := errs.(validator.ValidationErrors)
+	if !okAssert || len(errors) == 0 {
+		return true
+	}
+	for _, err := range errors {
+		message := err.Error()
+		if val, ok := vm[err.Tag()]; ok {
+			message = strings.Replace(val, "<param>", err.Param(), -1)
+		}
+		r.Writeln(message)
+	}
+
+	return false
+}
+
 // Редактирование данных в консольной строке
 func (r *CLIReader) edit(prompt string, what string, validateRules string, validateMessages string) (string, error) {
 	var value string
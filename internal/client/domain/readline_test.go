@@ -8,6 +8,8 @@ import (
 	"github.com/chzyer/readline"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
 )
 
 func TestRegistration(t *testing.T) {
@@ -222,6 +224,108 @@ func TestEdit(t *testing.T) {
 
 }
 
+func TestInputField(t *testing.T) {
+	r, w := io.Pipe()
+	rl, err := NewCLIReadline(&readline.Config{
+		Prompt:          "\033[31m»\033[0m ",
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+		Stdin:           r,
+	})
+	require.NoError(t, err)
+
+	// неверная дата не принимается, верная приводится к виду ГГГГ-ММ-ДД
+	var value string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		value, err = rl.inputField(&Field{Name: "Дата", Ftype: constants.FieldTypeDate, ValidateRules: "required", ValidateMessages: "{}"}, "")
+	}()
+	sleep()
+	w.Write([]byte("31.02.2024\n"))
+	sleep()
+	w.Write([]byte("01.02.2024\n"))
+	<-done
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02-01", value)
+
+	// вариант списка выбирается по номеру
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		value, err = rl.inputField(&Field{Name: "Сеть", Ftype: constants.FieldTypeSelect, Options: []string{"WPA2", "WPA3"}, ValidateMessages: "{}"}, "")
+	}()
+	sleep()
+	w.Write([]byte("2\n"))
+	<-done
+	require.NoError(t, err)
+	assert.Equal(t, "WPA3", value)
+
+	// :gen генерирует пароль, неверные параметры генерации не принимаются
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		value, err = rl.inputField(&Field{Name: "Пароль", Ftype: constants.FieldTypeString, ValidateRules: "required", ValidateMessages: "{}"}, "")
+	}()
+	sleep()
+	w.Write([]byte(":gen -length 2\n"))
+	sleep()
+	w.Write([]byte(":gen -length 12 -no-symbols\n"))
+	<-done
+	require.NoError(t, err)
+	assert.Len(t, value, 12)
+	assert.Regexp(t, "^[a-zA-Z0-9]+$", value)
+
+	_, ok := genArgs(&Field{Ftype: constants.FieldTypeURL}, ":gen")
+	assert.False(t, ok)
+}
+
+func TestInputFieldEcho(t *testing.T) {
+	r, w := io.Pipe()
+	out := &syncBuffer{}
+	rl, err := NewCLIReadline(&readline.Config{
+		Prompt:          "\033[31m»\033[0m ",
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+		Stdin:           r,
+		Stdout:          out,
+		FuncIsTerminal:  func() bool { return true },
+	})
+	require.NoError(t, err)
+
+	input := func(field *Field, line string) string {
+		var value string
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			value, err = rl.inputField(field, "")
+		}()
+		sleep()
+		w.Write([]byte(line + "\n"))
+		<-done
+		require.NoError(t, err)
+		return value
+	}
+
+	// логин, отмеченный секретным, и путь к файлу SSH ключа вводятся с отображением
+	assert.Equal(t, "alice", input(&Field{Name: "Логин", Ftype: constants.FieldTypeString, Secret: true, ValidateMessages: "{}"}, "alice"))
+	assert.Contains(t, out.String(), "alice")
+	assert.Equal(t, "/home/alice/.ssh/id_ed25519", input(&Field{Name: "Ключ", Ftype: constants.FieldTypeSSHKey, Secret: true, ValidateMessages: "{}"}, "/home/alice/.ssh/id_ed25519"))
+	assert.Contains(t, out.String(), "/home/alice/.ssh/id_ed25519")
+
+	// пароль и пароль SSH ключа скрываются
+	assert.Equal(t, "s3cret-pass", input(&Field{Name: "Пароль", Ftype: constants.FieldTypeSecret, ValidateMessages: "{}"}, "s3cret-pass"))
+	assert.Equal(t, "key-phrase", input(&Field{Name: "Пароль ключа", Ftype: constants.FieldTypeSSHPassphrase, ValidateMessages: "{}"}, "key-phrase"))
+	assert.NotContains(t, out.String(), "s3cret-pass")
+	assert.NotContains(t, out.String(), "key-phrase")
+
+	// секретный многострочный текст вводится построчно в консоли, без редактора
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "false")
+	notes := &Field{Name: "Коды восстановления", Ftype: constants.FieldTypeMultiline, Secret: true, ValidateMessages: "{}"}
+	assert.Equal(t, "1111\n\n2222", input(notes, "1111\n\n2222\n."))
+}
+
 func TestGet(t *testing.T) {
 	uploadDir, err := FilestorageDir()
 	require.NoError(t, err)
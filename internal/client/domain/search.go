@@ -0,0 +1,324 @@
+// Поиск по расшифрованным на клиенте сущностям всех типов
+package domain
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// SearchResult найденная сущность
+type SearchResult struct {
+	Id    int32  `json:"id" yaml:"id"`       // ID сущности
+	Etype string `json:"type" yaml:"type"`   // тип сущности
+	Title string `json:"title" yaml:"title"` // описание, составленное из метаданных
+	Match string `json:"match" yaml:"match"` // текст, лучше всего совпавший с запросом
+	Score int    `json:"score" yaml:"score"` // оценка совпадения, чем больше, тем лучше
+}
+
+// searchEntry проиндексированная сущность
+type searchEntry struct {
+	id    int32
+	etype string
+	title string
+	texts []string // описание, метаданные и значения открытых полей
+	lower [][]rune // тексты в нижнем регистре для сопоставления
+}
+
+// SearchIndex индекс для нечеткого поиска по описаниям, метаданным и открытым полям сущностей
+// Метаданные хранятся на сервере зашифрованными, поэтому индекс строится на клиенте после расшифровки.
+// Значения секретных полей в индекс не попадают.
+type SearchIndex struct {
+	Sender  Sender // отправка-получение данных на/с сервера
+	mu      sync.RWMutex
+	built   bool
+	entries map[int32]*searchEntry
+	fields  map[string][]*Field // описания полей по типам сущностей
+}
+
+// NewSearchIndex конструктор, индекс строится при первом вызове Build
+func NewSearchIndex(sender Sender) *SearchIndex {
+	return &SearchIndex{
+		Sender:  sender,
+		entries: make(map[int32]*searchEntry),
+		fields:  make(map[string][]*Field),
+	}
+}
+
+// Built индекс построен
+func (s *SearchIndex) Built() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.built
+}
+
+// Build загрузка и индексация всех сущностей указанных типов, без типов - всех типов из справочника
+func (s *SearchIndex) Build(etypes ...string) error {
+	if len(etypes) == 0 {
+		codes, err := s.Sender.EntityCodes()
+		if err != nil {
+			return err
+		}
+		for _, code := range codes {
+			etypes = append(etypes, code.Etype)
+		}
+	}
+
+	for _, etype := range etypes {
+		list, err := s.Sender.EntityList(etype)
+		if err != nil {
+			return err
+		}
+		for id := range list {
+			ent, err := s.Sender.Entity(id)
+			if err != nil {
+				return err
+			}
+			err = s.Put(*ent)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.built = true
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Put добавление или обновление сущности в индексе
+func (s *SearchIndex) Put(ent Entity) error {
+	fields, err := s.fieldsOf(ent.Etype)
+	if err != nil {
+		return err
+	}
+
+	entry := &searchEntry{id: ent.Id, etype: ent.Etype, title: metaTitle(ent.Metainfo)}
+	entry.texts = append(entry.texts, entry.title)
+	for _, meta := range ent.Metainfo {
+		entry.texts = append(entry.texts, meta.Title+": "+meta.Value)
+	}
+	for _, prop := range ent.Props {
+		field := fieldByID(fields, prop.FieldId)
+		if field == nil || isSecretField(field) || prop.Value == "" {
+			continue
+		}
+		value := prop.Value
+		if field.Ftype == constants.FieldTypePath {
+			fd := &BinaryFileProperty{}
+			if json.Unmarshal([]byte(value), fd) == nil {
+				value = fd.Clientname
+			}
+			value = path.Base(value)
+		}
+		entry.texts = append(entry.texts, field.Name+": "+value)
+	}
+	for _, text := range entry.texts {
+		entry.lower = append(entry.lower, []rune(strings.ToLower(text)))
+	}
+
+	s.mu.Lock()
+	s.entries[ent.Id] = entry
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Remove удаление сущности из индекса
+func (s *SearchIndex) Remove(id int32) {
+	s.mu.Lock()
+	delete(s.entries, id)
+	s.mu.Unlock()
+}
+
+// Search нечеткий поиск: каждое слово запроса должно совпасть хотя бы с одним текстом сущности,
+// символы слова должны встречаться в тексте в том же порядке. Пустой etype - поиск по всем типам.
+// Результаты упорядочены по убыванию оценки совпадения, limit <= 0 - без ограничения.
+func (s *SearchIndex) Search(query string, etype string, limit int) []SearchResult {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return nil
+	}
+
+	s.mu.RLock()
+	var results []SearchResult
+	for _, entry := range s.entries {
+		if etype != "" && entry.etype != etype {
+			continue
+		}
+		score, match := entry.score(terms)
+		if score > 0 {
+			results = append(results, SearchResult{Id: entry.id, Etype: entry.etype, Title: entry.title, Match: match, Score: score})
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Id < results[j].Id
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+// Tracking Sender, обновляющий построенный индекс после добавления, изменения, удаления и восстановления сущностей
+func (s *SearchIndex) Tracking(sender Sender) Sender {
+	return &trackingSender{Sender: sender, index: s}
+}
+
+// fieldsOf описания полей типа сущности
+func (s *SearchIndex) fieldsOf(etype string) ([]*Field, error) {
+	s.mu.RLock()
+	fields, ok := s.fields[etype]
+	s.mu.RUnlock()
+	if ok {
+		return fields, nil
+	}
+
+	fields, err := s.Sender.Fields(etype)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.fields[etype] = fields
+	s.mu.Unlock()
+
+	return fields, nil
+}
+
+// score суммарная оценка совпадения слов запроса и текст, лучше всего совпавший с первым словом
+func (e *searchEntry) score(terms []string) (int, string) {
+	total := 0
+	match := ""
+	for i, term := range terms {
+		pattern := []rune(term)
+		best, bestText := 0, 0
+		for j, text := range e.lower {
+			if sc := fuzzyScore(pattern, text); sc > best {
+				best, bestText = sc, j
+			}
+		}
+		if best == 0 {
+			return 0, ""
+		}
+		if i == 0 {
+			match = e.texts[bestText]
+		}
+		total += best
+	}
+
+	return total, match
+}
+
+// fuzzyScore оценка совпадения шаблона с текстом, 0 - символы шаблона не встречаются в тексте по порядку
+// Подстрока целиком, символы подряд и совпадения в начале слов оцениваются выше
+func fuzzyScore(pattern []rune, text []rune) int {
+	if len(pattern) == 0 {
+		return 0
+	}
+
+	score, pi, prev := 0, 0, -2
+	for ti := 0; ti < len(text) && pi < len(pattern); ti++ {
+		if text[ti] != pattern[pi] {
+			continue
+		}
+		score++
+		if ti == prev+1 {
+			score += 5
+		}
+		if ti == 0 || !unicode.IsLetter(text[ti-1]) && !unicode.IsDigit(text[ti-1]) {
+			score += 3
+		}
+		prev = ti
+		pi++
+	}
+	if pi < len(pattern) {
+		return 0
+	}
+
+	if strings.Contains(string(text), string(pattern)) {
+		score += 2 * len(pattern)
+	}
+
+	return score
+}
+
+// metaTitle описание сущности из метаданных в том же виде, что и в списке сущностей с сервера
+func metaTitle(metas []*Metainfo) string {
+	if len(metas) == 0 {
+		return "нет описания."
+	}
+
+	parts := make([]string, 0, len(metas))
+	for _, meta := range metas {
+		parts = append(parts, meta.Title+":"+meta.Value+".")
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// trackingSender Sender, поддерживающий индекс поиска в актуальном состоянии в течение сессии
+type trackingSender struct {
+	Sender
+	index *SearchIndex
+}
+
+// AddEntity добавление сущности и ее индексация
+func (t *trackingSender) AddEntity(ae Entity) (int32, error) {
+	id, err := t.Sender.AddEntity(ae)
+	if err == nil && id > 0 && t.index.Built() {
+		ae.Id = id
+		_ = t.index.Put(ae)
+	}
+
+	return id, err
+}
+
+// SaveEntity сохранение сущности и обновление индекса
+func (t *trackingSender) SaveEntity(ae Entity) (int32, error) {
+	id, err := t.Sender.SaveEntity(ae)
+	if err == nil && t.index.Built() {
+		_ = t.index.Put(ae)
+	}
+
+	return id, err
+}
+
+// DeleteEntity перемещение сущности в корзину и удаление из индекса
+func (t *trackingSender) DeleteEntity(id int32) error {
+	err := t.Sender.DeleteEntity(id)
+	if err == nil {
+		t.index.Remove(id)
+	}
+
+	return err
+}
+
+// RestoreEntity восстановление сущности из корзины и ее индексация
+func (t *trackingSender) RestoreEntity(id int32) error {
+	err := t.Sender.RestoreEntity(id)
+	if err != nil || !t.index.Built() {
+		return err
+	}
+
+	ent, err := t.Sender.Entity(id)
+	if err == nil {
+		_ = t.index.Put(*ent)
+	}
+
+	return nil
+}
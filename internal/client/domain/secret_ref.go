@@ -0,0 +1,206 @@
+// Ссылки на значения полей сущностей для передачи секретов в дочерние процессы и шаблоны
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/otp"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// SecretResolver получение значений полей сущностей по ссылкам вида:
+//
+//	<id>/<поле>              - поле сущности с указанным ID
+//	<id>                     - единственное поле сущности с указанным ID
+//	<тип>/<название>/<поле>  - поле сущности указанного типа, у которой одно из значений метаданных равно названию
+//
+// Вместо секрета OTP возвращается текущий код, файловые поля не поддерживаются
+type SecretResolver struct {
+	Sender Sender              // отправка-получение данных на/с сервера
+	fields map[string][]*Field // описания полей по типам сущностей
+	lists  map[string][]int32  // ID сущностей по типам
+	cache  map[int32]*Entity   // полученные сущности
+	now    func() time.Time    // текущее время для генерации кодов OTP
+}
+
+// NewSecretResolver конструктор, Sender должен быть уже аутентифицирован
+func NewSecretResolver(sender Sender) (*SecretResolver, error) {
+	return &SecretResolver{
+		Sender: sender,
+		fields: make(map[string][]*Field),
+		lists:  make(map[string][]int32),
+		cache:  make(map[int32]*Entity),
+		now:    time.Now,
+	}, nil
+}
+
+// Resolve значение поля по ссылке
+func (r *SecretResolver) Resolve(ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+
+	var ent *Entity
+	var fieldName string
+	var err error
+
+	// название поля может содержать "/", поэтому оно всегда последняя часть ссылки
+	head, rest, _ := strings.Cut(ref, "/")
+	if id, errID := strconv.ParseInt(head, 10, 32); errID == nil {
+		ent, err = r.entity(int32(id))
+		fieldName = rest
+	} else {
+		parts := strings.SplitN(ref, "/", 3)
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return "", fmt.Errorf("неверная ссылка %q: ожидается <id>/<поле> или <тип>/<название>/<поле>", ref)
+		}
+		ent, err = r.entityByName(parts[0], parts[1])
+		fieldName = parts[2]
+	}
+	if err != nil {
+		return "", fmt.Errorf("ссылка %q: %w", ref, err)
+	}
+
+	value, err := r.value(ent, fieldName)
+	if err != nil {
+		return "", fmt.Errorf("ссылка %q: %w", ref, err)
+	}
+
+	return value, nil
+}
+
+// RenderTemplate подстановка секретов в текст шаблона с плейсхолдерами {{ secret "ссылка" }}
+func (r *SecretResolver) RenderTemplate(name string, text string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap{
+		"secret": r.Resolve,
+	}).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var out strings.Builder
+	err = tmpl.Execute(&out, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
+
+// entity получение сущности с кешированием
+func (r *SecretResolver) entity(id int32) (*Entity, error) {
+	if ent, ok := r.cache[id]; ok {
+		return ent, nil
+	}
+
+	ent, err := r.Sender.Entity(id)
+	if err != nil {
+		return nil, err
+	}
+	if ent == nil || len(ent.Props) == 0 {
+		return nil, fmt.Errorf("сущность %v не найдена", id)
+	}
+	r.cache[id] = ent
+
+	return ent, nil
+}
+
+// entityByName поиск сущности указанного типа по значению метаданных
+func (r *SecretResolver) entityByName(etype string, name string) (*Entity, error) {
+	ids, ok := r.lists[etype]
+	if !ok {
+		list, err := r.Sender.EntityList(etype)
+		if err != nil {
+			return nil, err
+		}
+		for id := range list {
+			ids = append(ids, id)
+		}
+		r.lists[etype] = ids
+	}
+
+	var found *Entity
+	for _, id := range ids {
+		ent, err := r.entity(id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, meta := range ent.Metainfo {
+			if !strings.EqualFold(strings.TrimSpace(meta.Value), name) {
+				continue
+			}
+			if found != nil && found.Id != ent.Id {
+				return nil, fmt.Errorf("название %q неоднозначно: сущности %v и %v", name, found.Id, ent.Id)
+			}
+			found = ent
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("сущность %v с названием %q не найдена", etype, name)
+	}
+
+	return found, nil
+}
+
+// value значение поля сущности по названию поля
+func (r *SecretResolver) value(ent *Entity, fieldName string) (string, error) {
+	fields, ok := r.fields[ent.Etype]
+	if !ok {
+		var err error
+		fields, err = r.Sender.Fields(ent.Etype)
+		if err != nil {
+			return "", err
+		}
+		r.fields[ent.Etype] = fields
+	}
+
+	var prop *Property
+	var field *Field
+	switch {
+	case fieldName == "" && len(ent.Props) == 1:
+		prop = ent.Props[0]
+		field = fieldByID(fields, prop.FieldId)
+	case fieldName == "":
+		return "", errors.New("у сущности несколько полей, укажите название поля")
+	default:
+		for _, p := range ent.Props {
+			f := fieldByID(fields, p.FieldId)
+			if f != nil && strings.EqualFold(f.Name, fieldName) {
+				prop, field = p, f
+				break
+			}
+		}
+	}
+	if prop == nil || field == nil {
+		return "", fmt.Errorf("поле %q не найдено", fieldName)
+	}
+
+	switch field.Ftype {
+	case constants.FieldTypePath:
+		return "", fmt.Errorf("поле %q содержит файл", field.Name)
+	case constants.FieldTypeOTP:
+		k, err := otp.Parse(prop.Value)
+		if err != nil {
+			return "", err
+		}
+		return k.Code(r.now())
+	}
+
+	return prop.Value, nil
+}
+
+// fieldByID поиск описания поля по ID
+func fieldByID(fields []*Field, id int32) *Field {
+	for _, f := range fields {
+		if f.Id == id {
+			return f
+		}
+	}
+
+	return nil
+}
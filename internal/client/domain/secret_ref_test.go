@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+func newSecretResolver(t *testing.T) *SecretResolver {
+	sender := NewMockSender(gomock.NewController(t))
+
+	fields := map[string][]*Field{
+		constants.LogopasEntity: {
+			{Id: 1, Etype: constants.LogopasEntity, Name: "Логин", Ftype: constants.FieldTypeString},
+			{Id: 2, Etype: constants.LogopasEntity, Name: "Пароль", Ftype: constants.FieldTypeString},
+		},
+		constants.CardEntity: {
+			{Id: 3, Etype: constants.CardEntity, Name: "Месяц/Год (mm/yy) до которого действует карта", Ftype: constants.FieldTypeString},
+		},
+		constants.OTPEntity: {
+			{Id: 4, Etype: constants.OTPEntity, Name: "Секрет", Ftype: constants.FieldTypeOTP},
+		},
+		constants.BinaryEntity: {
+			{Id: 5, Etype: constants.BinaryEntity, Name: "Файл", Ftype: constants.FieldTypePath},
+		},
+	}
+	entities := map[int32]*Entity{
+		10: logopas(10, "alice", "s3cret", &Metainfo{Title: "сайт", Value: "github"}),
+		11: logopas(11, "bob", "pa55", &Metainfo{Title: "сайт", Value: "gitlab"}),
+		12: logopas(12, "carol", "qwerty", &Metainfo{Title: "сайт", Value: "gitlab"}),
+		20: {Id: 20, Etype: constants.CardEntity, Props: []*Property{{FieldId: 3, Value: "12/30"}}},
+		30: {Id: 30, Etype: constants.OTPEntity, Props: []*Property{{FieldId: 4, Value: "otpauth://totp/ACME:john?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&digits=8"}}},
+		40: {Id: 40, Etype: constants.BinaryEntity, Props: []*Property{{FieldId: 5, Value: `{"servername": "x"}`}}},
+	}
+
+	sender.EXPECT().Fields(gomock.Any()).DoAndReturn(func(etype string) ([]*Field, error) {
+		return fields[etype], nil
+	}).AnyTimes()
+	sender.EXPECT().EntityList(constants.LogopasEntity).Return(map[int32]string{10: "", 11: "", 12: ""}, nil).AnyTimes()
+	sender.EXPECT().Entity(gomock.Any()).DoAndReturn(func(id int32) (*Entity, error) {
+		if ent, ok := entities[id]; ok {
+			return ent, nil
+		}
+		return &Entity{Id: id}, nil
+	}).AnyTimes()
+
+	resolver, err := NewSecretResolver(sender)
+	require.NoError(t, err)
+	resolver.now = func() time.Time { return time.Unix(59, 0) }
+
+	return resolver
+}
+
+func TestSecretResolve(t *testing.T) {
+	resolver := newSecretResolver(t)
+
+	tests := []struct {
+		ref  string
+		want string
+		err  bool
+	}{
+		{ref: "10/Пароль", want: "s3cret"},
+		{ref: "10/логин", want: "alice"},
+		{ref: "logopas/github/Пароль", want: "s3cret"},
+		{ref: "20/Месяц/Год (mm/yy) до которого действует карта", want: "12/30"},
+		{ref: "20", want: "12/30"},
+		{ref: "30", want: "94287082"},                // RFC 6238, время 59
+		{ref: "10", err: true},                       // несколько полей
+		{ref: "10/Пин", err: true},                   // нет такого поля
+		{ref: "99/Пароль", err: true},                // нет такой сущности
+		{ref: "logopas/gitlab/Пароль", err: true},    // неоднозначное название
+		{ref: "logopas/bitbucket/Пароль", err: true}, // нет такого названия
+		{ref: "logopas/github", err: true},           // неверный формат
+		{ref: "40", err: true},                       // файловое поле
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := resolver.Resolve(tt.ref)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSecretRenderTemplate(t *testing.T) {
+	resolver := newSecretResolver(t)
+
+	out, err := resolver.RenderTemplate("app.conf", "user={{ secret \"10/Логин\" }}\npassword={{ secret \"logopas/github/Пароль\" }}\n")
+	require.NoError(t, err)
+	assert.Equal(t, "user=alice\npassword=s3cret\n", out)
+
+	_, err = resolver.RenderTemplate("app.conf", `{{ secret "10/Пин" }}`)
+	assert.Error(t, err)
+	_, err = resolver.RenderTemplate("app.conf", `{{ secret "10/Логин" `)
+	assert.Error(t, err)
+}
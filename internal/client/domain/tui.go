@@ -0,0 +1,1010 @@
+// Полноэкранный терминальный интерфейс: типы сущностей и дерево папок, список с поиском, просмотр и редактирование записей
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+	"github.com/charmbracelet/bubbles/cursor"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/otp"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// панели интерфейса
+type tuiPane int
+
+const (
+	paneTypes  tuiPane = iota // типы сущностей и папки
+	paneList                  // сущности выбранного типа
+	paneDetail                // просмотр сущности
+)
+
+const (
+	tuiMask        = "********" // значение скрытого секрета
+	tuiTypesWidth  = 22         // ширина панели типов сущностей
+	tuiDefaultSize = 100        // ширина экрана до получения размеров терминала
+)
+
+// сообщения о завершении фоновых операций с хранилищем
+type (
+	tuiCodesMsg struct {
+		codes   []*EntityCode
+		folders []FolderNode
+	}
+	tuiListMsg struct {
+		etype  string
+		folder int32
+		items  []ListItem
+	}
+	tuiEntityMsg struct {
+		ent    *Entity
+		fields []*Field
+		edit   bool // сразу открыть форму изменения
+	}
+	tuiDoneMsg struct {
+		status  string
+		id      int32 // ID добавленной или измененной сущности
+		removed bool  // сущность перемещена в корзину
+	}
+	tuiErrMsg struct {
+		err error
+	}
+)
+
+// TUI полноэкранный интерфейс поверх Commands
+// Операции с хранилищем выполняются в фоне командами bubbletea, интерфейс не блокируется
+type TUI struct {
+	cmds      *Commands
+	mu        sync.Mutex              // кэши Commands не потокобезопасны
+	Clipboard func(text string) error // копирование в буфер обмена
+	now       func() time.Time
+
+	width  int
+	height int
+	focus  tuiPane
+	codes  []*EntityCode
+	status string
+
+	folders   []FolderNode // дерево папок
+	typeIdx   int          // выбранный тип сущности, за типами следуют папки
+	items     []ListItem   // сущности выбранного типа или папки
+	filtered  []ListItem   // сущности, подходящие под строку поиска
+	listIdx   int
+	query     string
+	searching bool
+
+	ent      *Entity  // открытая сущность
+	fields   []*Field // описания полей открытой сущности
+	fieldIdx int
+	revealed bool
+
+	form    *tuiForm // форма добавления или изменения
+	confirm int32    // ID сущности, ожидающей подтверждения удаления
+}
+
+// NewTUI конструктор, Commands должны быть созданы над аутентифицированным Sender
+// По умолчанию значения копируются в буфер обмена терминала escape-последовательностью OSC 52
+func NewTUI(cmds *Commands) *TUI {
+	return &TUI{
+		cmds: cmds,
+		Clipboard: func(text string) error {
+			_, err := osc52.New(text).WriteTo(os.Stderr)
+			return err
+		},
+		now: time.Now,
+	}
+}
+
+// Init загрузка справочника типов сущностей и дерева папок
+func (t *TUI) Init() tea.Cmd {
+	return t.background(func() (tea.Msg, error) {
+		codes, err := t.cmds.entityCodes()
+		if err != nil {
+			return nil, err
+		}
+		folders, err := t.cmds.Folders()
+		return tuiCodesMsg{codes: codes, folders: folders}, err
+	})
+}
+
+// Update обработка нажатий клавиш и результатов фоновых операций
+func (t *TUI) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		t.width, t.height = msg.Width, msg.Height
+		return t, nil
+
+	case tuiCodesMsg:
+		t.codes, t.folders = msg.codes, msg.folders
+		if len(t.codes) == 0 {
+			t.status = "Нет доступных типов сущностей"
+			return t, nil
+		}
+		return t, t.loadList()
+
+	case tuiListMsg:
+		if msg.etype == t.etype() && msg.folder == t.folderID() {
+			t.items = msg.items
+			t.applyFilter()
+		}
+		return t, nil
+
+	case tuiEntityMsg:
+		t.ent, t.fields = msg.ent, msg.fields
+		t.fieldIdx, t.revealed = 0, false
+		t.focus = paneDetail
+		if msg.edit {
+			t.form = newTUIForm(t.ent.Etype, t.fields, t.ent, t.folderPath(t.ent.FolderId))
+		}
+		return t, nil
+
+	case tuiDoneMsg:
+		t.form = nil
+		t.status = msg.status
+		cmds := []tea.Cmd{t.loadList()}
+		if msg.removed {
+			if t.ent != nil && t.ent.Id == msg.id {
+				t.ent = nil
+			}
+			t.focus = paneList
+		} else if msg.id > 0 {
+			cmds = append(cmds, t.loadEntity(msg.id, false))
+		}
+		return t, tea.Batch(cmds...)
+
+	case tuiErrMsg:
+		if t.form != nil {
+			t.form.err = msg.err.Error()
+			return t, nil
+		}
+		t.status = "Ошибка: " + msg.err.Error()
+		return t, nil
+
+	case tea.KeyMsg:
+		return t.handleKey(msg)
+	}
+
+	return t, nil
+}
+
+// handleKey обработка нажатия клавиши в зависимости от состояния интерфейса
+func (t *TUI) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if key == "ctrl+c" {
+		return t, tea.Quit
+	}
+
+	switch {
+	case t.form != nil:
+		return t, t.updateForm(msg)
+	case t.searching:
+		t.updateSearch(msg)
+		return t, nil
+	case t.confirm != 0:
+		id := t.confirm
+		t.confirm = 0
+		if key == "y" {
+			return t, t.remove(id)
+		}
+		t.status = "Удаление отменено"
+		return t, nil
+	}
+
+	t.status = ""
+	switch key {
+	case "q":
+		return t, tea.Quit
+	case "tab":
+		t.focus = (t.focus + 1) % 3
+		if t.focus == paneDetail && t.ent == nil {
+			t.focus = paneTypes
+		}
+		return t, nil
+	case "shift+tab":
+		t.focus = (t.focus + 2) % 3
+		if t.focus == paneDetail && t.ent == nil {
+			t.focus = paneList
+		}
+		return t, nil
+	}
+
+	switch t.focus {
+	case paneTypes:
+		return t, t.keyTypes(key)
+	case paneList:
+		return t, t.keyList(key)
+	default:
+		return t, t.keyDetail(key)
+	}
+}
+
+// keyTypes клавиши панели типов сущностей и папок
+func (t *TUI) keyTypes(key string) tea.Cmd {
+	switch key {
+	case "up", "k", "down", "j":
+		idx := moveCursor(t.typeIdx, len(t.codes)+len(t.folders), key)
+		if idx == t.typeIdx {
+			return nil
+		}
+		t.typeIdx = idx
+		t.items, t.filtered, t.listIdx, t.query = nil, nil, 0, ""
+		return t.loadList()
+	case "enter", "right", "l":
+		t.focus = paneList
+	}
+
+	return nil
+}
+
+// keyList клавиши панели списка сущностей
+func (t *TUI) keyList(key string) tea.Cmd {
+	switch key {
+	case "up", "k", "down", "j":
+		t.listIdx = moveCursor(t.listIdx, len(t.filtered), key)
+	case "/":
+		t.searching = true
+	case "enter", "right", "l":
+		if item, ok := t.selected(); ok {
+			return t.loadEntity(item.Id, false)
+		}
+	case "n":
+		if etype := t.etype(); etype != "" {
+			return t.background(func() (tea.Msg, error) {
+				fields, err := t.cmds.fieldsOf(etype)
+				return tuiEntityMsg{ent: &Entity{Etype: etype}, fields: fields, edit: true}, err
+			})
+		}
+	case "e":
+		if item, ok := t.selected(); ok {
+			return t.loadEntity(item.Id, true)
+		}
+	case "d":
+		if item, ok := t.selected(); ok {
+			t.confirm = item.Id
+		}
+	case "esc", "left", "h":
+		if t.query != "" {
+			t.query = ""
+			t.applyFilter()
+			return nil
+		}
+		t.focus = paneTypes
+	}
+
+	return nil
+}
+
+// keyDetail клавиши панели просмотра сущности
+func (t *TUI) keyDetail(key string) tea.Cmd {
+	if t.ent == nil {
+		return nil
+	}
+
+	switch key {
+	case "up", "k", "down", "j":
+		t.fieldIdx = moveCursor(t.fieldIdx, len(t.ent.Props), key)
+	case "r":
+		t.revealed = !t.revealed
+	case "c":
+		t.copyValue()
+	case "e":
+		t.form = newTUIForm(t.ent.Etype, t.fields, t.ent, t.folderPath(t.ent.FolderId))
+	case "d":
+		t.confirm = t.ent.Id
+	case "esc", "left", "h":
+		t.focus = paneList
+	}
+
+	return nil
+}
+
+// updateSearch ввод строки поиска по списку сущностей
+func (t *TUI) updateSearch(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		t.searching = false
+	case tea.KeyEsc:
+		t.searching, t.query = false, ""
+	case tea.KeyBackspace:
+		if r := []rune(t.query); len(r) > 0 {
+			t.query = string(r[:len(r)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		t.query += string(msg.Runes)
+	}
+	t.applyFilter()
+}
+
+// applyFilter отбор сущностей, описание которых нечетко совпадает со строкой поиска
+func (t *TUI) applyFilter() {
+	pattern := []rune(strings.ToLower(strings.TrimSpace(t.query)))
+	t.filtered = t.filtered[:0]
+	for _, item := range t.items {
+		if len(pattern) == 0 || fuzzyScore(pattern, []rune(strings.ToLower(item.Title))) > 0 {
+			t.filtered = append(t.filtered, item)
+		}
+	}
+	if t.listIdx >= len(t.filtered) {
+		t.listIdx = max(len(t.filtered)-1, 0)
+	}
+}
+
+// copyValue копирование значения выбранного поля в буфер обмена, для OTP - текущего кода
+func (t *TUI) copyValue() {
+	prop, field := t.selectedProp()
+	if prop == nil {
+		return
+	}
+
+	value := prop.Value
+	switch field.Ftype {
+	case constants.FieldTypePath:
+		t.status = fmt.Sprintf("Поле %q содержит файл", field.Name)
+		return
+	case constants.FieldTypeOTP:
+		k, err := otp.Parse(prop.Value)
+		if err == nil {
+			value, err = k.Code(t.now())
+		}
+		if err != nil {
+			t.status = "Ошибка: " + err.Error()
+			return
+		}
+	}
+
+	err := t.Clipboard(value)
+	if err != nil {
+		t.status = "Ошибка копирования: " + err.Error()
+		return
+	}
+	t.status = fmt.Sprintf("Скопировано: %v", field.Name)
+}
+
+// remove перемещение сущности в корзину
+func (t *TUI) remove(id int32) tea.Cmd {
+	return t.background(func() (tea.Msg, error) {
+		err := t.cmds.Remove(id)
+		return tuiDoneMsg{status: fmt.Sprintf("Запись #%v перемещена в корзину", id), id: id, removed: true}, err
+	})
+}
+
+// loadList загрузка списка сущностей выбранного типа или всех сущностей выбранной папки
+func (t *TUI) loadList() tea.Cmd {
+	etype, folder := t.etype(), t.folderID()
+	if etype == "" && folder == 0 {
+		return nil
+	}
+
+	return t.background(func() (tea.Msg, error) {
+		items, err := t.cmds.ListFiltered(etype, ListFilter{FolderId: folder})
+		return tuiListMsg{etype: etype, folder: folder, items: items}, err
+	})
+}
+
+// loadEntity загрузка сущности с описаниями ее полей
+func (t *TUI) loadEntity(id int32, edit bool) tea.Cmd {
+	return t.background(func() (tea.Msg, error) {
+		// просмотр отмечает сущность в списке последних открытых, загрузка для редактирования - нет
+		get := t.cmds.openEntity
+		if edit {
+			get = t.cmds.entity
+		}
+		ent, err := get(id)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := t.cmds.fieldsOf(ent.Etype)
+		return tuiEntityMsg{ent: ent, fields: fields, edit: edit}, err
+	})
+}
+
+// background выполнение операции с хранилищем в фоне, ошибка превращается в сообщение для строки состояния
+func (t *TUI) background(fn func() (tea.Msg, error)) tea.Cmd {
+	return func() tea.Msg {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		msg, err := fn()
+		if err != nil {
+			return tuiErrMsg{err: err}
+		}
+		return msg
+	}
+}
+
+// etype код выбранного типа сущности
+func (t *TUI) etype() string {
+	if t.typeIdx >= len(t.codes) {
+		return ""
+	}
+
+	return t.codes[t.typeIdx].Etype
+}
+
+// folderID ID выбранной папки, 0 - выбран тип сущности
+func (t *TUI) folderID() int32 {
+	idx := t.typeIdx - len(t.codes)
+	if idx < 0 || idx >= len(t.folders) {
+		return 0
+	}
+
+	return t.folders[idx].Id
+}
+
+// folderPath путь папки по ID
+func (t *TUI) folderPath(id int32) string {
+	for _, node := range t.folders {
+		if node.Id == id {
+			return node.Path
+		}
+	}
+
+	return ""
+}
+
+// selected выбранный элемент списка сущностей
+func (t *TUI) selected() (ListItem, bool) {
+	if t.listIdx >= len(t.filtered) {
+		return ListItem{}, false
+	}
+
+	return t.filtered[t.listIdx], true
+}
+
+// selectedProp выбранное свойство открытой сущности и описание его поля
+func (t *TUI) selectedProp() (*Property, *Field) {
+	if t.ent == nil || t.fieldIdx >= len(t.ent.Props) {
+		return nil, nil
+	}
+	prop := t.ent.Props[t.fieldIdx]
+	field := fieldByID(t.fields, prop.FieldId)
+	if field == nil {
+		return nil, nil
+	}
+
+	return prop, field
+}
+
+// displayProp значение свойства для панели просмотра
+// Секреты скрыты до нажатия r, вместо секрета OTP показывается текущий код, вместо SSH ключа - отпечаток
+func (t *TUI) displayProp(prop *Property, field *Field) string {
+	switch field.Ftype {
+	case constants.FieldTypePath:
+		fd := &BinaryFileProperty{}
+		if json.Unmarshal([]byte(prop.Value), fd) == nil {
+			return path.Base(fd.Clientname)
+		}
+		return prop.Value
+	case constants.FieldTypeSSHKey:
+		return sshKeyDescription(prop.Value, propValueByFtype(t.ent.Props, constants.FieldTypeSSHPassphrase, fieldLookupOf(t.fields)))
+	case constants.FieldTypeSSHPublicKey, constants.FieldTypeSSHComment:
+		return prop.Value
+	}
+
+	if prop.Value == "" {
+		return ""
+	}
+	if !t.revealed {
+		return tuiMask
+	}
+	if field.Ftype == constants.FieldTypeOTP {
+		return otpDescription(prop.Value, t.now())
+	}
+
+	return prop.Value
+}
+
+// View отрисовка экрана: три панели и строка состояния
+func (t *TUI) View() string {
+	width, height := t.width, t.height
+	if width == 0 {
+		width, height = tuiDefaultSize, 30
+	}
+
+	// у каждой панели рамка занимает по 2 символа по ширине и высоте
+	innerHeight := max(height-3, 3)
+	listWidth := max((width-tuiTypesWidth-6)/2, 10)
+	detailWidth := max(width-tuiTypesWidth-listWidth-6, 10)
+
+	detail := t.viewDetail(detailWidth)
+	if t.form != nil {
+		detail = t.form.view(detailWidth)
+	}
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top,
+		paneStyle(t.focus == paneTypes && t.form == nil, tuiTypesWidth, innerHeight).Render(t.viewTypes(tuiTypesWidth, innerHeight)),
+		paneStyle(t.focus == paneList && t.form == nil, listWidth, innerHeight).Render(t.viewList(listWidth, innerHeight)),
+		paneStyle(t.focus == paneDetail || t.form != nil, detailWidth, innerHeight).Render(detail),
+	)
+
+	return body + "\n" + ansi.Truncate(t.viewStatus(), width, "…")
+}
+
+// viewTypes панель типов сущностей и дерева папок
+func (t *TUI) viewTypes(width, height int) string {
+	lines := []string{titleStyle.Render("Типы")}
+	for i, code := range t.codes {
+		lines = append(lines, cursorLine(i == t.typeIdx, typeTitle(code), width))
+	}
+	selected := t.typeIdx + 1
+	if len(t.folders) > 0 {
+		lines = append(lines, titleStyle.Render("Папки"))
+		for i, node := range t.folders {
+			lines = append(lines, cursorLine(len(t.codes)+i == t.typeIdx, strings.Repeat("  ", node.Depth)+node.Name, width))
+		}
+		if t.typeIdx >= len(t.codes) {
+			selected++
+		}
+	}
+
+	return strings.Join(scrollWindow(lines, selected, height), "\n")
+}
+
+// viewList панель списка сущностей с строкой поиска
+func (t *TUI) viewList(width, height int) string {
+	header := titleStyle.Render("Записи")
+	if t.searching || t.query != "" {
+		header = "/" + t.query
+		if t.searching {
+			header += "_"
+		}
+	}
+
+	lines := []string{header}
+	for i, item := range t.filtered {
+		lines = append(lines, cursorLine(i == t.listIdx, item.Title, width))
+	}
+	if len(t.filtered) == 0 {
+		lines = append(lines, "  (пусто)")
+	}
+
+	return strings.Join(scrollWindow(lines, t.listIdx+1, height), "\n")
+}
+
+// viewDetail панель просмотра сущности
+func (t *TUI) viewDetail(width int) string {
+	if t.ent == nil {
+		return "Выберите запись"
+	}
+
+	name := t.ent.Etype
+	for _, code := range t.codes {
+		if code.Etype == t.ent.Etype {
+			name = code.Name
+		}
+	}
+
+	lines := []string{titleStyle.Render(fmt.Sprintf("%v #%v", name, t.ent.Id))}
+	for i, prop := range t.ent.Props {
+		field := fieldByID(t.fields, prop.FieldId)
+		if field == nil {
+			continue
+		}
+		value := strings.ReplaceAll(t.displayProp(prop, field), "\n", "⏎")
+		lines = append(lines, cursorLine(i == t.fieldIdx, field.Name+": "+value, width))
+	}
+	if len(t.ent.Metainfo) > 0 {
+		lines = append(lines, "", titleStyle.Render("Метаданные"))
+		for _, meta := range t.ent.Metainfo {
+			lines = append(lines, ansi.Truncate("  "+meta.Title+": "+meta.Value, width, "…"))
+		}
+	}
+	if folder := t.folderPath(t.ent.FolderId); folder != "" {
+		lines = append(lines, "", ansi.Truncate("Папка: "+folder, width, "…"))
+	}
+	if len(t.ent.Tags) > 0 {
+		lines = append(lines, ansi.Truncate("Теги: "+strings.Join(t.ent.Tags, ", "), width, "…"))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// viewStatus подсказка по клавишам или результат последнего действия
+func (t *TUI) viewStatus() string {
+	switch {
+	case t.confirm != 0:
+		return fmt.Sprintf("Переместить запись #%v в корзину? (y/n)", t.confirm)
+	case t.status != "":
+		return t.status
+	case t.form != nil:
+		return "tab/↓ далее  shift+tab/↑ назад  ctrl+r показать  ctrl+s сохранить  esc отмена"
+	case t.searching:
+		return "Поиск: enter применить  esc сбросить"
+	}
+
+	switch t.focus {
+	case paneTypes:
+		return "↑/↓ тип или папка  enter записи  tab панель  q выход"
+	case paneList:
+		return "↑/↓ запись  enter открыть  / поиск  n новая  e изменить  d удалить  esc назад  q выход"
+	default:
+		return "↑/↓ поле  r показать  c копировать  e изменить  d удалить  esc назад  q выход"
+	}
+}
+
+// tuiForm форма добавления или изменения сущности
+// Поля проверяются по их правилам валидации при переходе к другому полю и перед сохранением
+type tuiForm struct {
+	id    int32  // ID изменяемой сущности, 0 - новая
+	etype string // тип сущности
+	rows  []*tuiFormRow
+	idx   int    // поле ввода в фокусе
+	err   string // ошибка сохранения
+}
+
+// строки формы, задающие размещение сущности
+const (
+	tuiRowFolder = "Папка" // путь папки
+	tuiRowTags   = "Теги"  // теги через запятую
+)
+
+// tuiFormRow поле ввода формы: свойство, существующие или новые метаданные, папка и теги
+type tuiFormRow struct {
+	field   *Field // описание поля, nil для метаданных
+	meta    string // название метаданных, пусто для новых (ввод название=значение)
+	place   string // tuiRowFolder или tuiRowTags, пусто для полей и метаданных
+	initial string // исходное значение, неизмененные поля не сохраняются
+	input   textinput.Model
+	err     string // ошибка валидации
+}
+
+// value введенное значение, многострочный текст вводится в одну строку с \n вместо перевода строки
+func (row *tuiFormRow) value() string {
+	if row.field != nil && row.field.Ftype == constants.FieldTypeMultiline {
+		return unescapeMultiline(row.input.Value())
+	}
+
+	return row.input.Value()
+}
+
+// newTUIForm форма для сущности, у новой сущности Id равен 0, folder - путь папки сущности
+// Файл у сохраненной сущности не меняется, приватный SSH ключ заменяется только при вводе пути к новому
+func newTUIForm(etype string, fields []*Field, ent *Entity, folder string) *tuiForm {
+	form := &tuiForm{id: ent.Id, etype: etype}
+
+	for _, field := range fields {
+		if form.id > 0 && field.Ftype == constants.FieldTypePath {
+			continue
+		}
+		row := &tuiFormRow{field: field, input: newTUIInput()}
+		if prop := propByFieldID(ent.Props, field.Id); prop != nil && field.Ftype != constants.FieldTypeSSHKey {
+			row.initial = prop.Value
+			if field.Ftype == constants.FieldTypeMultiline {
+				row.initial = escapeMultiline(prop.Value)
+			}
+		}
+		row.input.Placeholder = fieldTypeHint(field)
+		if field.Ftype == constants.FieldTypePath || field.Ftype == constants.FieldTypeSSHKey {
+			row.input.Placeholder = "путь к файлу"
+			if form.id > 0 {
+				row.input.Placeholder = "пусто - оставить без изменений"
+			}
+		}
+		if isMaskedField(field) {
+			row.input.EchoMode = textinput.EchoPassword
+		}
+		row.input.SetValue(row.initial)
+		form.rows = append(form.rows, row)
+	}
+
+	for _, meta := range ent.Metainfo {
+		row := &tuiFormRow{meta: meta.Title, initial: meta.Value, input: newTUIInput()}
+		row.input.SetValue(meta.Value)
+		form.rows = append(form.rows, row)
+	}
+	row := &tuiFormRow{input: newTUIInput()}
+	row.input.Placeholder = "название=значение"
+	form.rows = append(form.rows, row)
+
+	row = &tuiFormRow{place: tuiRowFolder, initial: folder, input: newTUIInput()}
+	row.input.Placeholder = "путь" + FolderSeparator + "к" + FolderSeparator + "папке"
+	row.input.SetValue(folder)
+	form.rows = append(form.rows, row)
+	row = &tuiFormRow{place: tuiRowTags, initial: strings.Join(ent.Tags, ", "), input: newTUIInput()}
+	row.input.Placeholder = "через запятую"
+	row.input.SetValue(row.initial)
+	form.rows = append(form.rows, row)
+
+	form.rows[0].input.Focus()
+
+	return form
+}
+
+// newTUIInput поле ввода без мигающего курсора
+func newTUIInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = ""
+	input.Cursor.SetMode(cursor.CursorStatic)
+
+	return input
+}
+
+// label подпись поля ввода
+func (r *tuiFormRow) label() string {
+	switch {
+	case r.field != nil:
+		return r.field.Name
+	case r.place != "":
+		return r.place
+	case r.meta != "":
+		return r.meta
+	default:
+		return "Новые метаданные"
+	}
+}
+
+// updateForm ввод в форме, переход между полями и сохранение
+func (t *TUI) updateForm(msg tea.KeyMsg) tea.Cmd {
+	form := t.form
+	switch msg.String() {
+	case "esc":
+		t.form = nil
+		t.status = "Изменения отменены"
+		if t.ent != nil && t.ent.Id == 0 {
+			t.ent = nil
+			t.focus = paneList
+		}
+		return nil
+	case "tab", "down":
+		t.validateRow(form.rows[form.idx])
+		form.focus((form.idx + 1) % len(form.rows))
+		return nil
+	case "shift+tab", "up":
+		t.validateRow(form.rows[form.idx])
+		form.focus((form.idx + len(form.rows) - 1) % len(form.rows))
+		return nil
+	case "enter":
+		t.validateRow(form.rows[form.idx])
+		if form.idx < len(form.rows)-1 {
+			form.focus(form.idx + 1)
+			return nil
+		}
+		return t.submit()
+	case "ctrl+s":
+		return t.submit()
+	case "ctrl+r":
+		row := form.rows[form.idx]
+		if row.input.EchoMode == textinput.EchoPassword {
+			row.input.EchoMode = textinput.EchoNormal
+		} else if row.field != nil && row.input.EchoMode == textinput.EchoNormal && isMaskedField(row.field) {
+			row.input.EchoMode = textinput.EchoPassword
+		}
+		return nil
+	}
+
+	var cmd tea.Cmd
+	row := form.rows[form.idx]
+	row.input, cmd = row.input.Update(msg)
+
+	return cmd
+}
+
+// focus перевод фокуса на поле ввода
+func (f *tuiForm) focus(idx int) {
+	f.rows[f.idx].input.Blur()
+	f.idx = idx
+	f.rows[f.idx].input.Focus()
+}
+
+// changed поле требуется сохранить: у новой сущности - все поля, у сохраненной - только измененные
+func (f *tuiForm) changed(row *tuiFormRow) bool {
+	value := row.input.Value()
+	if f.id == 0 {
+		return row.field != nil || value != ""
+	}
+	if row.field != nil && row.field.Ftype == constants.FieldTypeSSHKey {
+		return value != ""
+	}
+
+	return value != row.initial
+}
+
+// validateRow проверка поля ввода по правилам валидации поля сущности
+func (t *TUI) validateRow(row *tuiFormRow) bool {
+	row.err = ""
+	if !t.form.changed(row) {
+		return true
+	}
+
+	if row.field == nil {
+		if row.meta == "" && row.place == "" && !strings.Contains(row.input.Value(), "=") {
+			row.err = "ожидается название=значение"
+		}
+		return row.err == ""
+	}
+
+	_, err := t.cmds.validate(row.field, row.value())
+	if err != nil {
+		row.err = strings.TrimPrefix(err.Error(), fmt.Sprintf("%v: %v: ", ErrValidation, row.field.Name))
+	}
+
+	return row.err == ""
+}
+
+// submit проверка всех полей формы и сохранение сущности в фоне
+func (t *TUI) submit() tea.Cmd {
+	form := t.form
+	form.err = ""
+
+	valid := true
+	values := make(map[string]string)
+	var metas []*Metainfo
+	var place Placement
+	for _, row := range form.rows {
+		if !t.validateRow(row) {
+			valid = false
+			continue
+		}
+		if !form.changed(row) {
+			continue
+		}
+		switch {
+		case row.field != nil:
+			values[row.field.Name] = row.value()
+		case row.place == tuiRowFolder:
+			place.Folder = row.input.Value()
+			if place.Folder == "" {
+				place.Folder = FolderSeparator
+			}
+		case row.place == tuiRowTags:
+			place.Tags = splitTags(row.input.Value())
+			kept := make(map[string]bool, len(place.Tags))
+			for _, tag := range place.Tags {
+				kept[strings.ToLower(tag)] = true
+			}
+			for _, tag := range splitTags(row.initial) {
+				if !kept[strings.ToLower(tag)] {
+					place.Untags = append(place.Untags, tag)
+				}
+			}
+		case row.meta != "":
+			metas = append(metas, &Metainfo{Title: row.meta, Value: row.input.Value()})
+		default:
+			title, value, _ := strings.Cut(row.input.Value(), "=")
+			metas = append(metas, &Metainfo{Title: strings.TrimSpace(title), Value: strings.TrimSpace(value)})
+		}
+	}
+	if !valid {
+		form.err = "Исправьте ошибки в полях"
+		return nil
+	}
+
+	id, etype := form.id, form.etype
+
+	return t.background(func() (tea.Msg, error) {
+		switch {
+		case id > 0:
+			err := t.cmds.Edit(id, values, metas, place)
+			return tuiDoneMsg{status: fmt.Sprintf("Запись #%v сохранена", id) + t.breachStatus(), id: id}, err
+
+		case isFileEntity(etype):
+			fields, err := t.cmds.fieldsOf(etype)
+			if err != nil {
+				return nil, err
+			}
+			newID, size, err := t.cmds.Upload(etype, values[fields[0].Name], metas, place)
+			if err != nil && newID == 0 {
+				return nil, err
+			}
+			if err != nil {
+				return nil, errors.Join(fmt.Errorf("запись #%v добавлена, но файл не загружен", newID), err)
+			}
+			return tuiDoneMsg{status: fmt.Sprintf("Запись #%v добавлена, загружено %v байт", newID, size), id: newID}, nil
+
+		default:
+			newID, err := t.cmds.Add(etype, values, metas, place)
+			return tuiDoneMsg{status: fmt.Sprintf("Запись #%v добавлена", newID) + t.breachStatus(), id: newID}, err
+		}
+	})
+}
+
+// breachStatus предупреждение к статусу сохранения о паролях, найденных в базе утечек
+func (t *TUI) breachStatus() string {
+	items, err := t.cmds.Breached()
+	if err != nil {
+		return ". Проверка по базе утечек не выполнена: " + err.Error()
+	}
+	var warnings []string
+	for _, item := range items {
+		warnings = append(warnings, BreachWarning(item.Field, item.Count))
+	}
+	if len(warnings) == 0 {
+		return ""
+	}
+
+	return ". " + strings.Join(warnings, ". ")
+}
+
+// view отрисовка формы
+func (f *tuiForm) view(width int) string {
+	title := "Новая запись"
+	if f.id > 0 {
+		title = fmt.Sprintf("Изменение записи #%v", f.id)
+	}
+
+	lines := []string{titleStyle.Render(title)}
+	for i, row := range f.rows {
+		f.rows[i].input.Width = max(width-4, 1)
+		lines = append(lines, cursorLine(i == f.idx, row.label()+":", width), "  "+row.input.View())
+		if row.err != "" {
+			lines = append(lines, errorStyle.Render(ansi.Truncate("  "+row.err, width, "…")))
+		}
+	}
+	if f.err != "" {
+		lines = append(lines, "", errorStyle.Render(ansi.Truncate(f.err, width, "…")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// splitTags теги из строки через запятую
+func splitTags(text string) []string {
+	return normalizeTags(strings.Split(text, ","))
+}
+
+var (
+	titleStyle  = lipgloss.NewStyle().Bold(true)
+	errorStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	activeColor = lipgloss.Color("12")
+)
+
+// paneStyle рамка панели, активная панель выделяется цветом
+func paneStyle(active bool, width, height int) lipgloss.Style {
+	style := lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Width(width).Height(height).MaxHeight(height + 2)
+	if active {
+		style = style.BorderForeground(activeColor)
+	}
+
+	return style
+}
+
+// cursorLine строка списка с отметкой выбранного элемента, обрезанная по ширине панели
+func cursorLine(selected bool, text string, width int) string {
+	prefix := "  "
+	if selected {
+		prefix = "> "
+	}
+
+	return ansi.Truncate(prefix+text, width, "…")
+}
+
+// scrollWindow строки, помещающиеся в панель, с сохранением видимости выбранной строки
+// Первая строка - заголовок, она видна всегда
+func scrollWindow(lines []string, selected int, height int) []string {
+	if len(lines) <= height || height < 2 {
+		return lines
+	}
+
+	start := max(selected-height+2, 1)
+	end := min(start+height-1, len(lines))
+
+	return append([]string{lines[0]}, lines[start:end]...)
+}
+
+// moveCursor перемещение курсора списка клавишами вверх/вниз
+func moveCursor(idx int, count int, key string) int {
+	switch key {
+	case "up", "k":
+		if idx > 0 {
+			return idx - 1
+		}
+	case "down", "j":
+		if idx < count-1 {
+			return idx + 1
+		}
+	}
+
+	return idx
+}
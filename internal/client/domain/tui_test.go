@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// newTestTUI интерфейс над хранилищем с двумя записями logopas и папками work/team, скопированные значения сохраняются в copied
+func newTestTUI(t *testing.T) (*TUI, *MockSender, *[]string) {
+	cmds, sender := newTestCommands(t)
+
+	sender.EXPECT().EntityCodes().Return([]*EntityCode{
+		{Etype: constants.LogopasEntity, Name: "Логин/пароль"},
+		{Etype: constants.CardEntity, Name: "Банковская карта"},
+	}, nil).AnyTimes()
+	sender.EXPECT().EntityList(constants.LogopasEntity).Return(map[int32]string{7: "сайт:github. ", 8: "сайт:gitlab. "}, nil).AnyTimes()
+	sender.EXPECT().Folders().Return([]*Folder{{Id: 3, Name: "work"}, {Id: 4, ParentId: 3, Name: "team"}}, nil).AnyTimes()
+	entity7 := func(int32) (*Entity, error) {
+		return logopas(7, "alice", "s3cret", &Metainfo{EntityId: 7, Title: "сайт", Value: "github"}), nil
+	}
+	sender.EXPECT().Entity(int32(7)).DoAndReturn(entity7).AnyTimes()
+	sender.EXPECT().OpenEntity(int32(7)).DoAndReturn(entity7).AnyTimes()
+
+	var copied []string
+	tui := NewTUI(cmds)
+	tui.Clipboard = func(text string) error {
+		copied = append(copied, text)
+		return nil
+	}
+	drive(t, tui, tui.Init())
+	drive(t, tui, func() tea.Msg { return tea.WindowSizeMsg{Width: 120, Height: 20} })
+
+	return tui, sender, &copied
+}
+
+// drive синхронное выполнение команды bubbletea и всех порожденных ею команд
+func drive(t *testing.T, m tea.Model, cmd tea.Cmd) {
+	t.Helper()
+
+	queue := []tea.Cmd{cmd}
+	for len(queue) > 0 {
+		cmd, queue = queue[0], queue[1:]
+		if cmd == nil {
+			continue
+		}
+		switch msg := cmd().(type) {
+		case tea.BatchMsg:
+			queue = append(queue, msg...)
+		case tea.QuitMsg, nil:
+		default:
+			_, next := m.Update(msg)
+			queue = append(queue, next)
+		}
+	}
+}
+
+// press нажатие клавиш: названия специальных клавиш (enter, esc, tab, ctrl+s ...) или печатаемый текст
+func press(t *testing.T, m tea.Model, keys ...string) {
+	t.Helper()
+
+	special := map[string]tea.KeyType{
+		"enter": tea.KeyEnter, "esc": tea.KeyEsc, "tab": tea.KeyTab, "down": tea.KeyDown, "up": tea.KeyUp,
+		"ctrl+s": tea.KeyCtrlS, "ctrl+u": tea.KeyCtrlU, "ctrl+r": tea.KeyCtrlR, "backspace": tea.KeyBackspace,
+	}
+	for _, key := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		if kt, ok := special[key]; ok {
+			msg = tea.KeyMsg{Type: kt}
+		}
+		_, cmd := m.Update(msg)
+		drive(t, m, cmd)
+	}
+}
+
+func TestTUIBrowse(t *testing.T) {
+	tui, _, copied := newTestTUI(t)
+
+	view := tui.View()
+	assert.Contains(t, view, "> Логин/пароль")
+	assert.Contains(t, view, "сайт:github.")
+	assert.Contains(t, view, "сайт:gitlab.")
+
+	// поиск по списку
+	press(t, tui, "enter", "/", "l", "a", "b", "enter")
+	view = tui.View()
+	assert.Contains(t, view, "/lab")
+	assert.NotContains(t, view, "сайт:github.")
+	press(t, tui, "esc")
+	assert.Contains(t, tui.View(), "сайт:github.")
+
+	// просмотр: секреты скрыты до нажатия r
+	press(t, tui, "enter")
+	view = tui.View()
+	assert.Contains(t, view, "Логин/пароль #7")
+	assert.Contains(t, view, "Пароль: "+tuiMask)
+	assert.Contains(t, view, "сайт: github")
+	assert.NotContains(t, view, "s3cret")
+
+	press(t, tui, "r")
+	assert.Contains(t, tui.View(), "Пароль: s3cret")
+
+	press(t, tui, "j", "c")
+	assert.Equal(t, []string{"s3cret"}, *copied)
+	assert.Contains(t, tui.View(), "Скопировано: Пароль")
+}
+
+func TestTUIEdit(t *testing.T) {
+	tui, sender, _ := newTestTUI(t)
+
+	press(t, tui, "enter", "enter", "e")
+	assert.Contains(t, tui.View(), "Изменение записи #7")
+
+	// пустой пароль не проходит валидацию, сохранения нет
+	press(t, tui, "tab", "ctrl+u", "ctrl+s")
+	view := tui.View()
+	assert.Contains(t, view, "Пароль не может быть пустым")
+	assert.Contains(t, view, "Исправьте ошибки в полях")
+
+	sender.EXPECT().SaveEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, "alice", ent.Props[0].Value)
+		assert.Equal(t, "new", ent.Props[1].Value)
+		assert.Equal(t, []*Metainfo{{EntityId: 7, Title: "сайт", Value: "github"}, {EntityId: 7, Title: "env", Value: "prod"}}, ent.Metainfo)
+		return 7, nil
+	})
+	press(t, tui, "n", "e", "w", "tab", "tab", "env=prod", "ctrl+s")
+	assert.Nil(t, tui.form)
+	assert.Contains(t, tui.View(), "Запись #7 сохранена")
+
+	// удаление с подтверждением
+	press(t, tui, "d", "n")
+	assert.Contains(t, tui.View(), "Удаление отменено")
+	sender.EXPECT().DeleteEntity(int32(7)).Return(nil)
+	press(t, tui, "d", "y")
+	assert.Nil(t, tui.ent)
+	assert.Contains(t, tui.View(), "Запись #7 перемещена в корзину")
+}
+
+func TestTUIAdd(t *testing.T) {
+	tui, sender, _ := newTestTUI(t)
+
+	sender.EXPECT().AddEntity(gomock.Any()).DoAndReturn(func(ent Entity) (int32, error) {
+		assert.Equal(t, constants.LogopasEntity, ent.Etype)
+		assert.Equal(t, []*Property{{FieldId: 1, Value: "bob"}, {FieldId: 2, Value: "pw"}}, ent.Props)
+		assert.Equal(t, int32(4), ent.FolderId)
+		assert.Equal(t, []string{"dev", "ci"}, ent.Tags)
+		return 7, nil
+	})
+	press(t, tui, "enter", "n")
+	assert.Contains(t, tui.View(), "Новая запись")
+	press(t, tui, "bob", "enter", "pw", "enter", "enter", "work/team", "enter", "dev, ci,dev", "enter")
+	assert.Nil(t, tui.form)
+	assert.Contains(t, tui.View(), "Запись #7 добавлена")
+	assert.Equal(t, int32(7), tui.ent.Id)
+}
+
+func TestTUIFolders(t *testing.T) {
+	tui, sender, _ := newTestTUI(t)
+
+	view := tui.View()
+	assert.Contains(t, view, "Папки")
+	assert.Contains(t, view, "  work")
+	assert.Contains(t, view, "    team")
+
+	sender.EXPECT().EntityList(constants.CardEntity).Return(map[int32]string{9: "банк:Альфа. "}, nil)
+	// в папке показываются сущности всех типов
+	sender.EXPECT().EntityListFiltered(gomock.Any(), ListFilter{FolderId: 3}).Return(nil, nil).Times(2)
+	sender.EXPECT().EntityListFiltered(constants.LogopasEntity, ListFilter{FolderId: 4}).Return(map[int32]string{7: "сайт:github. "}, nil)
+	sender.EXPECT().EntityListFiltered(constants.CardEntity, ListFilter{FolderId: 4}).Return(map[int32]string{9: "банк:Альфа. "}, nil)
+	press(t, tui, "j", "j", "j")
+	assert.Equal(t, int32(4), tui.folderID())
+	view = tui.View()
+	assert.Contains(t, view, ">   team")
+	assert.Contains(t, view, "банк:Альфа.")
+	assert.NotContains(t, view, "сайт:gitlab.")
+}
+
+// syncBuffer буфер, в который пишет программа bubbletea и из которого читает тест
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// TestTUIProgram работа программы bubbletea с фиктивным терминалом: ввод из pipe, вывод в буфер
+func TestTUIProgram(t *testing.T) {
+	tui, _, _ := newTestTUI(t)
+	tui.codes, tui.items, tui.filtered = nil, nil, nil
+
+	in, keys := io.Pipe()
+	out := &syncBuffer{}
+	p := tea.NewProgram(tui, tea.WithInput(in), tea.WithOutput(out), tea.WithoutSignalHandler())
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := p.Run()
+		done <- err
+	}()
+	p.Send(tea.WindowSizeMsg{Width: 120, Height: 20})
+
+	waitFor := func(text string) {
+		t.Helper()
+		require.Eventually(t, func() bool { return strings.Contains(out.String(), text) }, 5*time.Second, 10*time.Millisecond, text)
+	}
+
+	waitFor("сайт:gitlab.")
+	_, err := keys.Write([]byte("\r\r"))
+	require.NoError(t, err)
+	waitFor("Логин/пароль #7")
+	_, err = keys.Write([]byte("q"))
+	require.NoError(t, err)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("программа не завершилась")
+	}
+}
@@ -0,0 +1,181 @@
+// Package hibp проверка паролей по локальной копии базы утечек Have I Been Pwned (Pwned Passwords SHA-1)
+// Пароли никуда не передаются: SHA-1 пароля ищется двоичным поиском в отсортированном файле на диске.
+// Поддерживаются файл формата "SHA-1:количество" (pwned-passwords-sha1-ordered-by-hash.txt) и папка
+// с файлами диапазонов <первые 5 символов SHA-1>.txt в формате "остаток SHA-1:количество"
+package hibp
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	prefixLen    = 5      // длина префикса SHA-1 в имени файла диапазона
+	rangeFileExt = ".txt" // расширение файла диапазона
+	maxLineLen   = 128    // максимальная длина строки файла, длиннее - неверный формат
+)
+
+// ErrFormat строка файла не в формате "SHA-1:количество"
+var ErrFormat = errors.New("неверный формат файла базы утечек")
+
+// Checker проверка паролей по локальной базе утечек
+type Checker struct {
+	path     string // путь к файлу или папке с файлами диапазонов
+	rangeDir bool   // path - папка с файлами диапазонов
+}
+
+// Open подключение базы утечек: файла, отсортированного по SHA-1, или папки с файлами диапазонов
+func Open(path string) (*Checker, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Checker{path: path, rangeDir: info.IsDir()}, nil
+}
+
+// Hash SHA-1 пароля в верхнем регистре, как в файлах Have I Been Pwned
+func Hash(password string) string {
+	sum := sha1.Sum([]byte(password))
+
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// Count сколько раз пароль встречался в утечках, 0 - не найден
+func (c *Checker) Count(password string) (int, error) {
+	hash := Hash(password)
+
+	file, key := c.path, hash
+	if c.rangeDir {
+		file, key = filepath.Join(c.path, hash[:prefixLen]+rangeFileExt), hash[prefixLen:]
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		if c.rangeDir && errors.Is(err, os.ErrNotExist) {
+			return 0, fmt.Errorf("нет файла диапазона %v: %w", hash[:prefixLen], err)
+		}
+		return 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	return search(f, info.Size(), key)
+}
+
+// search двоичный поиск строки "key:количество" в отсортированном файле со строками разной длины
+// Ищется первая строка, начинающаяся в диапазоне [lo, hi): если ее ключ меньше искомого,
+// диапазон сдвигается за нее, иначе - сужается до середины
+func search(r io.ReaderAt, size int64, key string) (int, error) {
+	lo, hi := int64(0), size
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		start, err := lineStart(r, size, mid)
+		if err != nil {
+			return 0, err
+		}
+		if start >= hi {
+			hi = mid
+			continue
+		}
+
+		line, err := readLine(r, size, start)
+		if err != nil {
+			return 0, err
+		}
+		lineKey, count, err := parseLine(line)
+		if err != nil {
+			return 0, err
+		}
+
+		switch cmp := strings.Compare(lineKey, key); {
+		case cmp == 0:
+			return count, nil
+		case cmp < 0:
+			lo = start + int64(len(line)) + 1
+		default:
+			hi = mid
+		}
+	}
+
+	return 0, nil
+}
+
+// lineStart смещение первой строки, начинающейся не раньше off (size - строк больше нет)
+func lineStart(r io.ReaderAt, size int64, off int64) (int64, error) {
+	if off == 0 {
+		return 0, nil
+	}
+
+	// строка начинается в off, если предыдущий символ - перевод строки
+	buf, err := readAt(r, size, off-1, maxLineLen)
+	if err != nil {
+		return 0, err
+	}
+	i := bytes.IndexByte(buf, '\n')
+	if i < 0 {
+		if off-1+int64(len(buf)) >= size {
+			return size, nil
+		}
+		return 0, ErrFormat
+	}
+
+	return off + int64(i), nil
+}
+
+// readLine строка, начинающаяся со смещения start, без перевода строки
+func readLine(r io.ReaderAt, size int64, start int64) ([]byte, error) {
+	buf, err := readAt(r, size, start, maxLineLen)
+	if err != nil {
+		return nil, err
+	}
+	i := bytes.IndexByte(buf, '\n')
+	if i < 0 {
+		if start+int64(len(buf)) < size {
+			return nil, ErrFormat
+		}
+		return buf, nil
+	}
+
+	return buf[:i], nil
+}
+
+// readAt чтение не более n байт со смещения off
+func readAt(r io.ReaderAt, size int64, off int64, n int64) ([]byte, error) {
+	if off+n > size {
+		n = size - off
+	}
+	buf := make([]byte, n)
+	_, err := r.ReadAt(buf, off)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// parseLine разбор строки "SHA-1:количество" (допускается перевод строки \r\n)
+func parseLine(line []byte) (string, int, error) {
+	key, count, ok := strings.Cut(strings.TrimSpace(string(line)), ":")
+	if !ok {
+		return "", 0, ErrFormat
+	}
+	n, err := strconv.Atoi(count)
+	if err != nil {
+		return "", 0, ErrFormat
+	}
+
+	return strings.ToUpper(key), n, nil
+}
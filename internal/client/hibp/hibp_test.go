@@ -0,0 +1,84 @@
+package hibp
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeHashes запись отсортированного файла "SHA-1:количество" для паролей pass0..pass<n-1>, количество - i+1
+func writeHashes(t *testing.T, n int, eol string) string {
+	lines := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		lines = append(lines, Hash("pass"+strconv.Itoa(i))+":"+strconv.Itoa(i+1))
+	}
+	sort.Strings(lines)
+
+	file := filepath.Join(t.TempDir(), "pwned.txt")
+	require.NoError(t, os.WriteFile(file, []byte(strings.Join(lines, eol)+eol), 0600))
+
+	return file
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8", Hash("password"))
+}
+
+func TestCount(t *testing.T) {
+	for _, eol := range []string{"\n", "\r\n"} {
+		checker, err := Open(writeHashes(t, 500, eol))
+		require.NoError(t, err)
+
+		for i := 0; i < 500; i++ {
+			count, err := checker.Count("pass" + strconv.Itoa(i))
+			require.NoError(t, err)
+			require.Equal(t, i+1, count, i)
+		}
+
+		for _, pass := range []string{"", "pass500", "Pass1", "correct horse battery staple"} {
+			count, err := checker.Count(pass)
+			require.NoError(t, err)
+			assert.Zero(t, count, pass)
+		}
+	}
+
+	// один пароль в файле без перевода строки в конце
+	file := filepath.Join(t.TempDir(), "one.txt")
+	require.NoError(t, os.WriteFile(file, []byte(Hash("password")+":3861493"), 0600))
+	checker, err := Open(file)
+	require.NoError(t, err)
+	count, err := checker.Count("password")
+	require.NoError(t, err)
+	assert.Equal(t, 3861493, count)
+
+	_, err = Open(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(file, []byte("not a hash file\n"), 0600))
+	_, err = checker.Count("password")
+	assert.ErrorIs(t, err, ErrFormat)
+}
+
+func TestCountRangeDir(t *testing.T) {
+	dir := t.TempDir()
+	hash := Hash("password")
+	content := "1D2DA4053E34E76F6576ED1DA63134B5E2A:2\r\n" + hash[prefixLen:] + ":3861493\r\nFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF:1\r\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, hash[:prefixLen]+rangeFileExt), []byte(content), 0600))
+
+	checker, err := Open(dir)
+	require.NoError(t, err)
+
+	count, err := checker.Count("password")
+	require.NoError(t, err)
+	assert.Equal(t, 3861493, count)
+
+	// для другого префикса SHA-1 нет файла диапазона
+	_, err = checker.Count("pass0")
+	assert.Error(t, err)
+}
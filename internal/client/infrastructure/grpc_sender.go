@@ -4,15 +4,21 @@ package infrastructure
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 
 	"github.com/dnsoftware/gophkeeper/internal/client/domain"
 	"github.com/dnsoftware/gophkeeper/internal/constants"
@@ -48,7 +54,8 @@ func NewGRPCSender(uploadDir string, serverAddress string, secretKey string, cre
 
 	opts = append(opts,
 		grpc.WithTransportCredentials(creds),
-		grpc.WithChainUnaryInterceptor(authInterceptor.TokenInterceptor(), dataOutInterceptor.DataOutputInterceptor()))
+		grpc.WithChainUnaryInterceptor(authInterceptor.TokenInterceptor(), dataOutInterceptor.DataOutputInterceptor()),
+		grpc.WithChainStreamInterceptor(authInterceptor.StreamTokenInterceptor()))
 
 	conn, err := grpc.NewClient(serverAddress, opts...)
 	if err != nil {
@@ -134,8 +141,10 @@ func (t *GRPCSender) EntityCodes() ([]*domain.EntityCode, error) {
 	var entcodes = make([]*domain.EntityCode, 0, len(ec.EntityCodes))
 	for _, val := range ec.EntityCodes {
 		entcodes = append(entcodes, &domain.EntityCode{
-			Etype: val.Etype,
-			Name:  val.Name,
+			Etype:    val.Etype,
+			Name:     val.Name,
+			Custom:   val.Custom,
+			Archived: val.Archived,
 		})
 	}
 
@@ -164,12 +173,87 @@ func (t *GRPCSender) Fields(etype string) ([]*domain.Field, error) {
 			Ftype:            val.Ftype,
 			ValidateRules:    val.ValidateRules,
 			ValidateMessages: val.ValidateMessages,
+			Secret:           val.Secret,
+			Position:         val.Position,
+			Options:          val.Options,
 		})
 	}
 
 	return fd, nil
 }
 
+// CreateEntityType создание пользовательского типа сущности
+// Названия типа и полей не шифруются: по ним сервер строит формы и проверяет данные сущностей
+func (t *GRPCSender) CreateEntityType(name string, fields []*domain.Field) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	in := &pb.CreateEntityTypeRequest{Name: name, Fields: fieldDefinitions(fields)}
+	resp, err := t.KeeperClient.CreateEntityType(ctx, in, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Etype, nil
+}
+
+// UpdateEntityType изменение пользовательского типа сущности
+func (t *GRPCSender) UpdateEntityType(etype string, name string, fields []*domain.Field) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	in := &pb.UpdateEntityTypeRequest{Etype: etype, Name: name, Fields: fieldDefinitions(fields)}
+	resp, err := t.KeeperClient.UpdateEntityType(ctx, in, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// ArchiveEntityType перемещение пользовательского типа сущности в архив или возврат из архива
+func (t *GRPCSender) ArchiveEntityType(etype string, archived bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	in := &pb.ArchiveEntityTypeRequest{Etype: etype, Archived: archived}
+	resp, err := t.KeeperClient.ArchiveEntityType(ctx, in, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// fieldDefinitions описания полей пользовательского типа для запроса
+func fieldDefinitions(fields []*domain.Field) []*pb.Field {
+	res := make([]*pb.Field, 0, len(fields))
+	for _, f := range fields {
+		res = append(res, &pb.Field{
+			Id:               f.Id,
+			Name:             f.Name,
+			Ftype:            f.Ftype,
+			ValidateRules:    f.ValidateRules,
+			ValidateMessages: f.ValidateMessages,
+			Secret:           f.Secret,
+			Options:          f.Options,
+		})
+	}
+
+	return res
+}
+
 // AddEntity добавление сущности
 func (t *GRPCSender) AddEntity(ae domain.Entity) (int32, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
@@ -195,22 +279,24 @@ func (t *GRPCSender) AddEntity(ae domain.Entity) (int32, error) {
 	}
 
 	in := &pb.AddEntityRequest{
-		Id:       ae.Id,
-		Etype:    ae.Etype,
-		Props:    props,
-		Metainfo: metainfo,
+		Id:         ae.Id,
+		Etype:      ae.Etype,
+		Props:      props,
+		Metainfo:   metainfo,
+		BlindIndex: t.blindTokens(append(domain.BlindIndexTerms(ae.Metainfo), domain.BlindTagTerms(ae.Tags)...)),
+		FolderId:   ae.FolderId,
+		Tags:       append([]string(nil), ae.Tags...),
 	}
 
 	resp, err := t.KeeperClient.AddEntity(ctx, in, opts...)
+	if err != nil {
+		return 0, violationsError(err)
+	}
 
 	if resp.Error != "" {
 		return 0, fmt.Errorf(resp.Error)
 	}
 
-	if err != nil {
-		return 0, err
-	}
-
 	return resp.Id, err
 }
 
@@ -239,23 +325,69 @@ func (t *GRPCSender) SaveEntity(ae domain.Entity) (int32, error) {
 	}
 
 	in := &pb.SaveEntityRequest{
-		Id:       ae.Id,
-		Etype:    ae.Etype,
-		Props:    props,
-		Metainfo: metainfo,
+		Id:         ae.Id,
+		Etype:      ae.Etype,
+		Props:      props,
+		Metainfo:   metainfo,
+		BlindIndex: t.blindTokens(append(domain.BlindIndexTerms(ae.Metainfo), domain.BlindTagTerms(ae.Tags)...)),
+		FolderId:   ae.FolderId,
+		Tags:       append([]string(nil), ae.Tags...),
 	}
 
 	resp, err := t.KeeperClient.SaveEditEntity(ctx, in, opts...)
+	if err != nil {
+		return 0, violationsError(err)
+	}
 
 	if resp.Error != "" {
 		return 0, fmt.Errorf(resp.Error)
 	}
 
-	if err != nil {
-		return 0, err
+	return resp.Id, err
+}
+
+// fieldViolationsError отказ сервера в сохранении сущности с перечнем нарушений по полям
+// Статус gRPC сохраняется, поэтому код ошибки (InvalidArgument) доступен через status.Code
+type fieldViolationsError struct {
+	st         *status.Status
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Error нарушения по одному на строке
+func (e *fieldViolationsError) Error() string {
+	var b strings.Builder
+	b.WriteString("данные сущности не прошли проверку:")
+	for _, fv := range e.violations {
+		b.WriteString("\n  " + fv.GetField() + ": " + fv.GetDescription())
 	}
 
-	return resp.Id, err
+	return b.String()
+}
+
+// GRPCStatus статус ответа сервера
+func (e *fieldViolationsError) GRPCStatus() *status.Status {
+	return e.st
+}
+
+// violationsError ошибка InvalidArgument с нарушениями из деталей errdetails.BadRequest,
+// остальные ошибки возвращаются без изменений
+func violationsError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		return err
+	}
+
+	var list []*errdetails.BadRequest_FieldViolation
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			list = append(list, br.GetFieldViolations()...)
+		}
+	}
+	if len(list) == 0 {
+		return err
+	}
+
+	return &fieldViolationsError{st: st, violations: list}
 }
 
 // UploadBinary загрузка незашифрованных бинарных данных (клиент -> сервер)
@@ -439,12 +571,211 @@ func (t *GRPCSender) DownloadCryptoBinary(entityId int32, fileName string) (stri
 	return uploadFile, nil
 }
 
-// Entity получение сущности
+/************************************ Вложения сущностей ************************************/
+
+// AddAttachment загрузка файла вложением к сущности
+// Первым сообщением передается описание вложения с зашифрованными именем и SHA-256 файла, затем - зашифрованные фрагменты
+func (t *GRPCSender) AddAttachment(entityId int32, file string) (*domain.Attachment, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("%v - папка, а не файл", file)
+	}
+	if info.Size() > constants.MaxAttachmentSize {
+		return nil, fmt.Errorf("размер файла превышает %v байт", constants.MaxAttachmentSize)
+	}
+
+	digest, err := fileDigest(file)
+	if err != nil {
+		return nil, err
+	}
+
+	fil, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer fil.Close()
+
+	stream, err := t.KeeperClient.AddAttachment(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+	att := &domain.Attachment{
+		EntityId: entityId,
+		Name:     filepath.Base(file),
+		Size:     info.Size(),
+		Digest:   digest,
+	}
+	err = stream.Send(&pb.AddAttachmentRequest{Data: &pb.AddAttachmentRequest_Info{Info: &pb.Attachment{
+		EntityId: entityId,
+		Name:     utils.Encrypt(att.Name, cryptoKey),
+		Size:     att.Size,
+		Digest:   utils.Encrypt(att.Digest, cryptoKey),
+	}}})
+	if err != nil {
+		return nil, err
+	}
+
+	// размер фрагментов передачи бинарных данных
+	buf := make([]byte, constants.ChunkSize)
+
+	for {
+		num, err := fil.Read(buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		crypted := utils.EncryptBinary(buf[:num], cryptoKey)
+		if err := stream.Send(&pb.AddAttachmentRequest{Data: &pb.AddAttachmentRequest_ChunkData{ChunkData: crypted}}); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	att.Id = res.Id
+	att.CreatedAt = time.Now()
+
+	return att, nil
+}
+
+// ListAttachments вложения сущности с расшифровкой имен и контрольных сумм
+func (t *GRPCSender) ListAttachments(entityId int32) ([]*domain.Attachment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	resp, err := t.KeeperClient.ListAttachments(ctx, &pb.ListAttachmentsRequest{EntityId: entityId}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+
+	list := make([]*domain.Attachment, 0, len(resp.Attachments))
+	for _, att := range resp.Attachments {
+		list = append(list, &domain.Attachment{
+			Id:        att.Id,
+			EntityId:  att.EntityId,
+			Name:      utils.Decrypt(att.Name, cryptoKey),
+			Size:      att.Size,
+			Digest:    utils.Decrypt(att.Digest, cryptoKey),
+			CreatedAt: time.Unix(att.CreatedAt, 0),
+		})
+	}
+
+	return list, nil
+}
+
+// DownloadAttachment скачивание вложения в папку загрузок
+// Если SHA-256 расшифрованного файла не совпадает с сохраненной при загрузке, файл удаляется
+func (t *GRPCSender) DownloadAttachment(att *domain.Attachment) (string, error) {
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+
+	stream, err := t.KeeperClient.DownloadAttachment(context.Background(), &pb.DownloadAttachmentRequest{Id: att.Id})
+	if err != nil {
+		return "", err
+	}
+
+	// имя файла приходит с сервера, поэтому путь внутри него не учитывается
+	uploadFile := t.uploadDir + "/" + fmt.Sprintf("%v_", time.Now().Unix()) + filepath.Base(att.Name)
+	f, err := os.OpenFile(uploadFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	err = func() error {
+		defer f.Close()
+
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				return f.Close()
+			}
+			if err != nil {
+				return err
+			}
+
+			chunk := utils.DecryptBinary(res.GetChunkData(), cryptoKey)
+			hash.Write(chunk)
+			_, err = f.Write(chunk)
+			if err != nil {
+				return err
+			}
+		}
+	}()
+	if err == nil && hex.EncodeToString(hash.Sum(nil)) != att.Digest {
+		err = fmt.Errorf("контрольная сумма вложения %v не совпадает", att.Name)
+	}
+	if err != nil {
+		_ = os.Remove(uploadFile)
+		return "", err
+	}
+
+	return uploadFile, nil
+}
+
+// DeleteAttachment удаление вложения
+func (t *GRPCSender) DeleteAttachment(id int32) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	resp, err := t.KeeperClient.DeleteAttachment(ctx, &pb.DeleteAttachmentRequest{Id: id}, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// fileDigest SHA-256 содержимого файла (hex)
+func fileDigest(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, f)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Entity получение сущности без отметки в списке последних открытых
 func (t *GRPCSender) Entity(id int32) (*domain.Entity, error) {
+	return t.entity(id, false)
+}
+
+// OpenEntity получение сущности, открытой пользователем: сервер запоминает время получения
+func (t *GRPCSender) OpenEntity(id int32) (*domain.Entity, error) {
+	return t.entity(id, true)
+}
+
+// entity получение сущности, touch - отметить ее в списке последних открытых
+func (t *GRPCSender) entity(id int32, touch bool) (*domain.Entity, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
 	defer cancel()
 
-	resp, err := t.KeeperClient.Entity(ctx, &pb.EntityRequest{Id: int32(id)})
+	resp, err := t.KeeperClient.Entity(ctx, &pb.EntityRequest{Id: int32(id), Touch: touch})
 	if err != nil {
 		return nil, err
 	}
@@ -476,6 +807,12 @@ func (t *GRPCSender) Entity(id int32) (*domain.Entity, error) {
 		Etype:    resp.Etype,
 		Props:    props,
 		Metainfo: meta,
+		FolderId: resp.FolderId,
+		Tags:     resp.Tags,
+		Favorite: resp.Favorite,
+	}
+	if resp.AccessedAt > 0 {
+		ent.AccessedAt = time.Unix(resp.AccessedAt, 0)
 	}
 
 	return ent, nil
@@ -493,45 +830,253 @@ func (t *GRPCSender) GetPassword() string {
 
 // EntityList Получение списка сущностей указанного типа для конкретного пользователя
 func (t *GRPCSender) EntityList(etype string) (map[int32]string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
-	defer cancel()
-
-	var opts []grpc.CallOption
-	in := &pb.EntityListRequest{Etype: etype}
+	return t.EntityListFiltered(etype, domain.ListFilter{})
+}
 
-	resp, err := t.KeeperClient.EntityList(ctx, in, opts...)
+// EntityListFiltered список сущностей с отбором по папке, тегу и отметкам
+func (t *GRPCSender) EntityListFiltered(etype string, filter domain.ListFilter) (map[int32]string, error) {
+	items, err := t.EntitySummaries(etype, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	list := make(map[int32]string, len(items))
+	for _, item := range items {
+		list[item.Id] = item.Title
+	}
+
+	return list, nil
+}
+
+// EntitySummaries список сущностей с отметками избранного и времени получения
+// Страницы запрашиваются у сервера до последней, тег передается токеном слепого индекса.
+// Последние открытые - одна страница от последней открытой сущности без ни разу не открытых
+func (t *GRPCSender) EntitySummaries(etype string, filter domain.ListFilter) ([]*domain.EntitySummary, error) {
+	in := &pb.ListEntitiesRequest{FolderId: filter.FolderId, Favorite: filter.Favorite}
+	if etype != "" {
+		in.Etypes = []string{etype}
+	}
+	if filter.Tag != "" {
+		in.Tag = t.blindTokens([]string{domain.BlindTagTerm(filter.Tag)})[0]
+	}
+	if filter.Recent > 0 {
+		in.Sort = pb.EntitySort_ENTITY_SORT_ACCESSED
+		in.Descending = true
+		in.PageSize = filter.Recent
+	}
+
 	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
 
-	list := make(map[int32]string, len(resp.List))
-	for key, val := range resp.List {
-		m := make(map[string]string)
-		err := json.Unmarshal([]byte(val), &m)
+	var items []*domain.EntitySummary
+	for {
+		resp, err := t.listEntities(in)
 		if err != nil {
 			return nil, err
 		}
 
-		str := ""
-		for ek, ev := range m {
-			if ek == "" {
-				str = str + "нет описания. "
-			} else {
-				k := utils.Decrypt(ek, cryptoKey)
-				v := utils.Decrypt(ev, cryptoKey)
-				str = str + k + ":" + v + ". "
+		for _, ent := range resp.Entities {
+			if filter.Recent > 0 && ent.AccessedAt == 0 {
+				continue
 			}
+			item := &domain.EntitySummary{
+				Id:       ent.Id,
+				Etype:    ent.Etype,
+				Title:    summaryTitle(ent.Metainfo, cryptoKey),
+				Favorite: ent.Favorite,
+			}
+			if ent.AccessedAt > 0 {
+				item.AccessedAt = time.Unix(ent.AccessedAt, 0)
+			}
+			items = append(items, item)
 		}
 
-		list[key] = str
+		if filter.Recent > 0 || resp.NextPageToken == "" {
+			return items, nil
+		}
+		in.PageToken = resp.NextPageToken
 	}
+}
 
-	return list, nil
+// listEntities запрос одной страницы списка сущностей
+func (t *GRPCSender) listEntities(in *pb.ListEntitiesRequest) (*pb.ListEntitiesResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+
+	var opts []grpc.CallOption
+
+	return t.KeeperClient.ListEntities(ctx, in, opts...)
+}
+
+// summaryTitle описание сущности для списка из расшифрованной метаинформации
+func summaryTitle(metainfo []*pb.Metainfo, cryptoKey string) string {
+	if len(metainfo) == 0 {
+		return "нет описания. "
+	}
+
+	str := ""
+	for _, meta := range metainfo {
+		str = str + utils.Decrypt(meta.Title, cryptoKey) + ":" + utils.Decrypt(meta.Value, cryptoKey) + ". "
+	}
+
+	return str
+}
+
+// SetFavorite добавление сущности в избранное или удаление из него
+func (t *GRPCSender) SetFavorite(id int32, favorite bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	in := pb.SetFavoriteRequest{Id: id, Favorite: favorite}
+	resp, err := t.KeeperClient.SetFavorite(ctx, &in, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// SearchEntities поиск сущностей на сервере по токенам слепого индекса с расшифровкой метаинформации
+func (t *GRPCSender) SearchEntities(query string, etype string) ([]*domain.FoundEntity, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	tokens := t.blindTokens(domain.BlindQueryTerms(query))
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: пустой запрос поиска", domain.ErrValidation)
+	}
+
+	resp, err := t.KeeperClient.SearchEntities(ctx, &pb.SearchEntitiesRequest{Tokens: tokens, Etype: etype}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+
+	items := make([]*domain.FoundEntity, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		meta := make([]*domain.Metainfo, 0, len(item.Metainfo))
+		for _, val := range item.Metainfo {
+			meta = append(meta, &domain.Metainfo{
+				EntityId: val.EntityId,
+				Title:    utils.Decrypt(val.Title, cryptoKey),
+				Value:    utils.Decrypt(val.Value, cryptoKey),
+			})
+		}
+
+		items = append(items, &domain.FoundEntity{
+			Id:       item.Id,
+			Etype:    item.Etype,
+			Metainfo: meta,
+		})
+	}
+
+	return items, nil
+}
+
+// blindTokens токены слепого индекса: HMAC от текстов на ключе, производном от ключа хранилища
+func (t *GRPCSender) blindTokens(terms []string) []string {
+	key := utils.BlindIndexKeyCreate(t.password, t.SecretKey)
+
+	tokens := make([]string, 0, len(terms))
+	for _, term := range terms {
+		tokens = append(tokens, utils.BlindToken(key, term))
+	}
+
+	return tokens
+}
+
+// Folders получение всех папок пользователя с расшифровкой названий
+func (t *GRPCSender) Folders() ([]*domain.Folder, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	resp, err := t.KeeperClient.Folders(ctx, &pb.FoldersRequest{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+
+	folders := make([]*domain.Folder, 0, len(resp.Folders))
+	for _, f := range resp.Folders {
+		folders = append(folders, &domain.Folder{
+			Id:       f.Id,
+			ParentId: f.ParentId,
+			Name:     utils.Decrypt(f.Name, cryptoKey),
+		})
+	}
+
+	return folders, nil
 }
 
-// DeleteEntity удаление сущности
+// CreateFolder создание папки, название шифруется
+func (t *GRPCSender) CreateFolder(parentId int32, name string) (int32, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+	in := &pb.CreateFolderRequest{ParentId: parentId, Name: utils.Encrypt(name, cryptoKey)}
+
+	resp, err := t.KeeperClient.CreateFolder(ctx, in, opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.Error != "" {
+		return 0, errors.New(resp.Error)
+	}
+
+	return resp.Id, nil
+}
+
+// UpdateFolder переименование и перемещение папки, название шифруется
+func (t *GRPCSender) UpdateFolder(folder domain.Folder) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+	in := &pb.UpdateFolderRequest{Id: folder.Id, ParentId: folder.ParentId, Name: utils.Encrypt(folder.Name, cryptoKey)}
+
+	resp, err := t.KeeperClient.UpdateFolder(ctx, in, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// DeleteFolder удаление папки
+func (t *GRPCSender) DeleteFolder(id int32) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	resp, err := t.KeeperClient.DeleteFolder(ctx, &pb.DeleteFolderRequest{Id: id}, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// DeleteEntity удаление сущности (перемещение в корзину)
 func (t *GRPCSender) DeleteEntity(id int32) error {
 	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
 	defer cancel()
@@ -549,3 +1094,74 @@ func (t *GRPCSender) DeleteEntity(id int32) error {
 
 	return nil
 }
+
+// ListTrash получение содержимого корзины с расшифровкой метаинформации
+func (t *GRPCSender) ListTrash() ([]*domain.TrashItem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	resp, err := t.KeeperClient.ListTrash(ctx, &pb.ListTrashRequest{}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoKey := utils.SymmPassCreate(t.password, t.SecretKey)
+
+	items := make([]*domain.TrashItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		meta := make([]*domain.Metainfo, 0, len(item.Metainfo))
+		for _, val := range item.Metainfo {
+			meta = append(meta, &domain.Metainfo{
+				EntityId: val.EntityId,
+				Title:    utils.Decrypt(val.Title, cryptoKey),
+				Value:    utils.Decrypt(val.Value, cryptoKey),
+			})
+		}
+
+		items = append(items, &domain.TrashItem{
+			Id:        item.Id,
+			Etype:     item.Etype,
+			Metainfo:  meta,
+			DeletedAt: time.Unix(item.DeletedAt, 0),
+		})
+	}
+
+	return items, nil
+}
+
+// RestoreEntity восстановление сущности из корзины
+func (t *GRPCSender) RestoreEntity(id int32) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	resp, err := t.KeeperClient.RestoreEntity(ctx, &pb.RestoreEntityRequest{Id: id}, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// PurgeEntity окончательное удаление сущности из корзины
+func (t *GRPCSender) PurgeEntity(id int32) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DBContextTimeout)
+	defer cancel()
+	var opts []grpc.CallOption
+
+	resp, err := t.KeeperClient.PurgeEntity(ctx, &pb.PurgeEntityRequest{Id: id}, opts...)
+	if err != nil {
+		return err
+	}
+
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/dnsoftware/gophkeeper/internal/client/domain"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	pb "github.com/dnsoftware/gophkeeper/internal/proto"
+)
+
+// invalidServer сервер, отклоняющий любую сущность с нарушениями в деталях ошибки
+type invalidServer struct {
+	pb.UnimplementedKeeperServer
+}
+
+func (invalidServer) invalid() error {
+	st, err := status.New(codes.InvalidArgument, "данные сущности не прошли проверку").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "Пароль", Description: "обязательное поле не заполнено"},
+			{Field: "tags[0]", Description: "тег длиннее 64 байт"},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return st.Err()
+}
+
+func (s invalidServer) AddEntity(context.Context, *pb.AddEntityRequest) (*pb.AddEntityResponse, error) {
+	return nil, s.invalid()
+}
+
+func (s invalidServer) SaveEditEntity(context.Context, *pb.SaveEntityRequest) (*pb.SaveEntityResponse, error) {
+	return nil, s.invalid()
+}
+
+// newTestSender клиент, подключенный к серверу srv в памяти
+func newTestSender(t *testing.T, srv pb.KeeperServer) *GRPCSender {
+	listen := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pb.RegisterKeeperServer(server, srv)
+	go server.Serve(listen)
+	t.Cleanup(server.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) { return listen.Dial() }
+	sender, conn, err := NewGRPCSender(t.TempDir(), "passthrough:///bufnet", "secret", insecure.NewCredentials(), grpc.WithContextDialer(dialer))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return sender
+}
+
+func TestSaveEntityInvalid(t *testing.T) {
+	sender := newTestSender(t, invalidServer{})
+	ent := domain.Entity{Etype: constants.LogopasEntity, Tags: []string{"tag"}}
+
+	// ответ без данных не приводит к панике, нарушения перечисляются по полям, код ошибки сохраняется
+	_, err := sender.AddEntity(ent)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "данные сущности не прошли проверку:\n  Пароль: обязательное поле не заполнено\n  tags[0]: тег длиннее 64 байт", err.Error())
+
+	ent.Id = 7
+	_, err = sender.SaveEntity(ent)
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), "Пароль: обязательное поле не заполнено")
+
+	// ошибки без нарушений в деталях не изменяются
+	err = status.Error(codes.Unavailable, "нет связи")
+	assert.Equal(t, err, violationsError(err))
+}
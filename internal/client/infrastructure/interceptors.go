@@ -56,6 +56,19 @@ func (i *AuthInterceptor) TokenInterceptor() grpc.UnaryClientInterceptor {
 	}
 }
 
+// StreamTokenInterceptor добавление токена авторизации в исходящий потоковый запрос
+func (i *AuthInterceptor) StreamTokenInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+
+		if ok := i.excludeMethods[method]; !ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, constants.TokenKey, i.actualToken.GetToken())
+		}
+
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
 /******************************** Шифровка исходящих данных *******************************/
 
 // UserPasswordGet интерфейс получения пароля пользователя, чтобы использовать его как часть ключа шифрования
@@ -109,6 +122,9 @@ func (d *DataOutInterceptor) DataOutputInterceptor() grpc.UnaryClientInterceptor
 					entity.Metainfo[key].Title = utils.Encrypt(meta.Title, cryptoKey)
 					entity.Metainfo[key].Value = utils.Encrypt(meta.Value, cryptoKey)
 				}
+				for key, tag := range entity.Tags {
+					entity.Tags[key] = utils.Encrypt(tag, cryptoKey)
+				}
 			case constants.MethodSaveEditEntity:
 				entity := req.(*proto.SaveEntityRequest)
 				for key, prop := range entity.Props {
@@ -122,6 +138,9 @@ func (d *DataOutInterceptor) DataOutputInterceptor() grpc.UnaryClientInterceptor
 					entity.Metainfo[key].Title = utils.Encrypt(meta.Title, cryptoKey)
 					entity.Metainfo[key].Value = utils.Encrypt(meta.Value, cryptoKey)
 				}
+				for key, tag := range entity.Tags {
+					entity.Tags[key] = utils.Encrypt(tag, cryptoKey)
+				}
 
 			}
 		}
@@ -145,6 +164,9 @@ func (d *DataOutInterceptor) DataOutputInterceptor() grpc.UnaryClientInterceptor
 					entity.Metainfo[key].Title = utils.Decrypt(meta.Title, cryptoKey)
 					entity.Metainfo[key].Value = utils.Decrypt(meta.Value, cryptoKey)
 				}
+				for key, tag := range entity.Tags {
+					entity.Tags[key] = utils.Decrypt(tag, cryptoKey)
+				}
 
 			}
 
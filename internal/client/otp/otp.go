@@ -0,0 +1,256 @@
+// Package otp генерация одноразовых паролей HOTP (RFC 4226) и TOTP (RFC 6238)
+// по секрету в формате base32 или по URI otpauth://
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// типы одноразовых паролей
+const (
+	TypeTOTP string = "totp" // по времени
+	TypeHOTP string = "hotp" // по счетчику
+)
+
+// алгоритмы HMAC
+const (
+	AlgorithmSHA1   string = "SHA1"
+	AlgorithmSHA256 string = "SHA256"
+	AlgorithmSHA512 string = "SHA512"
+)
+
+// значения по умолчанию
+const (
+	DefaultDigits int = 6  // количество цифр кода
+	DefaultPeriod int = 30 // период действия кода TOTP в секундах
+)
+
+// Key параметры генерации одноразовых паролей
+type Key struct {
+	Type      string // totp или hotp
+	Issuer    string // кем выдан (сервис)
+	Account   string // учетная запись
+	Secret    []byte // секрет
+	Algorithm string // алгоритм HMAC
+	Digits    int    // количество цифр кода
+	Period    int    // период действия кода TOTP в секундах
+	Counter   uint64 // счетчик HOTP
+}
+
+// Parse разбор секрета в формате base32 или URI otpauth://
+// Для секрета base32 используются параметры TOTP по умолчанию (SHA1, 6 цифр, 30 секунд)
+func Parse(s string) (*Key, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(strings.ToLower(s), "otpauth://") {
+		return parseURI(s)
+	}
+
+	secret, err := decodeSecret(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		Type:      TypeTOTP,
+		Secret:    secret,
+		Algorithm: AlgorithmSHA1,
+		Digits:    DefaultDigits,
+		Period:    DefaultPeriod,
+	}, nil
+}
+
+// IsValid является ли строка корректным секретом base32 или URI otpauth://
+func IsValid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// parseURI разбор URI вида otpauth://totp/Issuer:account?secret=...&issuer=...&algorithm=SHA1&digits=6&period=30
+func parseURI(s string) (*Key, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &Key{
+		Type:      strings.ToLower(u.Host),
+		Algorithm: AlgorithmSHA1,
+		Digits:    DefaultDigits,
+		Period:    DefaultPeriod,
+	}
+	if k.Type != TypeTOTP && k.Type != TypeHOTP {
+		return nil, fmt.Errorf("unknown otp type: %s", u.Host)
+	}
+
+	// метка: "Issuer:account" или "account"
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, ok := strings.Cut(label, ":"); ok {
+		k.Issuer = strings.TrimSpace(issuer)
+		k.Account = strings.TrimSpace(account)
+	} else {
+		k.Account = label
+	}
+
+	q := u.Query()
+	if issuer := q.Get("issuer"); issuer != "" {
+		k.Issuer = issuer
+	}
+
+	k.Secret, err = decodeSecret(q.Get("secret"))
+	if err != nil {
+		return nil, err
+	}
+
+	if alg := q.Get("algorithm"); alg != "" {
+		k.Algorithm = strings.ToUpper(alg)
+		if _, err = hashFunc(k.Algorithm); err != nil {
+			return nil, err
+		}
+	}
+
+	if digits := q.Get("digits"); digits != "" {
+		k.Digits, err = strconv.Atoi(digits)
+		if err != nil || k.Digits < 6 || k.Digits > 10 {
+			return nil, fmt.Errorf("wrong digits: %s", digits)
+		}
+	}
+
+	if period := q.Get("period"); period != "" {
+		k.Period, err = strconv.Atoi(period)
+		if err != nil || k.Period <= 0 {
+			return nil, fmt.Errorf("wrong period: %s", period)
+		}
+	}
+
+	if k.Type == TypeHOTP {
+		counter := q.Get("counter")
+		if counter == "" {
+			return nil, errors.New("hotp counter is required")
+		}
+		k.Counter, err = strconv.ParseUint(counter, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("wrong counter: %s", counter)
+		}
+	}
+
+	return k, nil
+}
+
+// decodeSecret декодирование секрета base32 (регистр, пробелы и отсутствие выравнивания допускаются)
+func decodeSecret(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	s = strings.TrimRight(s, "=")
+	if s == "" {
+		return nil, errors.New("empty otp secret")
+	}
+
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("wrong base32 secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+// URI представление ключа в виде URI otpauth://
+func (k *Key) URI() string {
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+
+	q := url.Values{}
+	q.Set("secret", base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(k.Secret))
+	if k.Issuer != "" {
+		q.Set("issuer", k.Issuer)
+	}
+	q.Set("algorithm", k.Algorithm)
+	q.Set("digits", strconv.Itoa(k.Digits))
+	if k.Type == TypeHOTP {
+		q.Set("counter", strconv.FormatUint(k.Counter, 10))
+	} else {
+		q.Set("period", strconv.Itoa(k.Period))
+	}
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     k.Type,
+		Path:     "/" + label,
+		RawQuery: q.Encode(),
+	}
+
+	return u.String()
+}
+
+// Code текущий код: для TOTP - на момент t, для HOTP - для текущего значения счетчика
+func (k *Key) Code(t time.Time) (string, error) {
+	counter := k.Counter
+	if k.Type == TypeTOTP {
+		counter = uint64(t.Unix()) / uint64(k.Period)
+	}
+
+	return HOTP(k.Secret, counter, k.Digits, k.Algorithm)
+}
+
+// Remaining сколько еще будет действовать код TOTP, полученный в момент t (для HOTP - 0)
+func (k *Key) Remaining(t time.Time) time.Duration {
+	if k.Type != TypeTOTP {
+		return 0
+	}
+
+	period := int64(k.Period)
+
+	return time.Duration(period-t.Unix()%period) * time.Second
+}
+
+// HOTP вычисление кода по секрету и значению счетчика (RFC 4226)
+func HOTP(secret []byte, counter uint64, digits int, algorithm string) (string, error) {
+	hf, err := hashFunc(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(hf, secret)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// динамическое усечение
+	offset := sum[len(sum)-1] & 0x0f
+	value := int64(binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff)
+
+	mod := int64(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod), nil
+}
+
+// hashFunc функция хеширования по названию алгоритма
+func hashFunc(algorithm string) (func() hash.Hash, error) {
+	switch strings.ToUpper(algorithm) {
+	case AlgorithmSHA1:
+		return sha1.New, nil
+	case AlgorithmSHA256:
+		return sha256.New, nil
+	case AlgorithmSHA512:
+		return sha512.New, nil
+	}
+
+	return nil, fmt.Errorf("unknown otp algorithm: %s", algorithm)
+}
@@ -0,0 +1,105 @@
+package otp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// тестовые векторы RFC 4226, приложение D
+func TestHOTP(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	expected := []string{"755224", "287082", "359152", "969429", "338314", "254676", "287922", "162583", "399871", "520489"}
+
+	for counter, code := range expected {
+		got, err := HOTP(secret, uint64(counter), 6, AlgorithmSHA1)
+		require.NoError(t, err)
+		assert.Equal(t, code, got)
+	}
+
+	_, err := HOTP(secret, 0, 6, "MD5")
+	assert.Error(t, err)
+}
+
+// тестовые векторы RFC 6238, приложение B
+func TestTOTP(t *testing.T) {
+	secrets := map[string][]byte{
+		AlgorithmSHA1:   []byte("12345678901234567890"),
+		AlgorithmSHA256: []byte("12345678901234567890123456789012"),
+		AlgorithmSHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+
+	tests := []struct {
+		unix      int64
+		algorithm string
+		code      string
+	}{
+		{59, AlgorithmSHA1, "94287082"},
+		{59, AlgorithmSHA256, "46119246"},
+		{59, AlgorithmSHA512, "90693936"},
+		{1111111109, AlgorithmSHA1, "07081804"},
+		{1111111109, AlgorithmSHA256, "68084774"},
+		{1234567890, AlgorithmSHA512, "93441116"},
+		{20000000000, AlgorithmSHA1, "65353130"},
+	}
+
+	for _, tt := range tests {
+		k := &Key{Type: TypeTOTP, Secret: secrets[tt.algorithm], Algorithm: tt.algorithm, Digits: 8, Period: 30}
+		code, err := k.Code(time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.code, code, "time %v %v", tt.unix, tt.algorithm)
+	}
+}
+
+func TestParse(t *testing.T) {
+	// секрет base32: строчные буквы и пробелы допускаются
+	k, err := Parse("jbsw y3dp ehpk 3pxp")
+	require.NoError(t, err)
+	assert.Equal(t, TypeTOTP, k.Type)
+	assert.Equal(t, []byte("Hello!\xde\xad\xbe\xef"), k.Secret)
+	assert.Equal(t, DefaultDigits, k.Digits)
+	assert.Equal(t, DefaultPeriod, k.Period)
+	assert.Equal(t, AlgorithmSHA1, k.Algorithm)
+
+	k, err = Parse("otpauth://totp/ACME%20Co:john@example.com?secret=JBSWY3DPEHPK3PXP&issuer=ACME%20Co&algorithm=SHA256&digits=8&period=60")
+	require.NoError(t, err)
+	assert.Equal(t, "ACME Co", k.Issuer)
+	assert.Equal(t, "john@example.com", k.Account)
+	assert.Equal(t, AlgorithmSHA256, k.Algorithm)
+	assert.Equal(t, 8, k.Digits)
+	assert.Equal(t, 60, k.Period)
+
+	// каноническое представление разбирается обратно без потерь
+	k2, err := Parse(k.URI())
+	require.NoError(t, err)
+	assert.Equal(t, k, k2)
+
+	k, err = Parse("otpauth://hotp/Service?secret=JBSWY3DPEHPK3PXP&counter=7")
+	require.NoError(t, err)
+	assert.Equal(t, TypeHOTP, k.Type)
+	assert.Equal(t, uint64(7), k.Counter)
+	assert.Equal(t, "Service", k.Account)
+	assert.Equal(t, time.Duration(0), k.Remaining(time.Now()))
+
+	bad := []string{
+		"",
+		"not base32!",
+		"otpauth://xotp/a?secret=JBSWY3DPEHPK3PXP",
+		"otpauth://totp/a",
+		"otpauth://totp/a?secret=JBSWY3DPEHPK3PXP&algorithm=MD5",
+		"otpauth://totp/a?secret=JBSWY3DPEHPK3PXP&digits=3",
+		"otpauth://totp/a?secret=JBSWY3DPEHPK3PXP&period=0",
+		"otpauth://hotp/a?secret=JBSWY3DPEHPK3PXP",
+	}
+	for _, s := range bad {
+		assert.False(t, IsValid(s), s)
+	}
+}
+
+func TestRemaining(t *testing.T) {
+	k := &Key{Type: TypeTOTP, Period: 30}
+	assert.Equal(t, 30*time.Second, k.Remaining(time.Unix(60, 0)))
+	assert.Equal(t, 1*time.Second, k.Remaining(time.Unix(89, 0)))
+}
@@ -0,0 +1,231 @@
+// Package passgen генерация паролей из заданных классов символов и парольных фраз из встроенного списка слов
+// Все случайные значения берутся только из crypto/rand
+package passgen
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// значения по умолчанию и ограничения
+const (
+	DefaultLength    = 20  // длина пароля
+	DefaultWords     = 6   // количество слов парольной фразы
+	DefaultSeparator = "-" // разделитель слов парольной фразы
+	MinLength        = 4   // минимальная длина пароля
+	MaxLength        = 128 // максимальная длина пароля
+	MinWords         = 3   // минимальное количество слов парольной фразы
+	MaxWords         = 20  // максимальное количество слов парольной фразы
+)
+
+// классы символов пароля
+const (
+	lowerChars  = "abcdefghijklmnopqrstuvwxyz"
+	upperChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars  = "0123456789"
+	symbolChars = "!#$%&*+-=?@^_~"
+)
+
+// ambiguousChars символы, которые легко спутать при чтении и переписывании
+const ambiguousChars = "Il1O0o"
+
+//go:embed wordlist.txt
+var wordlistData string
+
+var (
+	wordsOnce sync.Once
+	words     []string
+)
+
+// Spec параметры генерации: пароль из классов символов или парольная фраза (Words > 0)
+type Spec struct {
+	Length      int    // длина пароля
+	NoLower     bool   // без строчных букв
+	NoUpper     bool   // без заглавных букв
+	NoDigits    bool   // без цифр
+	NoSymbols   bool   // без спецсимволов
+	NoAmbiguous bool   // без похожих символов (I, l, 1, O, 0, o)
+	Words       int    // количество слов парольной фразы, 0 - генерируется пароль
+	Separator   string // разделитель слов парольной фразы
+}
+
+// DefaultSpec параметры по умолчанию: пароль из 20 символов всех классов
+func DefaultSpec() Spec {
+	return Spec{Length: DefaultLength, Separator: DefaultSeparator}
+}
+
+// RegisterFlags ключи командной строки параметров генерации
+func (s *Spec) RegisterFlags(fs *flag.FlagSet) {
+	fs.IntVar(&s.Length, "length", DefaultLength, "password length")
+	fs.BoolVar(&s.NoLower, "no-lower", false, "without lowercase letters")
+	fs.BoolVar(&s.NoUpper, "no-upper", false, "without uppercase letters")
+	fs.BoolVar(&s.NoDigits, "no-digits", false, "without digits")
+	fs.BoolVar(&s.NoSymbols, "no-symbols", false, "without symbols")
+	fs.BoolVar(&s.NoAmbiguous, "no-ambiguous", false, "without ambiguous characters")
+	fs.IntVar(&s.Words, "words", 0, "passphrase word count, 0 - password")
+	fs.StringVar(&s.Separator, "separator", DefaultSeparator, "passphrase word separator")
+}
+
+// ParseArgs разбор параметров генерации из аргументов вида -length 24 -no-symbols или -words 5
+func ParseArgs(args []string) (Spec, error) {
+	s := DefaultSpec()
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	s.RegisterFlags(fs)
+
+	err := fs.Parse(args)
+	if err != nil {
+		return Spec{}, err
+	}
+	if fs.NArg() > 0 {
+		return Spec{}, fmt.Errorf("лишние аргументы: %v", strings.Join(fs.Args(), " "))
+	}
+
+	return s, s.Validate()
+}
+
+// Validate проверка параметров генерации
+func (s Spec) Validate() error {
+	if s.Words != 0 {
+		if s.Words < MinWords || s.Words > MaxWords {
+			return fmt.Errorf("количество слов должно быть от %v до %v", MinWords, MaxWords)
+		}
+		return nil
+	}
+
+	if s.Length < MinLength || s.Length > MaxLength {
+		return fmt.Errorf("длина пароля должна быть от %v до %v", MinLength, MaxLength)
+	}
+	if len(s.classes()) == 0 {
+		return errors.New("не выбран ни один класс символов")
+	}
+
+	return nil
+}
+
+// Generate генерация пароля или парольной фразы
+func Generate(s Spec) (string, error) {
+	err := s.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	if s.Words > 0 {
+		return passphrase(s.Words, s.Separator)
+	}
+
+	return password(s.Length, s.classes())
+}
+
+// Entropy оценка стойкости в битах: log2 количества равновероятных вариантов
+// Для пароля не учитывается обязательное присутствие каждого класса символов (оценка немного завышена)
+func Entropy(s Spec) float64 {
+	if s.Words > 0 {
+		return float64(s.Words) * math.Log2(float64(len(wordlist())))
+	}
+
+	return float64(s.Length) * math.Log2(float64(len(strings.Join(s.classes(), ""))))
+}
+
+// classes выбранные классы символов
+func (s Spec) classes() []string {
+	var classes []string
+	for _, class := range []struct {
+		chars string
+		skip  bool
+	}{
+		{lowerChars, s.NoLower},
+		{upperChars, s.NoUpper},
+		{digitChars, s.NoDigits},
+		{symbolChars, s.NoSymbols},
+	} {
+		if class.skip {
+			continue
+		}
+		chars := class.chars
+		if s.NoAmbiguous {
+			chars = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(ambiguousChars, r) {
+					return -1
+				}
+				return r
+			}, chars)
+		}
+		classes = append(classes, chars)
+	}
+
+	return classes
+}
+
+// password пароль с символами каждого из классов
+// Пароли без какого-либо класса отбрасываются, поэтому все подходящие пароли равновероятны
+func password(length int, classes []string) (string, error) {
+	alphabet := strings.Join(classes, "")
+	buf := make([]byte, length)
+	for {
+		for i := range buf {
+			n, err := randomIndex(len(alphabet))
+			if err != nil {
+				return "", err
+			}
+			buf[i] = alphabet[n]
+		}
+
+		if hasAllClasses(string(buf), classes) {
+			return string(buf), nil
+		}
+	}
+}
+
+// hasAllClasses есть ли в пароле символы каждого класса
+func hasAllClasses(pass string, classes []string) bool {
+	for _, class := range classes {
+		if !strings.ContainsAny(pass, class) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// passphrase парольная фраза из случайных слов встроенного списка
+func passphrase(count int, separator string) (string, error) {
+	list := wordlist()
+	phrase := make([]string, count)
+	for i := range phrase {
+		n, err := randomIndex(len(list))
+		if err != nil {
+			return "", err
+		}
+		phrase[i] = list[n]
+	}
+
+	return strings.Join(phrase, separator), nil
+}
+
+// randomIndex равномерно распределенное случайное число от 0 до n-1
+func randomIndex(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(v.Int64()), nil
+}
+
+// wordlist встроенный список слов парольных фраз
+func wordlist() []string {
+	wordsOnce.Do(func() {
+		words = strings.Fields(wordlistData)
+	})
+
+	return words
+}
@@ -0,0 +1,90 @@
+package passgen
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	pass, err := Generate(DefaultSpec())
+	require.NoError(t, err)
+	assert.Len(t, pass, DefaultLength)
+	assert.True(t, hasAllClasses(pass, []string{lowerChars, upperChars, digitChars, symbolChars}))
+
+	// короткий пароль все равно содержит все выбранные классы
+	for i := 0; i < 50; i++ {
+		pass, err = Generate(Spec{Length: 4, NoAmbiguous: true})
+		require.NoError(t, err)
+		assert.Len(t, pass, 4)
+		assert.False(t, strings.ContainsAny(pass, ambiguousChars), pass)
+		assert.True(t, strings.ContainsAny(pass, symbolChars), pass)
+	}
+
+	pass, err = Generate(Spec{Length: 32, NoUpper: true, NoSymbols: true})
+	require.NoError(t, err)
+	assert.Equal(t, -1, strings.IndexFunc(pass, func(r rune) bool {
+		return !strings.ContainsRune(lowerChars+digitChars, r)
+	}), pass)
+
+	// пароли не повторяются
+	other, err := Generate(Spec{Length: 32, NoUpper: true, NoSymbols: true})
+	require.NoError(t, err)
+	assert.NotEqual(t, pass, other)
+
+	_, err = Generate(Spec{Length: 3})
+	assert.Error(t, err)
+	_, err = Generate(Spec{Length: 10, NoLower: true, NoUpper: true, NoDigits: true, NoSymbols: true})
+	assert.Error(t, err)
+}
+
+func TestGeneratePassphrase(t *testing.T) {
+	phrase, err := Generate(Spec{Words: 5, Separator: " "})
+	require.NoError(t, err)
+	parts := strings.Split(phrase, " ")
+	require.Len(t, parts, 5)
+	for _, word := range parts {
+		assert.Contains(t, wordlist(), word)
+	}
+
+	_, err = Generate(Spec{Words: 2})
+	assert.Error(t, err)
+
+	// список слов без повторов
+	seen := make(map[string]bool)
+	for _, word := range wordlist() {
+		assert.False(t, seen[word], word)
+		seen[word] = true
+	}
+	assert.Greater(t, len(seen), 1024)
+}
+
+func TestParseArgs(t *testing.T) {
+	spec, err := ParseArgs(nil)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSpec(), spec)
+
+	spec, err = ParseArgs([]string{"-length", "32", "-no-symbols", "-no-ambiguous"})
+	require.NoError(t, err)
+	assert.Equal(t, Spec{Length: 32, NoSymbols: true, NoAmbiguous: true, Separator: DefaultSeparator}, spec)
+
+	spec, err = ParseArgs([]string{"-words=4", "-separator", "."})
+	require.NoError(t, err)
+	assert.Equal(t, 4, spec.Words)
+	assert.Equal(t, ".", spec.Separator)
+
+	_, err = ParseArgs([]string{"-length", "1000"})
+	assert.Error(t, err)
+	_, err = ParseArgs([]string{"24"})
+	assert.Error(t, err)
+	_, err = ParseArgs([]string{"-nosuch"})
+	assert.Error(t, err)
+}
+
+func TestEntropy(t *testing.T) {
+	assert.InDelta(t, 20*math.Log2(62), Entropy(Spec{Length: 20, NoSymbols: true}), 0.001)
+	assert.InDelta(t, 6*math.Log2(float64(len(wordlist()))), Entropy(Spec{Words: 6}), 0.001)
+}
@@ -27,14 +27,50 @@ const (
 	CardEntity    string = "card"    // банковская карта
 	TextEntity    string = "text"    // произвольные текстовые данные
 	BinaryEntity  string = "binary"  // произвольные бинарные данные
+	OTPEntity     string = "otp"     // секрет одноразовых паролей (TOTP/HOTP)
+	SSHKeyEntity  string = "sshkey"  // SSH ключ
 )
 
 // типы полей свойств сущности
 const (
-	FieldTypeString string = "string" // строка
-	FieldTypePath   string = "path"   // путь к файлу
+	FieldTypeString        string = "string"        // строка
+	FieldTypePath          string = "path"          // путь к файлу
+	FieldTypeOTP           string = "otp"           // секрет одноразовых паролей (base32 или otpauth:// URI)
+	FieldTypeSSHKey        string = "sshkey"        // приватный SSH ключ (вводится путь к файлу, хранится содержимое)
+	FieldTypeSSHPublicKey  string = "sshpub"        // публичный SSH ключ в формате authorized_keys
+	FieldTypeSSHPassphrase string = "sshpassphrase" // пароль приватного SSH ключа
+	FieldTypeSSHComment    string = "sshcomment"    // комментарий SSH ключа
+	FieldTypeSecret        string = "secret"        // секретная строка: ввод без отображения, при просмотре скрывается
+	FieldTypeMultiline     string = "multiline"     // многострочный текст, вводится в редакторе
+	FieldTypeURL           string = "url"           // адрес (URL)
+	FieldTypeDate          string = "date"          // дата, хранится в виде ГГГГ-ММ-ДД
+	FieldTypeMonthYear     string = "monthyear"     // месяц и год, хранится в виде ММ/ГГ
+	FieldTypeNumber        string = "number"        // число
+	FieldTypeSelect        string = "select"        // выбор одного значения из списка вариантов
 )
 
+// названия метаданных, по которым git credential helper находит логин и пароль для сервера
+const (
+	MetaURL  string = "url"  // адрес сервера (https://host[:port][/path])
+	MetaHost string = "host" // имя сервера (host[:port])
+)
+
+// переменные окружения с учетными данными для неинтерактивных режимов клиента
+const (
+	EnvLogin    string = "GOPHKEEPER_LOGIN"    // логин пользователя хранилища
+	EnvPassword string = "GOPHKEEPER_PASSWORD" // пароль пользователя хранилища
+)
+
+// названия метаданных, управляющих работой встроенного ssh-agent
+const (
+	MetaSSHAgent        string = "ssh-agent"         // ключ доступен через агента (yes/no)
+	MetaSSHAgentConfirm string = "ssh-agent-confirm" // перед каждой подписью запрашивать подтверждение (yes/no)
+)
+
+// MetaSearchIndex название метаданных со списком (через запятую) названий метаданных сущности,
+// которые попадают в слепой индекс для поиска на сервере. Остальные метаданные не индексируются
+const MetaSearchIndex string = "search-index"
+
 // Названия методов для которых применяется симметричное шифрования
 // шифровка отправляемых данных
 const (
@@ -59,6 +95,12 @@ const (
 	DBContextTimeout time.Duration = time.Duration(10) * time.Second // длительность запроса в контексте работы с БД
 )
 
+// корзина
+const (
+	TrashRetention     time.Duration = time.Hour * 24 * 30 // срок хранения сущностей в корзине по умолчанию
+	TrashPurgeInterval time.Duration = time.Hour           // периодичность автоматической очистки корзины
+)
+
 // сообщения об ошибках
 const (
 	ErrPasswordsNotMatch string = "пароли не совпадают"
@@ -72,3 +114,62 @@ const (
 	ExcludeMethodRegistration string = "/proto.Keeper/Registration"
 	ExcludeMethodLogin        string = "/proto.Keeper/Login"
 )
+
+// хранилище данных сервера
+const (
+	StoragePostgresql string = "postgresql" // база данных Postgresql
+	StorageMemory     string = "memory"     // оперативная память (данные теряются при остановке, для демонстрации)
+	StorageSqlite     string = "sqlite"     // файл SQLite (однопользовательская установка)
+
+	SqliteDefaultFile string = "gophkeeper.db" // файл базы SQLite, если не указан databaseDSN
+)
+
+// папки сущностей
+const (
+	RootFolder int32 = -1 // фильтр списка сущностей: только сущности вне папок
+)
+
+// слепой индекс (blind index) для поиска по зашифрованной метаинформации
+const (
+	MaxSearchTokens int = 32 // максимальное количество токенов в одном запросе поиска
+)
+
+// избранное и последние открытые сущности
+const (
+	MaxRecentEntities int32 = 50 // максимальное количество последних открытых сущностей в одном запросе
+	RecentEntities    int32 = 5  // количество последних открытых сущностей в главном меню клиента
+)
+
+// постраничный список сущностей
+const (
+	DefaultPageSize int32 = 50  // размер страницы списка сущностей по умолчанию
+	MaxPageSize     int32 = 500 // максимальный размер страницы списка сущностей
+)
+
+// ограничения размеров сущности, проверяемые сервером (значения зашифрованы клиентом)
+const (
+	MaxPropValueSize int = 64 * 1024 // максимальный размер значения свойства
+	MaxMetainfoCount int = 64        // максимальное количество метаданных сущности
+	MaxMetainfoSize  int = 4 * 1024  // максимальный размер названия и значения метаданных
+	MaxTagsCount     int = 32        // максимальное количество тегов сущности
+	MaxTagSize       int = 1024      // максимальный размер тега
+	MaxEntityTokens  int = 2048      // максимальное количество токенов слепого индекса сущности
+)
+
+// пользовательские типы сущностей
+const (
+	MaxCustomTypes    int    = 100  // максимальное количество пользовательских типов сущностей (вместе с архивными)
+	MaxCustomFields   int    = 32   // максимальное количество полей пользовательского типа
+	MaxTypeNameSize   int    = 256  // максимальная длина названия типа и поля (в символах)
+	MaxValidateLength int    = 1024 // максимальная длина правил и сообщений валидации
+	MaxSelectOptions  int    = 64   // максимальное количество вариантов значения поля типа select
+	CustomEtypePrefix string = "u"  // префикс кода пользовательского типа: u<ID пользователя>_<случайный суффикс>
+)
+
+// вложения сущностей
+const (
+	AttachmentsDir          string = "attachments"    // папка вложений в файловом хранилище сервера
+	MaxAttachments          int    = 32               // максимальное количество вложений одной сущности
+	MaxAttachmentSize       int64  = 64 * 1024 * 1024 // максимальный размер зашифрованных данных вложения
+	MaxAttachmentFieldsSize int    = 4 * 1024         // максимальный размер зашифрованных имени и контрольной суммы вложения
+)
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.34.1
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.12.4
 // source: internal/proto/keeper.proto
 
@@ -20,13 +20,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// пинг сервера
+// Порядок сортировки списка сущностей, при равных значениях - по ID
+type EntitySort int32
+
+const (
+	EntitySort_ENTITY_SORT_ID       EntitySort = 0 // по ID (порядок создания)
+	EntitySort_ENTITY_SORT_CREATED  EntitySort = 1 // по времени создания
+	EntitySort_ENTITY_SORT_UPDATED  EntitySort = 2 // по времени последнего изменения
+	EntitySort_ENTITY_SORT_ACCESSED EntitySort = 3 // по времени последнего получения
+)
+
+// Enum value maps for EntitySort.
+var (
+	EntitySort_name = map[int32]string{
+		0: "ENTITY_SORT_ID",
+		1: "ENTITY_SORT_CREATED",
+		2: "ENTITY_SORT_UPDATED",
+		3: "ENTITY_SORT_ACCESSED",
+	}
+	EntitySort_value = map[string]int32{
+		"ENTITY_SORT_ID":       0,
+		"ENTITY_SORT_CREATED":  1,
+		"ENTITY_SORT_UPDATED":  2,
+		"ENTITY_SORT_ACCESSED": 3,
+	}
+)
+
+func (x EntitySort) Enum() *EntitySort {
+	p := new(EntitySort)
+	*p = x
+	return p
+}
+
+func (x EntitySort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntitySort) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_keeper_proto_enumTypes[0].Descriptor()
+}
+
+func (EntitySort) Type() protoreflect.EnumType {
+	return &file_internal_proto_keeper_proto_enumTypes[0]
+}
+
+func (x EntitySort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntitySort.Descriptor instead.
+func (EntitySort) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{0}
+}
+
+// Пинг сервера
 type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // ping
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // ping (некоторое тестовое сообщение на сервер)
 }
 
 func (x *PingRequest) Reset() {
@@ -68,13 +121,13 @@ func (x *PingRequest) GetMessage() string {
 	return ""
 }
 
-// ответ на пинг сервера
+// Ответ на пинг сервера
 type PingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // pong
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // pong (некоторое тестовое сообщение с сервера)
 }
 
 func (x *PingResponse) Reset() {
@@ -348,14 +401,16 @@ func (x *LoginResponse) GetError() string {
 	return ""
 }
 
-// объект "код сущности-название"
+// Объект "код сущности-название"
 type EntityCode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Etype string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"` // тип сущности: card, text, logopas, binary
-	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`   // название сущности
+	Etype    string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"`        // тип сущности: card, text, logopas, binary
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`          // название сущности
+	Custom   bool   `protobuf:"varint,3,opt,name=custom,proto3" json:"custom,omitempty"`     // тип создан пользователем
+	Archived bool   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"` // тип в архиве: новые сущности этого типа не создаются, существующие доступны
 }
 
 func (x *EntityCode) Reset() {
@@ -404,6 +459,20 @@ func (x *EntityCode) GetName() string {
 	return ""
 }
 
+func (x *EntityCode) GetCustom() bool {
+	if x != nil {
+		return x.Custom
+	}
+	return false
+}
+
+func (x *EntityCode) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
 // Запрос списка доступных к добавлению типов сущностей (таблица entity_codes)
 type EntityCodesRequest struct {
 	state         protoimpl.MessageState
@@ -449,7 +518,7 @@ type EntityCodesResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EntityCodes []*EntityCode `protobuf:"bytes,1,rep,name=entity_codes,json=entityCodes,proto3" json:"entity_codes,omitempty"`
+	EntityCodes []*EntityCode `protobuf:"bytes,1,rep,name=entity_codes,json=entityCodes,proto3" json:"entity_codes,omitempty"` // массив (справочник) кодов сущностей
 }
 
 func (x *EntityCodesResponse) Reset() {
@@ -491,22 +560,19 @@ func (x *EntityCodesResponse) GetEntityCodes() []*EntityCode {
 	return nil
 }
 
-// объект "Поле сущности"
-type Field struct {
+// Запрос на создание пользовательского типа сущности
+// Порядок полей в форме - порядок в запросе, id и etype полей не указываются
+type CreateEntityTypeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                    // код поля
-	Etype            string `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                                               // тип сущности (card? binary и т.д.)
-	Name             string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                 // наименование поля
-	Ftype            string `protobuf:"bytes,4,opt,name=ftype,proto3" json:"ftype,omitempty"`                                               // тип поля (string, path и т.п.)
-	ValidateRules    string `protobuf:"bytes,5,opt,name=validate_rules,json=validateRules,proto3" json:"validate_rules,omitempty"`          // правила валидации
-	ValidateMessages string `protobuf:"bytes,6,opt,name=validate_messages,json=validateMessages,proto3" json:"validate_messages,omitempty"` // сообщения валидации (при непрохождении оной)
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // название типа
+	Fields []*Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"` // описания полей
 }
 
-func (x *Field) Reset() {
-	*x = Field{}
+func (x *CreateEntityTypeRequest) Reset() {
+	*x = CreateEntityTypeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -514,13 +580,13 @@ func (x *Field) Reset() {
 	}
 }
 
-func (x *Field) String() string {
+func (x *CreateEntityTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Field) ProtoMessage() {}
+func (*CreateEntityTypeRequest) ProtoMessage() {}
 
-func (x *Field) ProtoReflect() protoreflect.Message {
+func (x *CreateEntityTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,64 +598,36 @@ func (x *Field) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Field.ProtoReflect.Descriptor instead.
-func (*Field) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateEntityTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEntityTypeRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Field) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Field) GetEtype() string {
-	if x != nil {
-		return x.Etype
-	}
-	return ""
-}
-
-func (x *Field) GetName() string {
+func (x *CreateEntityTypeRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Field) GetFtype() string {
-	if x != nil {
-		return x.Ftype
-	}
-	return ""
-}
-
-func (x *Field) GetValidateRules() string {
-	if x != nil {
-		return x.ValidateRules
-	}
-	return ""
-}
-
-func (x *Field) GetValidateMessages() string {
+func (x *CreateEntityTypeRequest) GetFields() []*Field {
 	if x != nil {
-		return x.ValidateMessages
+		return x.Fields
 	}
-	return ""
+	return nil
 }
 
-// Запрос списка характеристик полей сущности
-type FieldsRequest struct {
+// Ответ на запрос на создание пользовательского типа сущности
+type CreateEntityTypeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Etype string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"` // тип сущности: card, text, logopas, binary и т.д.
+	Etype string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"` // код созданного типа
 }
 
-func (x *FieldsRequest) Reset() {
-	*x = FieldsRequest{}
+func (x *CreateEntityTypeResponse) Reset() {
+	*x = CreateEntityTypeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -597,13 +635,13 @@ func (x *FieldsRequest) Reset() {
 	}
 }
 
-func (x *FieldsRequest) String() string {
+func (x *CreateEntityTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsRequest) ProtoMessage() {}
+func (*CreateEntityTypeResponse) ProtoMessage() {}
 
-func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateEntityTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,29 +653,33 @@ func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
-func (*FieldsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateEntityTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateEntityTypeResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *FieldsRequest) GetEtype() string {
+func (x *CreateEntityTypeResponse) GetEtype() string {
 	if x != nil {
 		return x.Etype
 	}
 	return ""
 }
 
-// Ответ на запрос списка характеристик полей сущности
-type FieldsResponse struct {
+// Запрос на изменение пользовательского типа сущности
+// Передается полный набор полей в нужном порядке: поля с id изменяются, без id - добавляются.
+// Удалять поля нельзя, в сущностях могут храниться их значения
+type UpdateEntityTypeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fields []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Etype  string   `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"`   // код типа
+	Name   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // новое название типа
+	Fields []*Field `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"` // описания полей
 }
 
-func (x *FieldsResponse) Reset() {
-	*x = FieldsResponse{}
+func (x *UpdateEntityTypeRequest) Reset() {
+	*x = UpdateEntityTypeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +687,13 @@ func (x *FieldsResponse) Reset() {
 	}
 }
 
-func (x *FieldsResponse) String() string {
+func (x *UpdateEntityTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsResponse) ProtoMessage() {}
+func (*UpdateEntityTypeRequest) ProtoMessage() {}
 
-func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateEntityTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,31 +705,43 @@ func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
-func (*FieldsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateEntityTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEntityTypeRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *FieldsResponse) GetFields() []*Field {
+func (x *UpdateEntityTypeRequest) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *UpdateEntityTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEntityTypeRequest) GetFields() []*Field {
 	if x != nil {
 		return x.Fields
 	}
 	return nil
 }
 
-// Значение свойства сущности
-type Property struct {
+// Ответ на запрос на изменение пользовательского типа сущности
+type UpdateEntityTypeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EntityId int32  `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"` // код сущности
-	FieldId  int32  `protobuf:"varint,2,opt,name=fieldId,proto3" json:"fieldId,omitempty"`   // код описания поля свйоства
-	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`        // значение свойства
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
 }
 
-func (x *Property) Reset() {
-	*x = Property{}
+func (x *UpdateEntityTypeResponse) Reset() {
+	*x = UpdateEntityTypeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -695,13 +749,13 @@ func (x *Property) Reset() {
 	}
 }
 
-func (x *Property) String() string {
+func (x *UpdateEntityTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Property) ProtoMessage() {}
+func (*UpdateEntityTypeResponse) ProtoMessage() {}
 
-func (x *Property) ProtoReflect() protoreflect.Message {
+func (x *UpdateEntityTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -713,45 +767,30 @@ func (x *Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Property.ProtoReflect.Descriptor instead.
-func (*Property) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateEntityTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEntityTypeResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Property) GetEntityId() int32 {
-	if x != nil {
-		return x.EntityId
-	}
-	return 0
-}
-
-func (x *Property) GetFieldId() int32 {
-	if x != nil {
-		return x.FieldId
-	}
-	return 0
-}
-
-func (x *Property) GetValue() string {
+func (x *UpdateEntityTypeResponse) GetError() string {
 	if x != nil {
-		return x.Value
+		return x.Error
 	}
 	return ""
 }
 
-// Метаинформация сущности
-type Metainfo struct {
+// Запрос на перемещение пользовательского типа в архив или возврат из архива
+type ArchiveEntityTypeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EntityId int32  `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"` // код сущности
-	Title    string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`        // наименование метаинформации
-	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`        // значение метаинформации
+	Etype    string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"`        // код типа
+	Archived bool   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"` // true - в архив, false - из архива
 }
 
-func (x *Metainfo) Reset() {
-	*x = Metainfo{}
+func (x *ArchiveEntityTypeRequest) Reset() {
+	*x = ArchiveEntityTypeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -759,13 +798,13 @@ func (x *Metainfo) Reset() {
 	}
 }
 
-func (x *Metainfo) String() string {
+func (x *ArchiveEntityTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metainfo) ProtoMessage() {}
+func (*ArchiveEntityTypeRequest) ProtoMessage() {}
 
-func (x *Metainfo) ProtoReflect() protoreflect.Message {
+func (x *ArchiveEntityTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -777,46 +816,36 @@ func (x *Metainfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Metainfo.ProtoReflect.Descriptor instead.
-func (*Metainfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveEntityTypeRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveEntityTypeRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Metainfo) GetEntityId() int32 {
-	if x != nil {
-		return x.EntityId
-	}
-	return 0
-}
-
-func (x *Metainfo) GetTitle() string {
+func (x *ArchiveEntityTypeRequest) GetEtype() string {
 	if x != nil {
-		return x.Title
+		return x.Etype
 	}
 	return ""
 }
 
-func (x *Metainfo) GetValue() string {
+func (x *ArchiveEntityTypeRequest) GetArchived() bool {
 	if x != nil {
-		return x.Value
+		return x.Archived
 	}
-	return ""
+	return false
 }
 
-// Запрос на добавление новой сущности
-type AddEntityRequest struct {
+// Ответ на запрос на перемещение типа в архив
+type ArchiveEntityTypeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // ID сущности
-	Etype    string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`       // тип сущности: card, text, logopas, binary и т.д.
-	Props    []*Property `protobuf:"bytes,3,rep,name=props,proto3" json:"props,omitempty"`       // массив значений свойств
-	Metainfo []*Metainfo `protobuf:"bytes,4,rep,name=metainfo,proto3" json:"metainfo,omitempty"` // массив значений метаинформации
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
 }
 
-func (x *AddEntityRequest) Reset() {
-	*x = AddEntityRequest{}
+func (x *ArchiveEntityTypeResponse) Reset() {
+	*x = ArchiveEntityTypeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -824,13 +853,13 @@ func (x *AddEntityRequest) Reset() {
 	}
 }
 
-func (x *AddEntityRequest) String() string {
+func (x *ArchiveEntityTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEntityRequest) ProtoMessage() {}
+func (*ArchiveEntityTypeResponse) ProtoMessage() {}
 
-func (x *AddEntityRequest) ProtoReflect() protoreflect.Message {
+func (x *ArchiveEntityTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -842,51 +871,37 @@ func (x *AddEntityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEntityRequest.ProtoReflect.Descriptor instead.
-func (*AddEntityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ArchiveEntityTypeResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveEntityTypeResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AddEntityRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *AddEntityRequest) GetEtype() string {
+func (x *ArchiveEntityTypeResponse) GetError() string {
 	if x != nil {
-		return x.Etype
+		return x.Error
 	}
 	return ""
 }
 
-func (x *AddEntityRequest) GetProps() []*Property {
-	if x != nil {
-		return x.Props
-	}
-	return nil
-}
-
-func (x *AddEntityRequest) GetMetainfo() []*Metainfo {
-	if x != nil {
-		return x.Metainfo
-	}
-	return nil
-}
-
-// Ответ на запрос на добавление новой сущности
-type AddEntityResponse struct {
+// Объект "Поле сущности"
+type Field struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID добавленной сущности
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+	Id               int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                    // код поля
+	Etype            string   `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                                               // тип сущности (card, binary и т.д.)
+	Name             string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                                 // наименование поля
+	Ftype            string   `protobuf:"bytes,4,opt,name=ftype,proto3" json:"ftype,omitempty"`                                               // тип поля (string, secret, multiline, url, date, monthyear, number, select, path и т.п.)
+	ValidateRules    string   `protobuf:"bytes,5,opt,name=validate_rules,json=validateRules,proto3" json:"validate_rules,omitempty"`          // правила валидации
+	ValidateMessages string   `protobuf:"bytes,6,opt,name=validate_messages,json=validateMessages,proto3" json:"validate_messages,omitempty"` // сообщения валидации (при непрохождении оной)
+	Secret           bool     `protobuf:"varint,7,opt,name=secret,proto3" json:"secret,omitempty"`                                            // значение секретно: скрывается при просмотре и не индексируется для поиска
+	Position         int32    `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`                                        // порядок поля в форме (поля упорядочены по position, затем по id)
+	Options          []string `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty"`                                           // варианты значения поля типа select
 }
 
-func (x *AddEntityResponse) Reset() {
-	*x = AddEntityResponse{}
+func (x *Field) Reset() {
+	*x = Field{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -894,13 +909,13 @@ func (x *AddEntityResponse) Reset() {
 	}
 }
 
-func (x *AddEntityResponse) String() string {
+func (x *Field) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEntityResponse) ProtoMessage() {}
+func (*Field) ProtoMessage() {}
 
-func (x *AddEntityResponse) ProtoReflect() protoreflect.Message {
+func (x *Field) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -912,124 +927,100 @@ func (x *AddEntityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEntityResponse.ProtoReflect.Descriptor instead.
-func (*AddEntityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AddEntityResponse) GetId() int32 {
+func (x *Field) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *AddEntityResponse) GetError() string {
+func (x *Field) GetEtype() string {
 	if x != nil {
-		return x.Error
+		return x.Etype
 	}
 	return ""
 }
 
-// Запрос на редактирование сущности
-type SaveEntityRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Field) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-	Id       int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // ID сущности
-	Etype    string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`       // тип сущности: card, text, logopas, binary и т.д.
-	Props    []*Property `protobuf:"bytes,3,rep,name=props,proto3" json:"props,omitempty"`       // массив значений свойств
-	Metainfo []*Metainfo `protobuf:"bytes,4,rep,name=metainfo,proto3" json:"metainfo,omitempty"` // массив значений метаинформации
+func (x *Field) GetFtype() string {
+	if x != nil {
+		return x.Ftype
+	}
+	return ""
 }
 
-func (x *SaveEntityRequest) Reset() {
-	*x = SaveEntityRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Field) GetValidateRules() string {
+	if x != nil {
+		return x.ValidateRules
 	}
+	return ""
 }
 
-func (x *SaveEntityRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Field) GetValidateMessages() string {
+	if x != nil {
+		return x.ValidateMessages
+	}
+	return ""
 }
 
-func (*SaveEntityRequest) ProtoMessage() {}
-
-func (x *SaveEntityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SaveEntityRequest.ProtoReflect.Descriptor instead.
-func (*SaveEntityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *SaveEntityRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *SaveEntityRequest) GetEtype() string {
+func (x *Field) GetSecret() bool {
 	if x != nil {
-		return x.Etype
+		return x.Secret
 	}
-	return ""
+	return false
 }
 
-func (x *SaveEntityRequest) GetProps() []*Property {
+func (x *Field) GetPosition() int32 {
 	if x != nil {
-		return x.Props
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-func (x *SaveEntityRequest) GetMetainfo() []*Metainfo {
+func (x *Field) GetOptions() []string {
 	if x != nil {
-		return x.Metainfo
+		return x.Options
 	}
 	return nil
 }
 
-// Ответ на запрос на добавление новой сущности
-type SaveEntityResponse struct {
+// Запрос списка характеристик полей сущности
+type FieldsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID сущности
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+	Etype string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"` // тип сущности: card, text, logopas, binary и т.д.
 }
 
-func (x *SaveEntityResponse) Reset() {
-	*x = SaveEntityResponse{}
+func (x *FieldsRequest) Reset() {
+	*x = FieldsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[17]
+		mi := &file_internal_proto_keeper_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SaveEntityResponse) String() string {
+func (x *FieldsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveEntityResponse) ProtoMessage() {}
+func (*FieldsRequest) ProtoMessage() {}
 
-func (x *SaveEntityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[17]
+func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,52 +1031,44 @@ func (x *SaveEntityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveEntityResponse.ProtoReflect.Descriptor instead.
-func (*SaveEntityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *SaveEntityResponse) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
+func (*FieldsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SaveEntityResponse) GetError() string {
+func (x *FieldsRequest) GetEtype() string {
 	if x != nil {
-		return x.Error
+		return x.Etype
 	}
 	return ""
 }
 
-// Загрузка бинарных данных на сервер (вызывается сразу после AddEntityResponse)
-type UploadBinRequest struct {
+// Ответ на запрос списка характеристик полей сущности
+type FieldsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EntityId  int32  `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`   // код сущности для которого будут загружаться бинарные данные
-	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"` // chunk
+	Fields []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"` // массив описаний полей сущности
 }
 
-func (x *UploadBinRequest) Reset() {
-	*x = UploadBinRequest{}
+func (x *FieldsResponse) Reset() {
+	*x = FieldsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[18]
+		mi := &file_internal_proto_keeper_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadBinRequest) String() string {
+func (x *FieldsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadBinRequest) ProtoMessage() {}
+func (*FieldsResponse) ProtoMessage() {}
 
-func (x *UploadBinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[18]
+func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1096,52 +1079,46 @@ func (x *UploadBinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadBinRequest.ProtoReflect.Descriptor instead.
-func (*UploadBinRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *UploadBinRequest) GetEntityId() int32 {
-	if x != nil {
-		return x.EntityId
-	}
-	return 0
+// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
+func (*FieldsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UploadBinRequest) GetChunkData() []byte {
+func (x *FieldsResponse) GetFields() []*Field {
 	if x != nil {
-		return x.ChunkData
+		return x.Fields
 	}
 	return nil
 }
 
-// Ответ на загрузку бинарных данных на сервер
-type UploadBinResponse struct {
+// Значение свойства сущности
+type Property struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Size  int32  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`  // размер загруженных данных
-	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+	EntityId int32  `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"` // код сущности
+	FieldId  int32  `protobuf:"varint,2,opt,name=fieldId,proto3" json:"fieldId,omitempty"`   // код описания поля свйоства
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`        // значение свойства
 }
 
-func (x *UploadBinResponse) Reset() {
-	*x = UploadBinResponse{}
+func (x *Property) Reset() {
+	*x = Property{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[19]
+		mi := &file_internal_proto_keeper_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadBinResponse) String() string {
+func (x *Property) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadBinResponse) ProtoMessage() {}
+func (*Property) ProtoMessage() {}
 
-func (x *UploadBinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[19]
+func (x *Property) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,51 +1129,60 @@ func (x *UploadBinResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadBinResponse.ProtoReflect.Descriptor instead.
-func (*UploadBinResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Property.ProtoReflect.Descriptor instead.
+func (*Property) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *UploadBinResponse) GetSize() int32 {
+func (x *Property) GetEntityId() int32 {
 	if x != nil {
-		return x.Size
+		return x.EntityId
 	}
 	return 0
 }
 
-func (x *UploadBinResponse) GetError() string {
+func (x *Property) GetFieldId() int32 {
 	if x != nil {
-		return x.Error
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *Property) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-// Получение сущности с сервера
-type EntityRequest struct {
+// Метаинформация сущности
+type Metainfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Идентификатор сущности
+	EntityId int32  `protobuf:"varint,1,opt,name=entityId,proto3" json:"entityId,omitempty"` // код сущности
+	Title    string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`        // наименование метаинформации
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`        // значение метаинформации
 }
 
-func (x *EntityRequest) Reset() {
-	*x = EntityRequest{}
+func (x *Metainfo) Reset() {
+	*x = Metainfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[20]
+		mi := &file_internal_proto_keeper_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EntityRequest) String() string {
+func (x *Metainfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityRequest) ProtoMessage() {}
+func (*Metainfo) ProtoMessage() {}
 
-func (x *EntityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[20]
+func (x *Metainfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,48 +1193,64 @@ func (x *EntityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityRequest.ProtoReflect.Descriptor instead.
-func (*EntityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Metainfo.ProtoReflect.Descriptor instead.
+func (*Metainfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *EntityRequest) GetId() int32 {
+func (x *Metainfo) GetEntityId() int32 {
 	if x != nil {
-		return x.Id
+		return x.EntityId
 	}
 	return 0
 }
 
-// Ответ на получение сущности с сервера
-type EntityResponse struct {
+func (x *Metainfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Metainfo) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Запрос на добавление новой сущности
+type AddEntityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // ID сущности
-	Etype    string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`       // тип сущности: card, text, logopas, binary и т.д.
-	Props    []*Property `protobuf:"bytes,3,rep,name=props,proto3" json:"props,omitempty"`       // массив значений свойств
-	Metainfo []*Metainfo `protobuf:"bytes,4,rep,name=metainfo,proto3" json:"metainfo,omitempty"` // массив значений метаинформации
-	Error    string      `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`       // если возникла ошибка - описание ошибки, иначе - пустая строка
+	Id         int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // ID сущности
+	Etype      string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                             // тип сущности: card, text, logopas, binary и т.д.
+	Props      []*Property `protobuf:"bytes,3,rep,name=props,proto3" json:"props,omitempty"`                             // массив значений свойств
+	Metainfo   []*Metainfo `protobuf:"bytes,4,rep,name=metainfo,proto3" json:"metainfo,omitempty"`                       // массив значений метаинформации
+	BlindIndex []string    `protobuf:"bytes,5,rep,name=blind_index,json=blindIndex,proto3" json:"blind_index,omitempty"` // токены слепого индекса (HMAC от метаинформации, вычисляются на клиенте)
+	FolderId   int32       `protobuf:"varint,6,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`      // ID папки, 0 - вне папок
+	Tags       []string    `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                               // теги (зашифрованы)
 }
 
-func (x *EntityResponse) Reset() {
-	*x = EntityResponse{}
+func (x *AddEntityRequest) Reset() {
+	*x = AddEntityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[21]
+		mi := &file_internal_proto_keeper_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EntityResponse) String() string {
+func (x *AddEntityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityResponse) ProtoMessage() {}
+func (*AddEntityRequest) ProtoMessage() {}
 
-func (x *EntityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[21]
+func (x *AddEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1259,71 +1261,147 @@ func (x *EntityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityResponse.ProtoReflect.Descriptor instead.
-func (*EntityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AddEntityRequest.ProtoReflect.Descriptor instead.
+func (*AddEntityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EntityResponse) GetId() int32 {
+func (x *AddEntityRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *EntityResponse) GetEtype() string {
+func (x *AddEntityRequest) GetEtype() string {
 	if x != nil {
 		return x.Etype
 	}
 	return ""
 }
 
-func (x *EntityResponse) GetProps() []*Property {
+func (x *AddEntityRequest) GetProps() []*Property {
 	if x != nil {
 		return x.Props
 	}
 	return nil
 }
 
-func (x *EntityResponse) GetMetainfo() []*Metainfo {
+func (x *AddEntityRequest) GetMetainfo() []*Metainfo {
 	if x != nil {
 		return x.Metainfo
 	}
 	return nil
 }
 
-func (x *EntityResponse) GetError() string {
+func (x *AddEntityRequest) GetBlindIndex() []string {
 	if x != nil {
-		return x.Error
+		return x.BlindIndex
 	}
-	return ""
+	return nil
 }
 
-// Запрос на удаление сущности
-type DeleteEntityRequest struct {
+func (x *AddEntityRequest) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *AddEntityRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Ответ на запрос на добавление новой сущности
+type AddEntityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Идентификатор сущности
+	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID добавленной сущности
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
 }
 
-func (x *DeleteEntityRequest) Reset() {
-	*x = DeleteEntityRequest{}
-	if protoimpl.UnsafeEnabled {
+func (x *AddEntityResponse) Reset() {
+	*x = AddEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEntityResponse) ProtoMessage() {}
+
+func (x *AddEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEntityResponse.ProtoReflect.Descriptor instead.
+func (*AddEntityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AddEntityResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddEntityResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Запрос на редактирование сущности
+type SaveEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                  // ID сущности
+	Etype      string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                             // тип сущности: card, text, logopas, binary и т.д.
+	Props      []*Property `protobuf:"bytes,3,rep,name=props,proto3" json:"props,omitempty"`                             // массив значений свойств
+	Metainfo   []*Metainfo `protobuf:"bytes,4,rep,name=metainfo,proto3" json:"metainfo,omitempty"`                       // массив значений метаинформации
+	BlindIndex []string    `protobuf:"bytes,5,rep,name=blind_index,json=blindIndex,proto3" json:"blind_index,omitempty"` // токены слепого индекса (HMAC от метаинформации, вычисляются на клиенте)
+	FolderId   int32       `protobuf:"varint,6,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`      // ID папки, 0 - вне папок
+	Tags       []string    `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                               // теги (зашифрованы)
+}
+
+func (x *SaveEntityRequest) Reset() {
+	*x = SaveEntityRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteEntityRequest) String() string {
+func (x *SaveEntityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntityRequest) ProtoMessage() {}
+func (*SaveEntityRequest) ProtoMessage() {}
 
-func (x *DeleteEntityRequest) ProtoReflect() protoreflect.Message {
+func (x *SaveEntityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1335,28 +1413,72 @@ func (x *DeleteEntityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntityRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEntityRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveEntityRequest.ProtoReflect.Descriptor instead.
+func (*SaveEntityRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteEntityRequest) GetId() int32 {
+func (x *SaveEntityRequest) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteEntityResponse struct {
+func (x *SaveEntityRequest) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *SaveEntityRequest) GetProps() []*Property {
+	if x != nil {
+		return x.Props
+	}
+	return nil
+}
+
+func (x *SaveEntityRequest) GetMetainfo() []*Metainfo {
+	if x != nil {
+		return x.Metainfo
+	}
+	return nil
+}
+
+func (x *SaveEntityRequest) GetBlindIndex() []string {
+	if x != nil {
+		return x.BlindIndex
+	}
+	return nil
+}
+
+func (x *SaveEntityRequest) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *SaveEntityRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Ответ на запрос на добавление новой сущности
+type SaveEntityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID сущности
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
 }
 
-func (x *DeleteEntityResponse) Reset() {
-	*x = DeleteEntityResponse{}
+func (x *SaveEntityResponse) Reset() {
+	*x = SaveEntityResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1364,13 +1486,13 @@ func (x *DeleteEntityResponse) Reset() {
 	}
 }
 
-func (x *DeleteEntityResponse) String() string {
+func (x *SaveEntityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEntityResponse) ProtoMessage() {}
+func (*SaveEntityResponse) ProtoMessage() {}
 
-func (x *DeleteEntityResponse) ProtoReflect() protoreflect.Message {
+func (x *SaveEntityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1382,29 +1504,37 @@ func (x *DeleteEntityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEntityResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEntityResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SaveEntityResponse.ProtoReflect.Descriptor instead.
+func (*SaveEntityResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteEntityResponse) GetError() string {
+func (x *SaveEntityResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SaveEntityResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-// Загрузка бинарных данных с сервера
-type DownloadBinRequest struct {
+// Загрузка бинарных данных на сервер (вызывается сразу после AddEntityResponse)
+type UploadBinRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EntityId int32 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // код сущности для которого будут загружаться бинарные данные
+	EntityId  int32  `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`   // код сущности для которого будут загружаться бинарные данные
+	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"` // chunk
 }
 
-func (x *DownloadBinRequest) Reset() {
-	*x = DownloadBinRequest{}
+func (x *UploadBinRequest) Reset() {
+	*x = UploadBinRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1412,13 +1542,13 @@ func (x *DownloadBinRequest) Reset() {
 	}
 }
 
-func (x *DownloadBinRequest) String() string {
+func (x *UploadBinRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadBinRequest) ProtoMessage() {}
+func (*UploadBinRequest) ProtoMessage() {}
 
-func (x *DownloadBinRequest) ProtoReflect() protoreflect.Message {
+func (x *UploadBinRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1430,28 +1560,37 @@ func (x *DownloadBinRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadBinRequest.ProtoReflect.Descriptor instead.
-func (*DownloadBinRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadBinRequest.ProtoReflect.Descriptor instead.
+func (*UploadBinRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DownloadBinRequest) GetEntityId() int32 {
+func (x *UploadBinRequest) GetEntityId() int32 {
 	if x != nil {
 		return x.EntityId
 	}
 	return 0
 }
 
-type DownloadBinResponse struct {
+func (x *UploadBinRequest) GetChunkData() []byte {
+	if x != nil {
+		return x.ChunkData
+	}
+	return nil
+}
+
+// Ответ на загрузку бинарных данных на сервер
+type UploadBinResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChunkData []byte `protobuf:"bytes,1,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"` // chunk
+	Size  int32  `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`  // размер загруженных данных
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
 }
 
-func (x *DownloadBinResponse) Reset() {
-	*x = DownloadBinResponse{}
+func (x *UploadBinResponse) Reset() {
+	*x = UploadBinResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_keeper_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1459,427 +1598,3589 @@ func (x *DownloadBinResponse) Reset() {
 	}
 }
 
-func (x *DownloadBinResponse) String() string {
+func (x *UploadBinResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadBinResponse) ProtoMessage() {}
+func (*UploadBinResponse) ProtoMessage() {}
 
-func (x *DownloadBinResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadBinResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_keeper_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DownloadBinResponse.ProtoReflect.Descriptor instead.
-func (*DownloadBinResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *DownloadBinResponse) GetChunkData() []byte {
-	if x != nil {
-		return x.ChunkData
-	}
-	return nil
-}
-
-// Получение списка сущностей пользователя определенного типа
-type EntityListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Etype string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"` // тип сущности: card, text, logopas, binary и т.д.
-}
-
-func (x *EntityListRequest) Reset() {
-	*x = EntityListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *EntityListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EntityListRequest) ProtoMessage() {}
-
-func (x *EntityListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadBinResponse.ProtoReflect.Descriptor instead.
+func (*UploadBinResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UploadBinResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadBinResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Получение сущности с сервера
+type EntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`       // Идентификатор сущности
+	Touch bool  `protobuf:"varint,2,opt,name=touch,proto3" json:"touch,omitempty"` // сущность открыта пользователем: время получения запоминается для списка последних открытых
+}
+
+func (x *EntityRequest) Reset() {
+	*x = EntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRequest) ProtoMessage() {}
+
+func (x *EntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRequest.ProtoReflect.Descriptor instead.
+func (*EntityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EntityRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EntityRequest) GetTouch() bool {
+	if x != nil {
+		return x.Touch
+	}
+	return false
+}
+
+// Ответ на получение сущности с сервера
+type EntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // ID сущности
+	Etype      string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                              // тип сущности: card, text, logopas, binary и т.д.
+	Props      []*Property `protobuf:"bytes,3,rep,name=props,proto3" json:"props,omitempty"`                              // массив значений свойств
+	Metainfo   []*Metainfo `protobuf:"bytes,4,rep,name=metainfo,proto3" json:"metainfo,omitempty"`                        // массив значений метаинформации
+	Error      string      `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                              // если возникла ошибка - описание ошибки, иначе - пустая строка
+	FolderId   int32       `protobuf:"varint,6,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`       // ID папки, 0 - вне папок
+	Tags       []string    `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                // теги (зашифрованы)
+	Favorite   bool        `protobuf:"varint,8,opt,name=favorite,proto3" json:"favorite,omitempty"`                       // сущность в избранном
+	AccessedAt int64       `protobuf:"varint,9,opt,name=accessed_at,json=accessedAt,proto3" json:"accessed_at,omitempty"` // время предыдущего получения сущности (unix timestamp), 0 - не открывалась
+}
+
+func (x *EntityResponse) Reset() {
+	*x = EntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityResponse) ProtoMessage() {}
+
+func (x *EntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityResponse.ProtoReflect.Descriptor instead.
+func (*EntityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *EntityResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EntityResponse) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetProps() []*Property {
+	if x != nil {
+		return x.Props
+	}
+	return nil
+}
+
+func (x *EntityResponse) GetMetainfo() []*Metainfo {
+	if x != nil {
+		return x.Metainfo
+	}
+	return nil
+}
+
+func (x *EntityResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EntityResponse) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *EntityResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EntityResponse) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *EntityResponse) GetAccessedAt() int64 {
+	if x != nil {
+		return x.AccessedAt
+	}
+	return 0
+}
+
+// Запрос на удаление сущности
+type DeleteEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // идентификатор сущности
+}
+
+func (x *DeleteEntityRequest) Reset() {
+	*x = DeleteEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntityRequest) ProtoMessage() {}
+
+func (x *DeleteEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEntityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteEntityRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос на удаление сущности
+type DeleteEntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *DeleteEntityResponse) Reset() {
+	*x = DeleteEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEntityResponse) ProtoMessage() {}
+
+func (x *DeleteEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEntityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEntityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteEntityResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Загрузка бинарных данных с сервера
+type DownloadBinRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId int32 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // код сущности для которого будут загружаться бинарные данные
+}
+
+func (x *DownloadBinRequest) Reset() {
+	*x = DownloadBinRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinRequest) ProtoMessage() {}
+
+func (x *DownloadBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBinRequest.ProtoReflect.Descriptor instead.
+func (*DownloadBinRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DownloadBinRequest) GetEntityId() int32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+// Ответ на запрос загрузки бинарных данных с сервера
+type DownloadBinResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkData []byte `protobuf:"bytes,1,opt,name=chunk_data,json=chunkData,proto3" json:"chunk_data,omitempty"` // chunk (фрагмент бинарных данных)
+}
+
+func (x *DownloadBinResponse) Reset() {
+	*x = DownloadBinResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinResponse) ProtoMessage() {}
+
+func (x *DownloadBinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBinResponse.ProtoReflect.Descriptor instead.
+func (*DownloadBinResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DownloadBinResponse) GetChunkData() []byte {
+	if x != nil {
+		return x.ChunkData
+	}
+	return nil
+}
+
+// Получение списка сущностей пользователя определенного типа
+// Устарело: используйте ListEntities
+//
+// Deprecated: Marked as deprecated in internal/proto/keeper.proto.
+type EntityListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Etype    string `protobuf:"bytes,1,opt,name=etype,proto3" json:"etype,omitempty"`                        // тип сущности: card, text, logopas, binary и т.д. Пустая строка при фильтре по папке или тегу - все типы
+	FolderId int32  `protobuf:"varint,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // фильтр по папке: 0 - без фильтра, -1 - только сущности вне папок
+	Tag      string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`                            // фильтр по тегу: токен слепого индекса тега, пустая строка - без фильтра
+	Favorite bool   `protobuf:"varint,4,opt,name=favorite,proto3" json:"favorite,omitempty"`                 // только избранные сущности
+	Recent   int32  `protobuf:"varint,5,opt,name=recent,proto3" json:"recent,omitempty"`                     // только N последних открытых сущностей, 0 - без фильтра
+}
+
+func (x *EntityListRequest) Reset() {
+	*x = EntityListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityListRequest) ProtoMessage() {}
+
+func (x *EntityListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityListRequest.ProtoReflect.Descriptor instead.
+func (*EntityListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EntityListRequest) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *EntityListRequest) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *EntityListRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *EntityListRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *EntityListRequest) GetRecent() int32 {
+	if x != nil {
+		return x.Recent
+	}
+	return 0
+}
+
+// Отметки сущности в списке: избранное и время последнего получения
+type EntityMark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // ID сущности
+	Etype      string `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                              // тип сущности
+	Favorite   bool   `protobuf:"varint,3,opt,name=favorite,proto3" json:"favorite,omitempty"`                       // сущность в избранном
+	AccessedAt int64  `protobuf:"varint,4,opt,name=accessed_at,json=accessedAt,proto3" json:"accessed_at,omitempty"` // время последнего получения сущности (unix timestamp), 0 - не открывалась
+}
+
+func (x *EntityMark) Reset() {
+	*x = EntityMark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityMark) ProtoMessage() {}
+
+func (x *EntityMark) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityMark.ProtoReflect.Descriptor instead.
+func (*EntityMark) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EntityMark) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EntityMark) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *EntityMark) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *EntityMark) GetAccessedAt() int64 {
+	if x != nil {
+		return x.AccessedAt
+	}
+	return 0
+}
+
+// Ответ на запрос получения списка сущностей пользователя определенного типа
+// Устарело: используйте ListEntities
+//
+// Deprecated: Marked as deprecated in internal/proto/keeper.proto.
+type EntityListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List  map[int32]string `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // карта (код_сущности:строка_с_описанием)
+	Marks []*EntityMark    `protobuf:"bytes,2,rep,name=marks,proto3" json:"marks,omitempty"`                                                                                        // отметки сущностей списка: по возрастанию ID, при фильтре recent - от последней открытой
+}
+
+func (x *EntityListResponse) Reset() {
+	*x = EntityListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityListResponse) ProtoMessage() {}
+
+func (x *EntityListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityListResponse.ProtoReflect.Descriptor instead.
+func (*EntityListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EntityListResponse) GetList() map[int32]string {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *EntityListResponse) GetMarks() []*EntityMark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+// Элемент списка сущностей
+type EntitySummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                   // ID сущности
+	Etype      string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                              // тип сущности: card, text, logopas, binary и т.д.
+	Metainfo   []*Metainfo `protobuf:"bytes,3,rep,name=metainfo,proto3" json:"metainfo,omitempty"`                        // массив значений метаинформации (зашифрованы)
+	FolderId   int32       `protobuf:"varint,4,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`       // ID папки, 0 - вне папок
+	Favorite   bool        `protobuf:"varint,5,opt,name=favorite,proto3" json:"favorite,omitempty"`                       // сущность в избранном
+	CreatedAt  int64       `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // время создания (unix timestamp)
+	UpdatedAt  int64       `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`    // время последнего изменения (unix timestamp), 0 - не изменялась
+	AccessedAt int64       `protobuf:"varint,8,opt,name=accessed_at,json=accessedAt,proto3" json:"accessed_at,omitempty"` // время последнего получения (unix timestamp), 0 - не открывалась
+	Revision   int64       `protobuf:"varint,9,opt,name=revision,proto3" json:"revision,omitempty"`                       // номер ревизии, увеличивается при каждом сохранении изменений
+}
+
+func (x *EntitySummary) Reset() {
+	*x = EntitySummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntitySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntitySummary) ProtoMessage() {}
+
+func (x *EntitySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntitySummary.ProtoReflect.Descriptor instead.
+func (*EntitySummary) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EntitySummary) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EntitySummary) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *EntitySummary) GetMetainfo() []*Metainfo {
+	if x != nil {
+		return x.Metainfo
+	}
+	return nil
+}
+
+func (x *EntitySummary) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *EntitySummary) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *EntitySummary) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EntitySummary) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *EntitySummary) GetAccessedAt() int64 {
+	if x != nil {
+		return x.AccessedAt
+	}
+	return 0
+}
+
+func (x *EntitySummary) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// Запрос страницы списка сущностей пользователя
+type ListEntitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Etypes     []string   `protobuf:"bytes,1,rep,name=etypes,proto3" json:"etypes,omitempty"`                        // типы сущностей, пустой - все типы
+	FolderId   int32      `protobuf:"varint,2,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"`   // фильтр по папке: 0 - без фильтра, -1 - только сущности вне папок
+	Tag        string     `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`                              // фильтр по тегу: токен слепого индекса тега, пустая строка - без фильтра
+	Favorite   bool       `protobuf:"varint,4,opt,name=favorite,proto3" json:"favorite,omitempty"`                   // только избранные сущности
+	Sort       EntitySort `protobuf:"varint,5,opt,name=sort,proto3,enum=proto.EntitySort" json:"sort,omitempty"`     // порядок сортировки
+	Descending bool       `protobuf:"varint,6,opt,name=descending,proto3" json:"descending,omitempty"`               // сортировка по убыванию
+	PageSize   int32      `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // размер страницы, 0 - размер по умолчанию
+	PageToken  string     `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // токен страницы из предыдущего ответа, пустая строка - первая страница
+}
+
+func (x *ListEntitiesRequest) Reset() {
+	*x = ListEntitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntitiesRequest) ProtoMessage() {}
+
+func (x *ListEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListEntitiesRequest) GetEtypes() []string {
+	if x != nil {
+		return x.Etypes
+	}
+	return nil
+}
+
+func (x *ListEntitiesRequest) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *ListEntitiesRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListEntitiesRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *ListEntitiesRequest) GetSort() EntitySort {
+	if x != nil {
+		return x.Sort
+	}
+	return EntitySort_ENTITY_SORT_ID
+}
+
+func (x *ListEntitiesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListEntitiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEntitiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Страница списка сущностей пользователя
+type ListEntitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entities      []*EntitySummary `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`                                  // сущности страницы
+	NextPageToken string           `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // токен следующей страницы, пустая строка - страница последняя
+}
+
+func (x *ListEntitiesResponse) Reset() {
+	*x = ListEntitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntitiesResponse) ProtoMessage() {}
+
+func (x *ListEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListEntitiesResponse) GetEntities() []*EntitySummary {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *ListEntitiesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Запрос на добавление сущности в избранное или удаление из него
+type SetFavoriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`             // ID сущности
+	Favorite bool  `protobuf:"varint,2,opt,name=favorite,proto3" json:"favorite,omitempty"` // true - добавить в избранное, false - убрать
+}
+
+func (x *SetFavoriteRequest) Reset() {
+	*x = SetFavoriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteRequest) ProtoMessage() {}
+
+func (x *SetFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*SetFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetFavoriteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetFavoriteRequest) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+// Ответ на запрос изменения избранного
+type SetFavoriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *SetFavoriteResponse) Reset() {
+	*x = SetFavoriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteResponse) ProtoMessage() {}
+
+func (x *SetFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*SetFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetFavoriteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Сущность, находящаяся в корзине
+type TrashItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // ID сущности
+	Etype     string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`                           // тип сущности: card, text, logopas, binary и т.д.
+	Metainfo  []*Metainfo `protobuf:"bytes,3,rep,name=metainfo,proto3" json:"metainfo,omitempty"`                     // массив значений метаинформации
+	DeletedAt int64       `protobuf:"varint,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // время удаления (unix timestamp)
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TrashItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrashItem) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *TrashItem) GetMetainfo() []*Metainfo {
+	if x != nil {
+		return x.Metainfo
+	}
+	return nil
+}
+
+func (x *TrashItem) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+// Запрос содержимого корзины пользователя
+type ListTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{41}
+}
+
+// Ответ на запрос содержимого корзины пользователя
+type ListTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*TrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // массив удаленных сущностей
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Запрос на восстановление сущности из корзины
+type RestoreEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // идентификатор сущности
+}
+
+func (x *RestoreEntityRequest) Reset() {
+	*x = RestoreEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEntityRequest) ProtoMessage() {}
+
+func (x *RestoreEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEntityRequest.ProtoReflect.Descriptor instead.
+func (*RestoreEntityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RestoreEntityRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос на восстановление сущности из корзины
+type RestoreEntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *RestoreEntityResponse) Reset() {
+	*x = RestoreEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreEntityResponse) ProtoMessage() {}
+
+func (x *RestoreEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreEntityResponse.ProtoReflect.Descriptor instead.
+func (*RestoreEntityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RestoreEntityResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Запрос на окончательное удаление сущности из корзины
+type PurgeEntityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // идентификатор сущности
+}
+
+func (x *PurgeEntityRequest) Reset() {
+	*x = PurgeEntityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeEntityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEntityRequest) ProtoMessage() {}
+
+func (x *PurgeEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEntityRequest.ProtoReflect.Descriptor instead.
+func (*PurgeEntityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PurgeEntityRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос на окончательное удаление сущности из корзины
+type PurgeEntityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *PurgeEntityResponse) Reset() {
+	*x = PurgeEntityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeEntityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEntityResponse) ProtoMessage() {}
+
+func (x *PurgeEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEntityResponse.ProtoReflect.Descriptor instead.
+func (*PurgeEntityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PurgeEntityResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Папка (названия зашифрованы)
+type Folder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID папки
+	ParentId int32  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // ID родительской папки, 0 - папка верхнего уровня
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                          // название папки (зашифровано)
+}
+
+func (x *Folder) Reset() {
+	*x = Folder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Folder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Folder) ProtoMessage() {}
+
+func (x *Folder) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Folder.ProtoReflect.Descriptor instead.
+func (*Folder) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Folder) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Folder) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Folder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Запрос дерева папок пользователя
+type FoldersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FoldersRequest) Reset() {
+	*x = FoldersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoldersRequest) ProtoMessage() {}
+
+func (x *FoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoldersRequest.ProtoReflect.Descriptor instead.
+func (*FoldersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{48}
+}
+
+// Ответ на запрос дерева папок пользователя
+type FoldersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folders []*Folder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"` // все папки пользователя
+}
+
+func (x *FoldersResponse) Reset() {
+	*x = FoldersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoldersResponse) ProtoMessage() {}
+
+func (x *FoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoldersResponse.ProtoReflect.Descriptor instead.
+func (*FoldersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FoldersResponse) GetFolders() []*Folder {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+// Запрос на создание папки
+type CreateFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentId int32  `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // ID родительской папки, 0 - папка верхнего уровня
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                          // название папки (зашифровано)
+}
+
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderRequest) ProtoMessage() {}
+
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateFolderRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Ответ на запрос на создание папки
+type CreateFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`      // ID созданной папки
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *CreateFolderResponse) Reset() {
+	*x = CreateFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderResponse) ProtoMessage() {}
+
+func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateFolderResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateFolderResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateFolderResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Запрос на изменение папки (переименование, перемещение)
+type UpdateFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                             // ID папки
+	ParentId int32  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // ID новой родительской папки, 0 - папка верхнего уровня
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                          // новое название папки (зашифровано)
+}
+
+func (x *UpdateFolderRequest) Reset() {
+	*x = UpdateFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFolderRequest) ProtoMessage() {}
+
+func (x *UpdateFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFolderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFolderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateFolderRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateFolderRequest) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateFolderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Ответ на запрос на изменение папки
+type UpdateFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *UpdateFolderResponse) Reset() {
+	*x = UpdateFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFolderResponse) ProtoMessage() {}
+
+func (x *UpdateFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFolderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFolderResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateFolderResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Запрос на удаление папки, вложенные папки и сущности переносятся в родительскую
+type DeleteFolderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID папки
+}
+
+func (x *DeleteFolderRequest) Reset() {
+	*x = DeleteFolderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFolderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderRequest) ProtoMessage() {}
+
+func (x *DeleteFolderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteFolderRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос на удаление папки
+type DeleteFolderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *DeleteFolderResponse) Reset() {
+	*x = DeleteFolderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFolderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderResponse) ProtoMessage() {}
+
+func (x *DeleteFolderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFolderResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteFolderResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Запрос поиска сущностей по токенам слепого индекса
+type SearchEntitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []string `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"` // токены, все они должны присутствовать у найденной сущности
+	Etype  string   `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`   // тип сущности, пустая строка - все типы
+}
+
+func (x *SearchEntitiesRequest) Reset() {
+	*x = SearchEntitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntitiesRequest) ProtoMessage() {}
+
+func (x *SearchEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*SearchEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SearchEntitiesRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *SearchEntitiesRequest) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+// Найденная сущность
+type FoundEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`            // ID сущности
+	Etype    string      `protobuf:"bytes,2,opt,name=etype,proto3" json:"etype,omitempty"`       // тип сущности: card, text, logopas, binary и т.д.
+	Metainfo []*Metainfo `protobuf:"bytes,3,rep,name=metainfo,proto3" json:"metainfo,omitempty"` // массив значений метаинформации (зашифрованы)
+}
+
+func (x *FoundEntity) Reset() {
+	*x = FoundEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoundEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoundEntity) ProtoMessage() {}
+
+func (x *FoundEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoundEntity.ProtoReflect.Descriptor instead.
+func (*FoundEntity) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FoundEntity) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FoundEntity) GetEtype() string {
+	if x != nil {
+		return x.Etype
+	}
+	return ""
+}
+
+func (x *FoundEntity) GetMetainfo() []*Metainfo {
+	if x != nil {
+		return x.Metainfo
+	}
+	return nil
+}
+
+// Ответ на запрос поиска сущностей
+type SearchEntitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*FoundEntity `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // найденные сущности
+}
+
+func (x *SearchEntitiesResponse) Reset() {
+	*x = SearchEntitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEntitiesResponse) ProtoMessage() {}
+
+func (x *SearchEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*SearchEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchEntitiesResponse) GetItems() []*FoundEntity {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Вложение сущности (имя файла и контрольная сумма зашифрованы клиентом)
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // ID вложения
+	EntityId  int32  `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`    // ID сущности
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                             // имя файла (зашифровано)
+	Size      int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`                            // размер исходного файла в байтах
+	Digest    string `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"`                         // SHA-256 исходного файла (зашифрован)
+	CreatedAt int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // время добавления (unix timestamp)
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Attachment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetEntityId() int32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *Attachment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Загрузка вложения на сервер: первое сообщение потока содержит описание вложения (id не указывается),
+// остальные - фрагменты зашифрованных данных
+type AddAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*AddAttachmentRequest_Info
+	//	*AddAttachmentRequest_ChunkData
+	Data isAddAttachmentRequest_Data `protobuf_oneof:"data"`
+}
+
+func (x *AddAttachmentRequest) Reset() {
+	*x = AddAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttachmentRequest) ProtoMessage() {}
+
+func (x *AddAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*AddAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{60}
+}
+
+func (m *AddAttachmentRequest) GetData() isAddAttachmentRequest_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *AddAttachmentRequest) GetInfo() *Attachment {
+	if x, ok := x.GetData().(*AddAttachmentRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *AddAttachmentRequest) GetChunkData() []byte {
+	if x, ok := x.GetData().(*AddAttachmentRequest_ChunkData); ok {
+		return x.ChunkData
+	}
+	return nil
+}
+
+type isAddAttachmentRequest_Data interface {
+	isAddAttachmentRequest_Data()
+}
+
+type AddAttachmentRequest_Info struct {
+	Info *Attachment `protobuf:"bytes,1,opt,name=info,proto3,oneof"` // описание вложения
+}
+
+type AddAttachmentRequest_ChunkData struct {
+	ChunkData []byte `protobuf:"bytes,2,opt,name=chunk_data,json=chunkData,proto3,oneof"` // фрагмент зашифрованных данных
+}
+
+func (*AddAttachmentRequest_Info) isAddAttachmentRequest_Data() {}
+
+func (*AddAttachmentRequest_ChunkData) isAddAttachmentRequest_Data() {}
+
+// Ответ на загрузку вложения
+type AddAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`     // ID созданного вложения
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"` // размер принятых зашифрованных данных
+}
+
+func (x *AddAttachmentResponse) Reset() {
+	*x = AddAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAttachmentResponse) ProtoMessage() {}
+
+func (x *AddAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*AddAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AddAttachmentResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddAttachmentResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// Запрос списка вложений сущности
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId int32 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"` // ID сущности
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListAttachmentsRequest) GetEntityId() int32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+// Ответ на запрос списка вложений сущности
+type ListAttachmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"` // вложения в порядке добавления
+}
+
+func (x *ListAttachmentsResponse) Reset() {
+	*x = ListAttachmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttachmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAttachmentsResponse) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+// Загрузка вложения с сервера, данные передаются потоком DownloadBinResponse
+type DownloadAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID вложения
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DownloadAttachmentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Запрос на удаление вложения
+type DeleteAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID вложения
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteAttachmentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Ответ на запрос на удаление вложения
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"` // если возникла ошибка - описание ошибки, иначе - пустая строка
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_keeper_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_keeper_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteAttachmentResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_internal_proto_keeper_proto protoreflect.FileDescriptor
+
+var file_internal_proto_keeper_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x27, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a,
+	0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x6a, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x22, 0x14, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x22, 0x69, 0x0a, 0x17, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x18, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x19, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf9, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x25, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x56, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x08, 0x4d,
+	0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xde, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x72,
+	0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70,
+	0x73, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1f,
+	0x0a, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x22, 0x39, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xdf, 0x01, 0x0a, 0x11,
+	0x53, 0x61, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x2b,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66,
+	0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x6c, 0x69, 0x6e, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x0a,
+	0x12, 0x53, 0x61, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4e, 0x0a, 0x10, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x11, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x75,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x22,
+	0x8e, 0x02, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x12,
+	0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x69, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x22, 0x90,
+	0x01, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76,
+	0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x3a, 0x02, 0x18,
+	0x01, 0x22, 0x6f, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x12, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x05, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x96, 0x02, 0x0a, 0x0d, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x69,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xfb, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73,
+	0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x70, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x40, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x7d, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x26, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x24, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a,
+	0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x06, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x0a, 0x0f, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x73, 0x22, 0x46, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x56, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x2c, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x22, 0x60, 0x0a, 0x0b, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x69,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x42, 0x0a,
+	0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x6f, 0x75, 0x6e, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x98, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x68, 0x0a, 0x14,
+	0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a,
+	0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x42, 0x06,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x35, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x30, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x2a, 0x6c, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x54, 0x49,
+	0x54, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x03, 0x32, 0xb8, 0x11, 0x0a, 0x06, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x49, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x12, 0x35, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x47, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01,
+	0x12, 0x50, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x10, 0x5a,
+	0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_proto_keeper_proto_rawDescOnce sync.Once
+	file_internal_proto_keeper_proto_rawDescData = file_internal_proto_keeper_proto_rawDesc
+)
+
+func file_internal_proto_keeper_proto_rawDescGZIP() []byte {
+	file_internal_proto_keeper_proto_rawDescOnce.Do(func() {
+		file_internal_proto_keeper_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_proto_keeper_proto_rawDescData)
+	})
+	return file_internal_proto_keeper_proto_rawDescData
+}
+
+var file_internal_proto_keeper_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_internal_proto_keeper_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_internal_proto_keeper_proto_goTypes = []any{
+	(EntitySort)(0),                   // 0: proto.EntitySort
+	(*PingRequest)(nil),               // 1: proto.PingRequest
+	(*PingResponse)(nil),              // 2: proto.PingResponse
+	(*RegisterRequest)(nil),           // 3: proto.RegisterRequest
+	(*RegisterResponse)(nil),          // 4: proto.RegisterResponse
+	(*LoginRequest)(nil),              // 5: proto.LoginRequest
+	(*LoginResponse)(nil),             // 6: proto.LoginResponse
+	(*EntityCode)(nil),                // 7: proto.EntityCode
+	(*EntityCodesRequest)(nil),        // 8: proto.EntityCodesRequest
+	(*EntityCodesResponse)(nil),       // 9: proto.EntityCodesResponse
+	(*CreateEntityTypeRequest)(nil),   // 10: proto.CreateEntityTypeRequest
+	(*CreateEntityTypeResponse)(nil),  // 11: proto.CreateEntityTypeResponse
+	(*UpdateEntityTypeRequest)(nil),   // 12: proto.UpdateEntityTypeRequest
+	(*UpdateEntityTypeResponse)(nil),  // 13: proto.UpdateEntityTypeResponse
+	(*ArchiveEntityTypeRequest)(nil),  // 14: proto.ArchiveEntityTypeRequest
+	(*ArchiveEntityTypeResponse)(nil), // 15: proto.ArchiveEntityTypeResponse
+	(*Field)(nil),                     // 16: proto.Field
+	(*FieldsRequest)(nil),             // 17: proto.FieldsRequest
+	(*FieldsResponse)(nil),            // 18: proto.FieldsResponse
+	(*Property)(nil),                  // 19: proto.Property
+	(*Metainfo)(nil),                  // 20: proto.Metainfo
+	(*AddEntityRequest)(nil),          // 21: proto.AddEntityRequest
+	(*AddEntityResponse)(nil),         // 22: proto.AddEntityResponse
+	(*SaveEntityRequest)(nil),         // 23: proto.SaveEntityRequest
+	(*SaveEntityResponse)(nil),        // 24: proto.SaveEntityResponse
+	(*UploadBinRequest)(nil),          // 25: proto.UploadBinRequest
+	(*UploadBinResponse)(nil),         // 26: proto.UploadBinResponse
+	(*EntityRequest)(nil),             // 27: proto.EntityRequest
+	(*EntityResponse)(nil),            // 28: proto.EntityResponse
+	(*DeleteEntityRequest)(nil),       // 29: proto.DeleteEntityRequest
+	(*DeleteEntityResponse)(nil),      // 30: proto.DeleteEntityResponse
+	(*DownloadBinRequest)(nil),        // 31: proto.DownloadBinRequest
+	(*DownloadBinResponse)(nil),       // 32: proto.DownloadBinResponse
+	(*EntityListRequest)(nil),         // 33: proto.EntityListRequest
+	(*EntityMark)(nil),                // 34: proto.EntityMark
+	(*EntityListResponse)(nil),        // 35: proto.EntityListResponse
+	(*EntitySummary)(nil),             // 36: proto.EntitySummary
+	(*ListEntitiesRequest)(nil),       // 37: proto.ListEntitiesRequest
+	(*ListEntitiesResponse)(nil),      // 38: proto.ListEntitiesResponse
+	(*SetFavoriteRequest)(nil),        // 39: proto.SetFavoriteRequest
+	(*SetFavoriteResponse)(nil),       // 40: proto.SetFavoriteResponse
+	(*TrashItem)(nil),                 // 41: proto.TrashItem
+	(*ListTrashRequest)(nil),          // 42: proto.ListTrashRequest
+	(*ListTrashResponse)(nil),         // 43: proto.ListTrashResponse
+	(*RestoreEntityRequest)(nil),      // 44: proto.RestoreEntityRequest
+	(*RestoreEntityResponse)(nil),     // 45: proto.RestoreEntityResponse
+	(*PurgeEntityRequest)(nil),        // 46: proto.PurgeEntityRequest
+	(*PurgeEntityResponse)(nil),       // 47: proto.PurgeEntityResponse
+	(*Folder)(nil),                    // 48: proto.Folder
+	(*FoldersRequest)(nil),            // 49: proto.FoldersRequest
+	(*FoldersResponse)(nil),           // 50: proto.FoldersResponse
+	(*CreateFolderRequest)(nil),       // 51: proto.CreateFolderRequest
+	(*CreateFolderResponse)(nil),      // 52: proto.CreateFolderResponse
+	(*UpdateFolderRequest)(nil),       // 53: proto.UpdateFolderRequest
+	(*UpdateFolderResponse)(nil),      // 54: proto.UpdateFolderResponse
+	(*DeleteFolderRequest)(nil),       // 55: proto.DeleteFolderRequest
+	(*DeleteFolderResponse)(nil),      // 56: proto.DeleteFolderResponse
+	(*SearchEntitiesRequest)(nil),     // 57: proto.SearchEntitiesRequest
+	(*FoundEntity)(nil),               // 58: proto.FoundEntity
+	(*SearchEntitiesResponse)(nil),    // 59: proto.SearchEntitiesResponse
+	(*Attachment)(nil),                // 60: proto.Attachment
+	(*AddAttachmentRequest)(nil),      // 61: proto.AddAttachmentRequest
+	(*AddAttachmentResponse)(nil),     // 62: proto.AddAttachmentResponse
+	(*ListAttachmentsRequest)(nil),    // 63: proto.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),   // 64: proto.ListAttachmentsResponse
+	(*DownloadAttachmentRequest)(nil), // 65: proto.DownloadAttachmentRequest
+	(*DeleteAttachmentRequest)(nil),   // 66: proto.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),  // 67: proto.DeleteAttachmentResponse
+	nil,                               // 68: proto.EntityListResponse.ListEntry
+}
+var file_internal_proto_keeper_proto_depIdxs = []int32{
+	7,  // 0: proto.EntityCodesResponse.entity_codes:type_name -> proto.EntityCode
+	16, // 1: proto.CreateEntityTypeRequest.fields:type_name -> proto.Field
+	16, // 2: proto.UpdateEntityTypeRequest.fields:type_name -> proto.Field
+	16, // 3: proto.FieldsResponse.fields:type_name -> proto.Field
+	19, // 4: proto.AddEntityRequest.props:type_name -> proto.Property
+	20, // 5: proto.AddEntityRequest.metainfo:type_name -> proto.Metainfo
+	19, // 6: proto.SaveEntityRequest.props:type_name -> proto.Property
+	20, // 7: proto.SaveEntityRequest.metainfo:type_name -> proto.Metainfo
+	19, // 8: proto.EntityResponse.props:type_name -> proto.Property
+	20, // 9: proto.EntityResponse.metainfo:type_name -> proto.Metainfo
+	68, // 10: proto.EntityListResponse.list:type_name -> proto.EntityListResponse.ListEntry
+	34, // 11: proto.EntityListResponse.marks:type_name -> proto.EntityMark
+	20, // 12: proto.EntitySummary.metainfo:type_name -> proto.Metainfo
+	0,  // 13: proto.ListEntitiesRequest.sort:type_name -> proto.EntitySort
+	36, // 14: proto.ListEntitiesResponse.entities:type_name -> proto.EntitySummary
+	20, // 15: proto.TrashItem.metainfo:type_name -> proto.Metainfo
+	41, // 16: proto.ListTrashResponse.items:type_name -> proto.TrashItem
+	48, // 17: proto.FoldersResponse.folders:type_name -> proto.Folder
+	20, // 18: proto.FoundEntity.metainfo:type_name -> proto.Metainfo
+	58, // 19: proto.SearchEntitiesResponse.items:type_name -> proto.FoundEntity
+	60, // 20: proto.AddAttachmentRequest.info:type_name -> proto.Attachment
+	60, // 21: proto.ListAttachmentsResponse.attachments:type_name -> proto.Attachment
+	1,  // 22: proto.Keeper.Ping:input_type -> proto.PingRequest
+	3,  // 23: proto.Keeper.Registration:input_type -> proto.RegisterRequest
+	5,  // 24: proto.Keeper.Login:input_type -> proto.LoginRequest
+	8,  // 25: proto.Keeper.EntityCodes:input_type -> proto.EntityCodesRequest
+	17, // 26: proto.Keeper.Fields:input_type -> proto.FieldsRequest
+	10, // 27: proto.Keeper.CreateEntityType:input_type -> proto.CreateEntityTypeRequest
+	12, // 28: proto.Keeper.UpdateEntityType:input_type -> proto.UpdateEntityTypeRequest
+	14, // 29: proto.Keeper.ArchiveEntityType:input_type -> proto.ArchiveEntityTypeRequest
+	21, // 30: proto.Keeper.AddEntity:input_type -> proto.AddEntityRequest
+	23, // 31: proto.Keeper.SaveEditEntity:input_type -> proto.SaveEntityRequest
+	29, // 32: proto.Keeper.DeleteEntity:input_type -> proto.DeleteEntityRequest
+	25, // 33: proto.Keeper.UploadBinary:input_type -> proto.UploadBinRequest
+	25, // 34: proto.Keeper.UploadCryptoBinary:input_type -> proto.UploadBinRequest
+	27, // 35: proto.Keeper.Entity:input_type -> proto.EntityRequest
+	31, // 36: proto.Keeper.DownloadBinary:input_type -> proto.DownloadBinRequest
+	31, // 37: proto.Keeper.DownloadCryptoBinary:input_type -> proto.DownloadBinRequest
+	33, // 38: proto.Keeper.EntityList:input_type -> proto.EntityListRequest
+	37, // 39: proto.Keeper.ListEntities:input_type -> proto.ListEntitiesRequest
+	57, // 40: proto.Keeper.SearchEntities:input_type -> proto.SearchEntitiesRequest
+	39, // 41: proto.Keeper.SetFavorite:input_type -> proto.SetFavoriteRequest
+	49, // 42: proto.Keeper.Folders:input_type -> proto.FoldersRequest
+	51, // 43: proto.Keeper.CreateFolder:input_type -> proto.CreateFolderRequest
+	53, // 44: proto.Keeper.UpdateFolder:input_type -> proto.UpdateFolderRequest
+	55, // 45: proto.Keeper.DeleteFolder:input_type -> proto.DeleteFolderRequest
+	42, // 46: proto.Keeper.ListTrash:input_type -> proto.ListTrashRequest
+	44, // 47: proto.Keeper.RestoreEntity:input_type -> proto.RestoreEntityRequest
+	46, // 48: proto.Keeper.PurgeEntity:input_type -> proto.PurgeEntityRequest
+	61, // 49: proto.Keeper.AddAttachment:input_type -> proto.AddAttachmentRequest
+	63, // 50: proto.Keeper.ListAttachments:input_type -> proto.ListAttachmentsRequest
+	65, // 51: proto.Keeper.DownloadAttachment:input_type -> proto.DownloadAttachmentRequest
+	66, // 52: proto.Keeper.DeleteAttachment:input_type -> proto.DeleteAttachmentRequest
+	2,  // 53: proto.Keeper.Ping:output_type -> proto.PingResponse
+	4,  // 54: proto.Keeper.Registration:output_type -> proto.RegisterResponse
+	6,  // 55: proto.Keeper.Login:output_type -> proto.LoginResponse
+	9,  // 56: proto.Keeper.EntityCodes:output_type -> proto.EntityCodesResponse
+	18, // 57: proto.Keeper.Fields:output_type -> proto.FieldsResponse
+	11, // 58: proto.Keeper.CreateEntityType:output_type -> proto.CreateEntityTypeResponse
+	13, // 59: proto.Keeper.UpdateEntityType:output_type -> proto.UpdateEntityTypeResponse
+	15, // 60: proto.Keeper.ArchiveEntityType:output_type -> proto.ArchiveEntityTypeResponse
+	22, // 61: proto.Keeper.AddEntity:output_type -> proto.AddEntityResponse
+	24, // 62: proto.Keeper.SaveEditEntity:output_type -> proto.SaveEntityResponse
+	30, // 63: proto.Keeper.DeleteEntity:output_type -> proto.DeleteEntityResponse
+	26, // 64: proto.Keeper.UploadBinary:output_type -> proto.UploadBinResponse
+	26, // 65: proto.Keeper.UploadCryptoBinary:output_type -> proto.UploadBinResponse
+	28, // 66: proto.Keeper.Entity:output_type -> proto.EntityResponse
+	32, // 67: proto.Keeper.DownloadBinary:output_type -> proto.DownloadBinResponse
+	32, // 68: proto.Keeper.DownloadCryptoBinary:output_type -> proto.DownloadBinResponse
+	35, // 69: proto.Keeper.EntityList:output_type -> proto.EntityListResponse
+	38, // 70: proto.Keeper.ListEntities:output_type -> proto.ListEntitiesResponse
+	59, // 71: proto.Keeper.SearchEntities:output_type -> proto.SearchEntitiesResponse
+	40, // 72: proto.Keeper.SetFavorite:output_type -> proto.SetFavoriteResponse
+	50, // 73: proto.Keeper.Folders:output_type -> proto.FoldersResponse
+	52, // 74: proto.Keeper.CreateFolder:output_type -> proto.CreateFolderResponse
+	54, // 75: proto.Keeper.UpdateFolder:output_type -> proto.UpdateFolderResponse
+	56, // 76: proto.Keeper.DeleteFolder:output_type -> proto.DeleteFolderResponse
+	43, // 77: proto.Keeper.ListTrash:output_type -> proto.ListTrashResponse
+	45, // 78: proto.Keeper.RestoreEntity:output_type -> proto.RestoreEntityResponse
+	47, // 79: proto.Keeper.PurgeEntity:output_type -> proto.PurgeEntityResponse
+	62, // 80: proto.Keeper.AddAttachment:output_type -> proto.AddAttachmentResponse
+	64, // 81: proto.Keeper.ListAttachments:output_type -> proto.ListAttachmentsResponse
+	32, // 82: proto.Keeper.DownloadAttachment:output_type -> proto.DownloadBinResponse
+	67, // 83: proto.Keeper.DeleteAttachment:output_type -> proto.DeleteAttachmentResponse
+	53, // [53:84] is the sub-list for method output_type
+	22, // [22:53] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_internal_proto_keeper_proto_init() }
+func file_internal_proto_keeper_proto_init() {
+	if File_internal_proto_keeper_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_proto_keeper_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityCodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityCodesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateEntityTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateEntityTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateEntityTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateEntityTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ArchiveEntityTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ArchiveEntityTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*Field); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*FieldsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*FieldsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*Property); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*Metainfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*AddEntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*AddEntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveEntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveEntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadBinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadBinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteEntityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteEntityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadBinRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadBinResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityMark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*EntityListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*EntitySummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use EntityListRequest.ProtoReflect.Descriptor instead.
-func (*EntityListRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *EntityListRequest) GetEtype() string {
-	if x != nil {
-		return x.Etype
-	}
-	return ""
-}
-
-type EntityListResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	List map[int32]string `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // карта: код_сущности:строка_с_описанием
-}
-
-func (x *EntityListResponse) Reset() {
-	*x = EntityListResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_keeper_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *EntityListResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EntityListResponse) ProtoMessage() {}
-
-func (x *EntityListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_keeper_proto_msgTypes[27]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_internal_proto_keeper_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEntitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use EntityListResponse.ProtoReflect.Descriptor instead.
-func (*EntityListResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_keeper_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *EntityListResponse) GetList() map[int32]string {
-	if x != nil {
-		return x.List
-	}
-	return nil
-}
-
-var File_internal_proto_keeper_proto protoreflect.FileDescriptor
-
-var file_internal_proto_keeper_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x27, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a,
-	0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
-	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x27, 0x0a, 0x0f,
-	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x3b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x14, 0x0a, 0x12,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x4b, 0x0a, 0x13, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22,
-	0xab, 0x01, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x66, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x25, 0x0a,
-	0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x56, 0x0a, 0x08,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x0a, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f,
-	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65,
-	0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x39, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25,
-	0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x05,
-	0x70, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x4d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e,
-	0x66, 0x6f, 0x22, 0x3a, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4e,
-	0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x22, 0x3d,
-	0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x1f, 0x0a,
-	0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa0,
-	0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x2b,
-	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66,
-	0x6f, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x22,
-	0x29, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x74, 0x79, 0x70, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x37, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x32, 0xa1, 0x07, 0x0a, 0x06, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x12, 0x2f,
-	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3f, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x64, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x45, 0x0a, 0x0e, 0x53, 0x61, 0x76, 0x65, 0x45, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x43, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x42, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x49, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
-	0x01, 0x12, 0x35, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x14, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x10, 0x5a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-}
-
-var (
-	file_internal_proto_keeper_proto_rawDescOnce sync.Once
-	file_internal_proto_keeper_proto_rawDescData = file_internal_proto_keeper_proto_rawDesc
-)
-
-func file_internal_proto_keeper_proto_rawDescGZIP() []byte {
-	file_internal_proto_keeper_proto_rawDescOnce.Do(func() {
-		file_internal_proto_keeper_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_proto_keeper_proto_rawDescData)
-	})
-	return file_internal_proto_keeper_proto_rawDescData
-}
-
-var file_internal_proto_keeper_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
-var file_internal_proto_keeper_proto_goTypes = []interface{}{
-	(*PingRequest)(nil),          // 0: proto.PingRequest
-	(*PingResponse)(nil),         // 1: proto.PingResponse
-	(*RegisterRequest)(nil),      // 2: proto.RegisterRequest
-	(*RegisterResponse)(nil),     // 3: proto.RegisterResponse
-	(*LoginRequest)(nil),         // 4: proto.LoginRequest
-	(*LoginResponse)(nil),        // 5: proto.LoginResponse
-	(*EntityCode)(nil),           // 6: proto.EntityCode
-	(*EntityCodesRequest)(nil),   // 7: proto.EntityCodesRequest
-	(*EntityCodesResponse)(nil),  // 8: proto.EntityCodesResponse
-	(*Field)(nil),                // 9: proto.Field
-	(*FieldsRequest)(nil),        // 10: proto.FieldsRequest
-	(*FieldsResponse)(nil),       // 11: proto.FieldsResponse
-	(*Property)(nil),             // 12: proto.Property
-	(*Metainfo)(nil),             // 13: proto.Metainfo
-	(*AddEntityRequest)(nil),     // 14: proto.AddEntityRequest
-	(*AddEntityResponse)(nil),    // 15: proto.AddEntityResponse
-	(*SaveEntityRequest)(nil),    // 16: proto.SaveEntityRequest
-	(*SaveEntityResponse)(nil),   // 17: proto.SaveEntityResponse
-	(*UploadBinRequest)(nil),     // 18: proto.UploadBinRequest
-	(*UploadBinResponse)(nil),    // 19: proto.UploadBinResponse
-	(*EntityRequest)(nil),        // 20: proto.EntityRequest
-	(*EntityResponse)(nil),       // 21: proto.EntityResponse
-	(*DeleteEntityRequest)(nil),  // 22: proto.DeleteEntityRequest
-	(*DeleteEntityResponse)(nil), // 23: proto.DeleteEntityResponse
-	(*DownloadBinRequest)(nil),   // 24: proto.DownloadBinRequest
-	(*DownloadBinResponse)(nil),  // 25: proto.DownloadBinResponse
-	(*EntityListRequest)(nil),    // 26: proto.EntityListRequest
-	(*EntityListResponse)(nil),   // 27: proto.EntityListResponse
-	nil,                          // 28: proto.EntityListResponse.ListEntry
-}
-var file_internal_proto_keeper_proto_depIdxs = []int32{
-	6,  // 0: proto.EntityCodesResponse.entity_codes:type_name -> proto.EntityCode
-	9,  // 1: proto.FieldsResponse.fields:type_name -> proto.Field
-	12, // 2: proto.AddEntityRequest.props:type_name -> proto.Property
-	13, // 3: proto.AddEntityRequest.metainfo:type_name -> proto.Metainfo
-	12, // 4: proto.SaveEntityRequest.props:type_name -> proto.Property
-	13, // 5: proto.SaveEntityRequest.metainfo:type_name -> proto.Metainfo
-	12, // 6: proto.EntityResponse.props:type_name -> proto.Property
-	13, // 7: proto.EntityResponse.metainfo:type_name -> proto.Metainfo
-	28, // 8: proto.EntityListResponse.list:type_name -> proto.EntityListResponse.ListEntry
-	0,  // 9: proto.Keeper.Ping:input_type -> proto.PingRequest
-	2,  // 10: proto.Keeper.Registration:input_type -> proto.RegisterRequest
-	4,  // 11: proto.Keeper.Login:input_type -> proto.LoginRequest
-	7,  // 12: proto.Keeper.EntityCodes:input_type -> proto.EntityCodesRequest
-	10, // 13: proto.Keeper.Fields:input_type -> proto.FieldsRequest
-	14, // 14: proto.Keeper.AddEntity:input_type -> proto.AddEntityRequest
-	16, // 15: proto.Keeper.SaveEditEntity:input_type -> proto.SaveEntityRequest
-	22, // 16: proto.Keeper.DeleteEntity:input_type -> proto.DeleteEntityRequest
-	18, // 17: proto.Keeper.UploadBinary:input_type -> proto.UploadBinRequest
-	18, // 18: proto.Keeper.UploadCryptoBinary:input_type -> proto.UploadBinRequest
-	20, // 19: proto.Keeper.Entity:input_type -> proto.EntityRequest
-	24, // 20: proto.Keeper.DownloadBinary:input_type -> proto.DownloadBinRequest
-	24, // 21: proto.Keeper.DownloadCryptoBinary:input_type -> proto.DownloadBinRequest
-	26, // 22: proto.Keeper.EntityList:input_type -> proto.EntityListRequest
-	1,  // 23: proto.Keeper.Ping:output_type -> proto.PingResponse
-	3,  // 24: proto.Keeper.Registration:output_type -> proto.RegisterResponse
-	5,  // 25: proto.Keeper.Login:output_type -> proto.LoginResponse
-	8,  // 26: proto.Keeper.EntityCodes:output_type -> proto.EntityCodesResponse
-	11, // 27: proto.Keeper.Fields:output_type -> proto.FieldsResponse
-	15, // 28: proto.Keeper.AddEntity:output_type -> proto.AddEntityResponse
-	17, // 29: proto.Keeper.SaveEditEntity:output_type -> proto.SaveEntityResponse
-	23, // 30: proto.Keeper.DeleteEntity:output_type -> proto.DeleteEntityResponse
-	19, // 31: proto.Keeper.UploadBinary:output_type -> proto.UploadBinResponse
-	19, // 32: proto.Keeper.UploadCryptoBinary:output_type -> proto.UploadBinResponse
-	21, // 33: proto.Keeper.Entity:output_type -> proto.EntityResponse
-	25, // 34: proto.Keeper.DownloadBinary:output_type -> proto.DownloadBinResponse
-	25, // 35: proto.Keeper.DownloadCryptoBinary:output_type -> proto.DownloadBinResponse
-	27, // 36: proto.Keeper.EntityList:output_type -> proto.EntityListResponse
-	23, // [23:37] is the sub-list for method output_type
-	9,  // [9:23] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
-}
-
-func init() { file_internal_proto_keeper_proto_init() }
-func file_internal_proto_keeper_proto_init() {
-	if File_internal_proto_keeper_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_proto_keeper_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ListEntitiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1890,8 +5191,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*SetFavoriteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1902,8 +5203,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SetFavoriteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1914,8 +5215,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*TrashItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1926,8 +5227,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTrashRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1938,8 +5239,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ListTrashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1950,8 +5251,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityCode); i {
+		file_internal_proto_keeper_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreEntityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1962,8 +5263,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityCodesRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreEntityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1974,8 +5275,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityCodesResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeEntityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1986,8 +5287,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Field); i {
+		file_internal_proto_keeper_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeEntityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1998,8 +5299,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldsRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*Folder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2010,8 +5311,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldsResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*FoldersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2022,8 +5323,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Property); i {
+		file_internal_proto_keeper_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*FoldersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2034,8 +5335,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Metainfo); i {
+		file_internal_proto_keeper_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFolderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2046,8 +5347,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddEntityRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateFolderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2058,8 +5359,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddEntityResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFolderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2070,8 +5371,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveEntityRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateFolderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2082,8 +5383,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SaveEntityResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFolderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2094,8 +5395,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadBinRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteFolderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2106,8 +5407,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadBinResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchEntitiesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2118,8 +5419,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*FoundEntity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2130,8 +5431,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchEntitiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2142,8 +5443,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEntityRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*Attachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2154,8 +5455,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteEntityResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAttachmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2166,8 +5467,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadBinRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAttachmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2178,8 +5479,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadBinResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAttachmentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2190,8 +5491,8 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityListRequest); i {
+		file_internal_proto_keeper_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAttachmentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2202,8 +5503,32 @@ func file_internal_proto_keeper_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_keeper_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityListResponse); i {
+		file_internal_proto_keeper_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_keeper_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAttachmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2215,18 +5540,23 @@ func file_internal_proto_keeper_proto_init() {
 			}
 		}
 	}
+	file_internal_proto_keeper_proto_msgTypes[60].OneofWrappers = []any{
+		(*AddAttachmentRequest_Info)(nil),
+		(*AddAttachmentRequest_ChunkData)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_proto_keeper_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   29,
+			NumEnums:      1,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_internal_proto_keeper_proto_goTypes,
 		DependencyIndexes: file_internal_proto_keeper_proto_depIdxs,
+		EnumInfos:         file_internal_proto_keeper_proto_enumTypes,
 		MessageInfos:      file_internal_proto_keeper_proto_msgTypes,
 	}.Build()
 	File_internal_proto_keeper_proto = out.File
@@ -24,6 +24,9 @@ const (
 	Keeper_Login_FullMethodName                = "/proto.Keeper/Login"
 	Keeper_EntityCodes_FullMethodName          = "/proto.Keeper/EntityCodes"
 	Keeper_Fields_FullMethodName               = "/proto.Keeper/Fields"
+	Keeper_CreateEntityType_FullMethodName     = "/proto.Keeper/CreateEntityType"
+	Keeper_UpdateEntityType_FullMethodName     = "/proto.Keeper/UpdateEntityType"
+	Keeper_ArchiveEntityType_FullMethodName    = "/proto.Keeper/ArchiveEntityType"
 	Keeper_AddEntity_FullMethodName            = "/proto.Keeper/AddEntity"
 	Keeper_SaveEditEntity_FullMethodName       = "/proto.Keeper/SaveEditEntity"
 	Keeper_DeleteEntity_FullMethodName         = "/proto.Keeper/DeleteEntity"
@@ -33,26 +36,90 @@ const (
 	Keeper_DownloadBinary_FullMethodName       = "/proto.Keeper/DownloadBinary"
 	Keeper_DownloadCryptoBinary_FullMethodName = "/proto.Keeper/DownloadCryptoBinary"
 	Keeper_EntityList_FullMethodName           = "/proto.Keeper/EntityList"
+	Keeper_ListEntities_FullMethodName         = "/proto.Keeper/ListEntities"
+	Keeper_SearchEntities_FullMethodName       = "/proto.Keeper/SearchEntities"
+	Keeper_SetFavorite_FullMethodName          = "/proto.Keeper/SetFavorite"
+	Keeper_Folders_FullMethodName              = "/proto.Keeper/Folders"
+	Keeper_CreateFolder_FullMethodName         = "/proto.Keeper/CreateFolder"
+	Keeper_UpdateFolder_FullMethodName         = "/proto.Keeper/UpdateFolder"
+	Keeper_DeleteFolder_FullMethodName         = "/proto.Keeper/DeleteFolder"
+	Keeper_ListTrash_FullMethodName            = "/proto.Keeper/ListTrash"
+	Keeper_RestoreEntity_FullMethodName        = "/proto.Keeper/RestoreEntity"
+	Keeper_PurgeEntity_FullMethodName          = "/proto.Keeper/PurgeEntity"
+	Keeper_AddAttachment_FullMethodName        = "/proto.Keeper/AddAttachment"
+	Keeper_ListAttachments_FullMethodName      = "/proto.Keeper/ListAttachments"
+	Keeper_DownloadAttachment_FullMethodName   = "/proto.Keeper/DownloadAttachment"
+	Keeper_DeleteAttachment_FullMethodName     = "/proto.Keeper/DeleteAttachment"
 )
 
 // KeeperClient is the client API for Keeper service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type KeeperClient interface {
+	// Проверка связи с сервером
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	// Регистрация пользователя
 	Registration(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// Вход пользователя
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Получение справочника кодов сущностей
 	EntityCodes(ctx context.Context, in *EntityCodesRequest, opts ...grpc.CallOption) (*EntityCodesResponse, error)
+	// Получение описания полей сущностей
 	Fields(ctx context.Context, in *FieldsRequest, opts ...grpc.CallOption) (*FieldsResponse, error)
+	// Создание пользовательского типа сущности
+	CreateEntityType(ctx context.Context, in *CreateEntityTypeRequest, opts ...grpc.CallOption) (*CreateEntityTypeResponse, error)
+	// Изменение пользовательского типа сущности (название, поля, их порядок)
+	UpdateEntityType(ctx context.Context, in *UpdateEntityTypeRequest, opts ...grpc.CallOption) (*UpdateEntityTypeResponse, error)
+	// Перемещение пользовательского типа сущности в архив и возврат из архива
+	ArchiveEntityType(ctx context.Context, in *ArchiveEntityTypeRequest, opts ...grpc.CallOption) (*ArchiveEntityTypeResponse, error)
+	// Добавление сущности
 	AddEntity(ctx context.Context, in *AddEntityRequest, opts ...grpc.CallOption) (*AddEntityResponse, error)
+	// Сохранение отредактированной сущности
 	SaveEditEntity(ctx context.Context, in *SaveEntityRequest, opts ...grpc.CallOption) (*SaveEntityResponse, error)
+	// Удаление сущности (перемещение в корзину)
 	DeleteEntity(ctx context.Context, in *DeleteEntityRequest, opts ...grpc.CallOption) (*DeleteEntityResponse, error)
+	// Выгрузка незашифрованных бинарных данных на сервер
 	UploadBinary(ctx context.Context, opts ...grpc.CallOption) (Keeper_UploadBinaryClient, error)
+	// Выгрузка зашифрованных бинарных данных на сервер
 	UploadCryptoBinary(ctx context.Context, opts ...grpc.CallOption) (Keeper_UploadCryptoBinaryClient, error)
+	// Получение сущности
 	Entity(ctx context.Context, in *EntityRequest, opts ...grpc.CallOption) (*EntityResponse, error)
+	// Загрузка незашифрованных бинарных данных с сервера
 	DownloadBinary(ctx context.Context, in *DownloadBinRequest, opts ...grpc.CallOption) (Keeper_DownloadBinaryClient, error)
+	// Загрузка зашифрованных бинарных данных с сервера
 	DownloadCryptoBinary(ctx context.Context, in *DownloadBinRequest, opts ...grpc.CallOption) (Keeper_DownloadCryptoBinaryClient, error)
+	// Deprecated: Do not use.
+	// Получение списка доступных к просмотру/редактированию/удалению сущностей
+	// Устарело: используйте ListEntities
 	EntityList(ctx context.Context, in *EntityListRequest, opts ...grpc.CallOption) (*EntityListResponse, error)
+	// Постраничное получение списка сущностей с сортировкой и отбором по типам
+	ListEntities(ctx context.Context, in *ListEntitiesRequest, opts ...grpc.CallOption) (*ListEntitiesResponse, error)
+	// Поиск сущностей по токенам слепого индекса, сервер не видит открытых данных
+	SearchEntities(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*SearchEntitiesResponse, error)
+	// Добавление сущности в избранное и удаление из него
+	SetFavorite(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error)
+	// Получение дерева папок
+	Folders(ctx context.Context, in *FoldersRequest, opts ...grpc.CallOption) (*FoldersResponse, error)
+	// Создание папки
+	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	// Изменение папки (переименование, перемещение)
+	UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error)
+	// Удаление папки
+	DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error)
+	// Получение содержимого корзины
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// Восстановление сущности из корзины
+	RestoreEntity(ctx context.Context, in *RestoreEntityRequest, opts ...grpc.CallOption) (*RestoreEntityResponse, error)
+	// Окончательное удаление сущности из корзины
+	PurgeEntity(ctx context.Context, in *PurgeEntityRequest, opts ...grpc.CallOption) (*PurgeEntityResponse, error)
+	// Добавление вложения к сущности (зашифрованные данные передаются потоком)
+	AddAttachment(ctx context.Context, opts ...grpc.CallOption) (Keeper_AddAttachmentClient, error)
+	// Получение списка вложений сущности
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Загрузка зашифрованных данных вложения с сервера
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (Keeper_DownloadAttachmentClient, error)
+	// Удаление вложения
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
 }
 
 type keeperClient struct {
@@ -108,6 +175,33 @@ func (c *keeperClient) Fields(ctx context.Context, in *FieldsRequest, opts ...gr
 	return out, nil
 }
 
+func (c *keeperClient) CreateEntityType(ctx context.Context, in *CreateEntityTypeRequest, opts ...grpc.CallOption) (*CreateEntityTypeResponse, error) {
+	out := new(CreateEntityTypeResponse)
+	err := c.cc.Invoke(ctx, Keeper_CreateEntityType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) UpdateEntityType(ctx context.Context, in *UpdateEntityTypeRequest, opts ...grpc.CallOption) (*UpdateEntityTypeResponse, error) {
+	out := new(UpdateEntityTypeResponse)
+	err := c.cc.Invoke(ctx, Keeper_UpdateEntityType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) ArchiveEntityType(ctx context.Context, in *ArchiveEntityTypeRequest, opts ...grpc.CallOption) (*ArchiveEntityTypeResponse, error) {
+	out := new(ArchiveEntityTypeResponse)
+	err := c.cc.Invoke(ctx, Keeper_ArchiveEntityType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keeperClient) AddEntity(ctx context.Context, in *AddEntityRequest, opts ...grpc.CallOption) (*AddEntityResponse, error) {
 	out := new(AddEntityResponse)
 	err := c.cc.Invoke(ctx, Keeper_AddEntity_FullMethodName, in, out, opts...)
@@ -276,6 +370,7 @@ func (x *keeperDownloadCryptoBinaryClient) Recv() (*DownloadBinResponse, error)
 	return m, nil
 }
 
+// Deprecated: Do not use.
 func (c *keeperClient) EntityList(ctx context.Context, in *EntityListRequest, opts ...grpc.CallOption) (*EntityListResponse, error) {
 	out := new(EntityListResponse)
 	err := c.cc.Invoke(ctx, Keeper_EntityList_FullMethodName, in, out, opts...)
@@ -285,24 +380,248 @@ func (c *keeperClient) EntityList(ctx context.Context, in *EntityListRequest, op
 	return out, nil
 }
 
+func (c *keeperClient) ListEntities(ctx context.Context, in *ListEntitiesRequest, opts ...grpc.CallOption) (*ListEntitiesResponse, error) {
+	out := new(ListEntitiesResponse)
+	err := c.cc.Invoke(ctx, Keeper_ListEntities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) SearchEntities(ctx context.Context, in *SearchEntitiesRequest, opts ...grpc.CallOption) (*SearchEntitiesResponse, error) {
+	out := new(SearchEntitiesResponse)
+	err := c.cc.Invoke(ctx, Keeper_SearchEntities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) SetFavorite(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error) {
+	out := new(SetFavoriteResponse)
+	err := c.cc.Invoke(ctx, Keeper_SetFavorite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) Folders(ctx context.Context, in *FoldersRequest, opts ...grpc.CallOption) (*FoldersResponse, error) {
+	out := new(FoldersResponse)
+	err := c.cc.Invoke(ctx, Keeper_Folders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error) {
+	out := new(CreateFolderResponse)
+	err := c.cc.Invoke(ctx, Keeper_CreateFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) UpdateFolder(ctx context.Context, in *UpdateFolderRequest, opts ...grpc.CallOption) (*UpdateFolderResponse, error) {
+	out := new(UpdateFolderResponse)
+	err := c.cc.Invoke(ctx, Keeper_UpdateFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) DeleteFolder(ctx context.Context, in *DeleteFolderRequest, opts ...grpc.CallOption) (*DeleteFolderResponse, error) {
+	out := new(DeleteFolderResponse)
+	err := c.cc.Invoke(ctx, Keeper_DeleteFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, Keeper_ListTrash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) RestoreEntity(ctx context.Context, in *RestoreEntityRequest, opts ...grpc.CallOption) (*RestoreEntityResponse, error) {
+	out := new(RestoreEntityResponse)
+	err := c.cc.Invoke(ctx, Keeper_RestoreEntity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) PurgeEntity(ctx context.Context, in *PurgeEntityRequest, opts ...grpc.CallOption) (*PurgeEntityResponse, error) {
+	out := new(PurgeEntityResponse)
+	err := c.cc.Invoke(ctx, Keeper_PurgeEntity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) AddAttachment(ctx context.Context, opts ...grpc.CallOption) (Keeper_AddAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Keeper_ServiceDesc.Streams[4], Keeper_AddAttachment_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keeperAddAttachmentClient{stream}
+	return x, nil
+}
+
+type Keeper_AddAttachmentClient interface {
+	Send(*AddAttachmentRequest) error
+	CloseAndRecv() (*AddAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type keeperAddAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *keeperAddAttachmentClient) Send(m *AddAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *keeperAddAttachmentClient) CloseAndRecv() (*AddAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AddAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keeperClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, Keeper_ListAttachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keeperClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (Keeper_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Keeper_ServiceDesc.Streams[5], Keeper_DownloadAttachment_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keeperDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Keeper_DownloadAttachmentClient interface {
+	Recv() (*DownloadBinResponse, error)
+	grpc.ClientStream
+}
+
+type keeperDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *keeperDownloadAttachmentClient) Recv() (*DownloadBinResponse, error) {
+	m := new(DownloadBinResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keeperClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, Keeper_DeleteAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // KeeperServer is the server API for Keeper service.
 // All implementations must embed UnimplementedKeeperServer
 // for forward compatibility
 type KeeperServer interface {
+	// Проверка связи с сервером
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// Регистрация пользователя
 	Registration(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// Вход пользователя
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Получение справочника кодов сущностей
 	EntityCodes(context.Context, *EntityCodesRequest) (*EntityCodesResponse, error)
+	// Получение описания полей сущностей
 	Fields(context.Context, *FieldsRequest) (*FieldsResponse, error)
+	// Создание пользовательского типа сущности
+	CreateEntityType(context.Context, *CreateEntityTypeRequest) (*CreateEntityTypeResponse, error)
+	// Изменение пользовательского типа сущности (название, поля, их порядок)
+	UpdateEntityType(context.Context, *UpdateEntityTypeRequest) (*UpdateEntityTypeResponse, error)
+	// Перемещение пользовательского типа сущности в архив и возврат из архива
+	ArchiveEntityType(context.Context, *ArchiveEntityTypeRequest) (*ArchiveEntityTypeResponse, error)
+	// Добавление сущности
 	AddEntity(context.Context, *AddEntityRequest) (*AddEntityResponse, error)
+	// Сохранение отредактированной сущности
 	SaveEditEntity(context.Context, *SaveEntityRequest) (*SaveEntityResponse, error)
+	// Удаление сущности (перемещение в корзину)
 	DeleteEntity(context.Context, *DeleteEntityRequest) (*DeleteEntityResponse, error)
+	// Выгрузка незашифрованных бинарных данных на сервер
 	UploadBinary(Keeper_UploadBinaryServer) error
+	// Выгрузка зашифрованных бинарных данных на сервер
 	UploadCryptoBinary(Keeper_UploadCryptoBinaryServer) error
+	// Получение сущности
 	Entity(context.Context, *EntityRequest) (*EntityResponse, error)
+	// Загрузка незашифрованных бинарных данных с сервера
 	DownloadBinary(*DownloadBinRequest, Keeper_DownloadBinaryServer) error
+	// Загрузка зашифрованных бинарных данных с сервера
 	DownloadCryptoBinary(*DownloadBinRequest, Keeper_DownloadCryptoBinaryServer) error
+	// Deprecated: Do not use.
+	// Получение списка доступных к просмотру/редактированию/удалению сущностей
+	// Устарело: используйте ListEntities
 	EntityList(context.Context, *EntityListRequest) (*EntityListResponse, error)
+	// Постраничное получение списка сущностей с сортировкой и отбором по типам
+	ListEntities(context.Context, *ListEntitiesRequest) (*ListEntitiesResponse, error)
+	// Поиск сущностей по токенам слепого индекса, сервер не видит открытых данных
+	SearchEntities(context.Context, *SearchEntitiesRequest) (*SearchEntitiesResponse, error)
+	// Добавление сущности в избранное и удаление из него
+	SetFavorite(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error)
+	// Получение дерева папок
+	Folders(context.Context, *FoldersRequest) (*FoldersResponse, error)
+	// Создание папки
+	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	// Изменение папки (переименование, перемещение)
+	UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error)
+	// Удаление папки
+	DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error)
+	// Получение содержимого корзины
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// Восстановление сущности из корзины
+	RestoreEntity(context.Context, *RestoreEntityRequest) (*RestoreEntityResponse, error)
+	// Окончательное удаление сущности из корзины
+	PurgeEntity(context.Context, *PurgeEntityRequest) (*PurgeEntityResponse, error)
+	// Добавление вложения к сущности (зашифрованные данные передаются потоком)
+	AddAttachment(Keeper_AddAttachmentServer) error
+	// Получение списка вложений сущности
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Загрузка зашифрованных данных вложения с сервера
+	DownloadAttachment(*DownloadAttachmentRequest, Keeper_DownloadAttachmentServer) error
+	// Удаление вложения
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
 	mustEmbedUnimplementedKeeperServer()
 }
 
@@ -325,6 +644,15 @@ func (UnimplementedKeeperServer) EntityCodes(context.Context, *EntityCodesReques
 func (UnimplementedKeeperServer) Fields(context.Context, *FieldsRequest) (*FieldsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Fields not implemented")
 }
+func (UnimplementedKeeperServer) CreateEntityType(context.Context, *CreateEntityTypeRequest) (*CreateEntityTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEntityType not implemented")
+}
+func (UnimplementedKeeperServer) UpdateEntityType(context.Context, *UpdateEntityTypeRequest) (*UpdateEntityTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEntityType not implemented")
+}
+func (UnimplementedKeeperServer) ArchiveEntityType(context.Context, *ArchiveEntityTypeRequest) (*ArchiveEntityTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveEntityType not implemented")
+}
 func (UnimplementedKeeperServer) AddEntity(context.Context, *AddEntityRequest) (*AddEntityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddEntity not implemented")
 }
@@ -352,6 +680,48 @@ func (UnimplementedKeeperServer) DownloadCryptoBinary(*DownloadBinRequest, Keepe
 func (UnimplementedKeeperServer) EntityList(context.Context, *EntityListRequest) (*EntityListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EntityList not implemented")
 }
+func (UnimplementedKeeperServer) ListEntities(context.Context, *ListEntitiesRequest) (*ListEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntities not implemented")
+}
+func (UnimplementedKeeperServer) SearchEntities(context.Context, *SearchEntitiesRequest) (*SearchEntitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEntities not implemented")
+}
+func (UnimplementedKeeperServer) SetFavorite(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavorite not implemented")
+}
+func (UnimplementedKeeperServer) Folders(context.Context, *FoldersRequest) (*FoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Folders not implemented")
+}
+func (UnimplementedKeeperServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedKeeperServer) UpdateFolder(context.Context, *UpdateFolderRequest) (*UpdateFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFolder not implemented")
+}
+func (UnimplementedKeeperServer) DeleteFolder(context.Context, *DeleteFolderRequest) (*DeleteFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedKeeperServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedKeeperServer) RestoreEntity(context.Context, *RestoreEntityRequest) (*RestoreEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreEntity not implemented")
+}
+func (UnimplementedKeeperServer) PurgeEntity(context.Context, *PurgeEntityRequest) (*PurgeEntityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeEntity not implemented")
+}
+func (UnimplementedKeeperServer) AddAttachment(Keeper_AddAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddAttachment not implemented")
+}
+func (UnimplementedKeeperServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedKeeperServer) DownloadAttachment(*DownloadAttachmentRequest, Keeper_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedKeeperServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
 func (UnimplementedKeeperServer) mustEmbedUnimplementedKeeperServer() {}
 
 // UnsafeKeeperServer may be embedded to opt out of forward compatibility for this service.
@@ -455,6 +825,60 @@ func _Keeper_Fields_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keeper_CreateEntityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEntityTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).CreateEntityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_CreateEntityType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).CreateEntityType(ctx, req.(*CreateEntityTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_UpdateEntityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEntityTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).UpdateEntityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_UpdateEntityType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).UpdateEntityType(ctx, req.(*UpdateEntityTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_ArchiveEntityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveEntityTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).ArchiveEntityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_ArchiveEntityType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).ArchiveEntityType(ctx, req.(*ArchiveEntityTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Keeper_AddEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddEntityRequest)
 	if err := dec(in); err != nil {
@@ -639,6 +1063,269 @@ func _Keeper_EntityList_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Keeper_ListEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).ListEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_ListEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).ListEntities(ctx, req.(*ListEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_SearchEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).SearchEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_SearchEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).SearchEntities(ctx, req.(*SearchEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_SetFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).SetFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_SetFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).SetFavorite(ctx, req.(*SetFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_Folders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).Folders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_Folders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).Folders(ctx, req.(*FoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_UpdateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).UpdateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_UpdateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).UpdateFolder(ctx, req.(*UpdateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_DeleteFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).DeleteFolder(ctx, req.(*DeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_RestoreEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).RestoreEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_RestoreEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).RestoreEntity(ctx, req.(*RestoreEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_PurgeEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).PurgeEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_PurgeEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).PurgeEntity(ctx, req.(*PurgeEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_AddAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KeeperServer).AddAttachment(&keeperAddAttachmentServer{stream})
+}
+
+type Keeper_AddAttachmentServer interface {
+	SendAndClose(*AddAttachmentResponse) error
+	Recv() (*AddAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type keeperAddAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *keeperAddAttachmentServer) SendAndClose(m *AddAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *keeperAddAttachmentServer) Recv() (*AddAttachmentRequest, error) {
+	m := new(AddAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Keeper_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Keeper_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeeperServer).DownloadAttachment(m, &keeperDownloadAttachmentServer{stream})
+}
+
+type Keeper_DownloadAttachmentServer interface {
+	Send(*DownloadBinResponse) error
+	grpc.ServerStream
+}
+
+type keeperDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *keeperDownloadAttachmentServer) Send(m *DownloadBinResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Keeper_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeeperServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Keeper_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeeperServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Keeper_ServiceDesc is the grpc.ServiceDesc for Keeper service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -666,6 +1353,18 @@ var Keeper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Fields",
 			Handler:    _Keeper_Fields_Handler,
 		},
+		{
+			MethodName: "CreateEntityType",
+			Handler:    _Keeper_CreateEntityType_Handler,
+		},
+		{
+			MethodName: "UpdateEntityType",
+			Handler:    _Keeper_UpdateEntityType_Handler,
+		},
+		{
+			MethodName: "ArchiveEntityType",
+			Handler:    _Keeper_ArchiveEntityType_Handler,
+		},
 		{
 			MethodName: "AddEntity",
 			Handler:    _Keeper_AddEntity_Handler,
@@ -686,6 +1385,54 @@ var Keeper_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EntityList",
 			Handler:    _Keeper_EntityList_Handler,
 		},
+		{
+			MethodName: "ListEntities",
+			Handler:    _Keeper_ListEntities_Handler,
+		},
+		{
+			MethodName: "SearchEntities",
+			Handler:    _Keeper_SearchEntities_Handler,
+		},
+		{
+			MethodName: "SetFavorite",
+			Handler:    _Keeper_SetFavorite_Handler,
+		},
+		{
+			MethodName: "Folders",
+			Handler:    _Keeper_Folders_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _Keeper_CreateFolder_Handler,
+		},
+		{
+			MethodName: "UpdateFolder",
+			Handler:    _Keeper_UpdateFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _Keeper_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _Keeper_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreEntity",
+			Handler:    _Keeper_RestoreEntity_Handler,
+		},
+		{
+			MethodName: "PurgeEntity",
+			Handler:    _Keeper_PurgeEntity_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _Keeper_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _Keeper_DeleteAttachment_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -708,6 +1455,16 @@ var Keeper_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Keeper_DownloadCryptoBinary_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AddAttachment",
+			Handler:       _Keeper_AddAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _Keeper_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "internal/proto/keeper.proto",
 }
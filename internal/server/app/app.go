@@ -1,6 +1,8 @@
 package app
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -8,19 +10,66 @@ import (
 	"syscall"
 
 	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
 
+	"github.com/dnsoftware/gophkeeper/internal/constants"
 	"github.com/dnsoftware/gophkeeper/internal/server/config"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/user"
 	"github.com/dnsoftware/gophkeeper/internal/server/handlers"
+	"github.com/dnsoftware/gophkeeper/internal/storage/memory"
 	"github.com/dnsoftware/gophkeeper/internal/storage/postgresql"
+	"github.com/dnsoftware/gophkeeper/internal/storage/sqlite"
 	"github.com/dnsoftware/gophkeeper/logger"
 )
 
+// repository полный набор интерфейсов хранилища, необходимых доменным сервисам
+type repository interface {
+	entity.EntityRepo
+	entity.FieldRepo
+	user.UserStorage
+	field.FieldStorage
+	entity_code.EntityCodeStorage
+	folder.FolderStorage
+}
+
+// newRepository создание хранилища данных, указанного в конфигурации
+func newRepository(cfg *config.ServerConfig) (repository, error) {
+	switch cfg.Storage.Driver {
+	case constants.StorageMemory:
+		logger.Log().Info("Данные хранятся в оперативной памяти и будут потеряны при остановке сервера")
+		return memory.NewMemoryStorage()
+	}
+
+	// миграции (могут быть отключены, тогда схемой управляют подкомандой migrate)
+	if !cfg.SkipMigrations {
+		m, err := newMigrate(cfg)
+		if err != nil {
+			return nil, err
+		}
+		err = m.Up()
+		m.Close()
+		if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			logger.Log().Error("migrate.Up: " + err.Error())
+			return nil, err
+		}
+	}
+
+	if cfg.Storage.Driver == constants.StorageSqlite {
+		return sqlite.NewSqliteStorage(cfg.DatabaseDSN)
+	}
+
+	repository, err := postgresql.NewPostgresqlStorage(cfg.DatabaseDSN)
+	if err != nil {
+		logger.Log().Error("NewPostgresqlStorage: " + err.Error())
+		return nil, err
+	}
+
+	return repository, nil
+}
+
 func ServerRun() error {
 	cfg, err := config.NewServerConfig()
 	if err != nil {
@@ -28,18 +77,9 @@ func ServerRun() error {
 	}
 	logger.Log().Info("Server starting...")
 
-	// миграции
-	path, _ := os.Getwd()
-	m, err := migrate.New("file://"+path+"/migrations", cfg.DatabaseDSN)
+	repository, err := newRepository(cfg)
 	if err != nil {
-		logger.Log().Error("migrate.New: " + err.Error())
 		return err
-	} else {
-		err = m.Up()
-		if err != nil && err.Error() != "no change" {
-			logger.Log().Error("migrate.New else: " + err.Error())
-			return err
-		}
 	}
 
 	// grpc server
@@ -49,12 +89,6 @@ func ServerRun() error {
 		return err
 	}
 
-	repository, err := postgresql.NewPostgresqlStorage(cfg.DatabaseDSN)
-	if err != nil {
-		logger.Log().Error("NewPostgresqlStorage: " + err.Error())
-		return err
-	}
-
 	userService, err := user.NewUser(repository)
 	if err != nil {
 		logger.Log().Error("user.NewUser: " + err.Error())
@@ -68,8 +102,20 @@ func ServerRun() error {
 	}
 	fieldService, _ := field.NewField(repository)
 	entityService, _ := entity.NewEntity(repository, repository)
+	folderService, _ := folder.NewFolder(repository)
+
+	// автоматическая очистка корзины от сущностей с истекшим сроком хранения
+	purgeCtx, purgeCancel := context.WithCancel(context.Background())
+	defer purgeCancel()
+	go entityService.RunTrashPurge(purgeCtx, cfg.TrashRetention, constants.TrashPurgeInterval)
 
-	grpcServer, err := handlers.NewGRPCServer(handlers.Services{userService, entityCodeService, fieldService, entityService}, cfg.SertificateKeyPath, cfg.PrivateKeyPath)
+	grpcServer, err := handlers.NewGRPCServer(handlers.Services{
+		UserService:       userService,
+		EntityCodeService: entityCodeService,
+		FieldService:      fieldService,
+		EntityService:     entityService,
+		FolderService:     folderService,
+	}, cfg.SertificateKeyPath, cfg.PrivateKeyPath)
 	if err != nil {
 		logger.Log().Fatal(err.Error())
 	}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+
+	"github.com/dnsoftware/gophkeeper/cmd/server/migrations"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/config"
+	"github.com/dnsoftware/gophkeeper/internal/storage/sqlite"
+	"github.com/dnsoftware/gophkeeper/logger"
+)
+
+// действия подкоманды migrate
+const (
+	migrateUp      = "up"
+	migrateDown    = "down"
+	migrateVersion = "version"
+	migrateForce   = "force"
+)
+
+// MigrateRun подкоманда управления схемой базы данных:
+// migrate up|down [N]|version|force V [ключи запуска сервера]
+func MigrateRun(args []string) error {
+	action, num, rest, err := parseMigrateArgs(args)
+	if err != nil {
+		return err
+	}
+
+	// оставшиеся аргументы - обычные ключи запуска сервера (-c, -d, -storage ...)
+	os.Args = append(os.Args[:1], rest...)
+	cfg, err := config.NewServerConfig()
+	if err != nil {
+		return err
+	}
+
+	m, err := newMigrate(cfg)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	result, err := runMigrate(m, action, num)
+	if err != nil {
+		return err
+	}
+	fmt.Println(result)
+
+	return nil
+}
+
+// parseMigrateArgs разбор аргументов подкоманды migrate
+// Возвращает действие, числовой параметр (шаги для down, версия для force) и оставшиеся аргументы
+func parseMigrateArgs(args []string) (string, int, []string, error) {
+	if len(args) == 0 {
+		return "", 0, nil, errors.New("usage: migrate up|down [N]|version|force V")
+	}
+
+	action := args[0]
+	rest := args[1:]
+	switch action {
+	case migrateUp, migrateVersion:
+		return action, 0, rest, nil
+	case migrateDown:
+		// по умолчанию откатывается одна миграция
+		if len(rest) > 0 {
+			if n, err := strconv.Atoi(rest[0]); err == nil {
+				if n <= 0 {
+					return "", 0, nil, fmt.Errorf("wrong steps count: %v", n)
+				}
+				return action, n, rest[1:], nil
+			}
+		}
+		return action, 1, rest, nil
+	case migrateForce:
+		if len(rest) == 0 {
+			return "", 0, nil, errors.New("usage: migrate force V")
+		}
+		v, err := strconv.Atoi(rest[0])
+		if err != nil {
+			return "", 0, nil, fmt.Errorf("wrong version: %s", rest[0])
+		}
+		return action, v, rest[1:], nil
+	}
+
+	return "", 0, nil, fmt.Errorf("unknown migrate action: %s", action)
+}
+
+// runMigrate выполнение действия, возвращает текст для вывода оператору
+func runMigrate(m *migrate.Migrate, action string, num int) (string, error) {
+	var err error
+	switch action {
+	case migrateUp:
+		err = m.Up()
+	case migrateDown:
+		err = m.Steps(-num)
+	case migrateForce:
+		err = m.Force(num)
+	case migrateVersion:
+	default:
+		return "", fmt.Errorf("unknown migrate action: %s", action)
+	}
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return "", err
+	}
+
+	version, dirty, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return "version: none", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	result := fmt.Sprintf("version: %v", version)
+	if dirty {
+		result += " (dirty)"
+	}
+
+	return result, nil
+}
+
+// newMigrate управление схемой базы данных хранилища, указанного в конфигурации
+func newMigrate(cfg *config.ServerConfig) (*migrate.Migrate, error) {
+	switch cfg.Storage.Driver {
+	case constants.StoragePostgresql:
+		source, err := iofs.New(migrations.FS, ".")
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := migrate.NewWithSourceInstance("iofs", source, cfg.DatabaseDSN)
+		if err != nil {
+			logger.Log().Error("migrate.New: " + err.Error())
+			return nil, err
+		}
+
+		return m, nil
+	case constants.StorageSqlite:
+		return sqlite.NewMigrate(cfg.DatabaseDSN)
+	}
+
+	return nil, fmt.Errorf("storage %s has no migrations", cfg.Storage.Driver)
+}
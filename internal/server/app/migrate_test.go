@@ -0,0 +1,117 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/cmd/server/migrations"
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/config"
+	"github.com/dnsoftware/gophkeeper/internal/storage/sqlite"
+)
+
+func TestParseMigrateArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		action string
+		num    int
+		rest   []string
+		err    bool
+	}{
+		{name: "up", args: []string{"up", "-d=dsn"}, action: "up", rest: []string{"-d=dsn"}},
+		{name: "version", args: []string{"version"}, action: "version", rest: []string{}},
+		{name: "down default", args: []string{"down", "-d=dsn"}, action: "down", num: 1, rest: []string{"-d=dsn"}},
+		{name: "down steps", args: []string{"down", "3", "-d=dsn"}, action: "down", num: 3, rest: []string{"-d=dsn"}},
+		{name: "down zero", args: []string{"down", "0"}, err: true},
+		{name: "force", args: []string{"force", "9"}, action: "force", num: 9, rest: []string{}},
+		{name: "force no version", args: []string{"force"}, err: true},
+		{name: "force bad version", args: []string{"force", "x"}, err: true},
+		{name: "unknown", args: []string{"drop"}, err: true},
+		{name: "empty", args: nil, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, num, rest, err := parseMigrateArgs(tt.args)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.action, action)
+			assert.Equal(t, tt.num, num)
+			assert.Equal(t, tt.rest, rest)
+		})
+	}
+}
+
+func TestRunMigrate(t *testing.T) {
+	m, err := sqlite.NewMigrate(t.TempDir() + "/gophkeeper.db")
+	require.NoError(t, err)
+	defer m.Close()
+
+	result, err := runMigrate(m, migrateVersion, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "version: none", result)
+
+	result, err = runMigrate(m, migrateUp, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "version: 11", result)
+
+	// повторное применение - без изменений
+	result, err = runMigrate(m, migrateUp, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "version: 11", result)
+
+	result, err = runMigrate(m, migrateDown, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "version: 10", result)
+
+	result, err = runMigrate(m, migrateForce, 10)
+	require.NoError(t, err)
+	assert.Equal(t, "version: 10", result)
+
+	_, err = runMigrate(m, "drop", 0)
+	require.Error(t, err)
+}
+
+func TestNewRepositoryMigrations(t *testing.T) {
+	path := t.TempDir() + "/gophkeeper.db"
+	cfg := &config.ServerConfig{DatabaseDSN: path, Storage: config.StorageConfig{Driver: constants.StorageSqlite}, SkipMigrations: true}
+
+	// миграции отключены: схема не создается
+	repo, err := newRepository(cfg)
+	require.NoError(t, err)
+	m, err := sqlite.NewMigrate(path)
+	require.NoError(t, err)
+	result, err := runMigrate(m, migrateVersion, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "version: none", result)
+	m.Close()
+	require.NoError(t, repo.(*sqlite.SqliteStorage).Close())
+
+	cfg.SkipMigrations = false
+	repo, err = newRepository(cfg)
+	require.NoError(t, err)
+	defer repo.(*sqlite.SqliteStorage).Close()
+	m, err = sqlite.NewMigrate(path)
+	require.NoError(t, err)
+	defer m.Close()
+	result, err = runMigrate(m, migrateVersion, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "version: 11", result)
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	source, err := iofs.New(migrations.FS, ".")
+	require.NoError(t, err)
+	defer source.Close()
+
+	version, err := source.First()
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), version)
+}
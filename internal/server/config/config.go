@@ -6,18 +6,28 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/dnsoftware/gophkeeper/internal/constants"
 	"github.com/dnsoftware/gophkeeper/logger"
 )
 
 type ServerConfig struct {
-	Env                string `yaml:"env"`                // окружение (local, dev, prod)
-	ServerAddress      string `yaml:"serverAddress"`      // адрес и порт на которых работает gRPC сервер
-	DatabaseDSN        string `yaml:"databaseDSN"`        // параметры доступа к базе данных Postgresql
-	SertificateKeyPath string `yaml:"sertificateKeyPath"` // путь к файлу сертификата
-	PrivateKeyPath     string `yaml:"privateKeyPath"`     // путь к файлу с приватным ключом
+	Env                string        `yaml:"env"`                // окружение (local, dev, prod)
+	ServerAddress      string        `yaml:"serverAddress"`      // адрес и порт на которых работает gRPC сервер
+	DatabaseDSN        string        `yaml:"databaseDSN"`        // параметры доступа к базе данных Postgresql
+	SertificateKeyPath string        `yaml:"sertificateKeyPath"` // путь к файлу сертификата
+	PrivateKeyPath     string        `yaml:"privateKeyPath"`     // путь к файлу с приватным ключом
+	TrashRetention     time.Duration `yaml:"trashRetention"`     // срок хранения удаленных сущностей в корзине
+	Storage            StorageConfig `yaml:"storage"`            // параметры хранилища данных
+	SkipMigrations     bool          `yaml:"skipMigrations"`     // не применять миграции при запуске (схемой управляют подкомандой migrate)
+}
+
+// StorageConfig параметры хранилища данных
+type StorageConfig struct {
+	Driver string `yaml:"driver"` // тип хранилища (postgresql, sqlite, memory)
 }
 
 func NewServerConfig() (*ServerConfig, error) {
@@ -31,6 +41,9 @@ func NewServerConfig() (*ServerConfig, error) {
 	flag.StringVar(&flagCfg.DatabaseDSN, "d", "", "database DSN")
 	flag.StringVar(&flagCfg.SertificateKeyPath, "s", "", "path to SSL sertificate key file")
 	flag.StringVar(&flagCfg.PrivateKeyPath, "p", "", "path to SSL private key file")
+	flag.DurationVar(&flagCfg.TrashRetention, "r", constants.TrashRetention, "trash retention period")
+	flag.BoolVar(&flagCfg.SkipMigrations, "skip-migrations", false, "do not apply migrations on start")
+	flag.StringVar(&flagCfg.Storage.Driver, "storage", constants.StoragePostgresql, "data storage driver (postgresql, sqlite, memory)")
 	flag.Parse()
 
 	if configFile != "" {
@@ -59,6 +72,24 @@ func NewServerConfig() (*ServerConfig, error) {
 	if cfg.PrivateKeyPath == "" {
 		cfg.PrivateKeyPath = flagCfg.PrivateKeyPath
 	}
+	if cfg.TrashRetention == 0 {
+		cfg.TrashRetention = flagCfg.TrashRetention
+	}
+	cfg.SkipMigrations = cfg.SkipMigrations || flagCfg.SkipMigrations
+	if cfg.Storage.Driver == "" {
+		cfg.Storage.Driver = flagCfg.Storage.Driver
+	}
+
+	switch cfg.Storage.Driver {
+	case constants.StoragePostgresql, constants.StorageMemory:
+	case constants.StorageSqlite:
+		// для SQLite в databaseDSN указывается путь к файлу базы
+		if cfg.DatabaseDSN == "" {
+			cfg.DatabaseDSN = constants.SqliteDefaultFile
+		}
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.Storage.Driver)
+	}
 
 	return cfg, nil
 }
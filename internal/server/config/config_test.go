@@ -4,6 +4,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -18,4 +19,6 @@ func TestDelete(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "local", cfg.Env)
 	assert.Equal(t, "localhost:9090", cfg.ServerAddress)
+	assert.Equal(t, 720*time.Hour, cfg.TrashRetention)
+	assert.Equal(t, "postgresql", cfg.Storage.Driver)
 }
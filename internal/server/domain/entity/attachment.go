@@ -0,0 +1,282 @@
+package entity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	pb "github.com/dnsoftware/gophkeeper/internal/proto"
+	"github.com/dnsoftware/gophkeeper/internal/utils"
+)
+
+// AttachmentModel вложение сущности
+// Имя файла и контрольная сумма зашифрованы клиентом, содержимое хранится зашифрованными фрагментами
+// в файлах <папка Servername>/<индекс фрагмента>_<имя файла Servername>
+type AttachmentModel struct {
+	ID         int32     // ID вложения
+	EntityID   int32     // ID сущности
+	Name       string    // имя файла (зашифровано)
+	Size       int64     // размер исходного файла в байтах
+	Digest     string    // SHA-256 исходного файла (зашифрован)
+	Servername string    // путь к файлам фрагментов на сервере (без индекса фрагмента)
+	ChunkCount int32     // кол-во частей на которые разбит файл
+	CreatedAt  time.Time // время добавления
+}
+
+// AddAttachment получение вложения с клиента (клиент -> сервер)
+// Первое сообщение потока - описание вложения, остальные - фрагменты зашифрованных данных.
+// Фрагменты пишутся в новую папку файлового хранилища, при ошибке папка удаляется
+func (e *Entity) AddAttachment(stream pb.Keeper_AddAttachmentServer, userID int32) (AttachmentModel, error) {
+	ctx := stream.Context()
+
+	req, err := stream.Recv()
+	if err != nil {
+		return AttachmentModel{}, status.Error(codes.InvalidArgument, "не получено описание вложения")
+	}
+	info := req.GetInfo()
+	if info == nil {
+		return AttachmentModel{}, status.Error(codes.InvalidArgument, "первым сообщением должно быть описание вложения")
+	}
+
+	att := AttachmentModel{
+		EntityID: info.EntityId,
+		Name:     info.Name,
+		Size:     info.Size,
+		Digest:   info.Digest,
+	}
+	err = e.checkNewAttachment(ctx, att, userID)
+	if err != nil {
+		return AttachmentModel{}, err
+	}
+
+	dir, base, err := newBlobDir(constants.AttachmentsDir, strconv.Itoa(int(userID)))
+	if err != nil {
+		return AttachmentModel{}, status.Error(codes.Internal, err.Error())
+	}
+	att.Servername = dir + "/" + base
+
+	// данные принимаются вне транзакции, чтобы не удерживать хранилище на время передачи
+	received, err := receiveAttachmentChunks(stream, &att)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return AttachmentModel{}, err
+	}
+
+	// каждый фрагмент зашифрован отдельно, без накладных расходов шифрования размер должен совпасть с заявленным
+	if received-int64(att.ChunkCount)*utils.BinaryOverhead != att.Size {
+		_ = os.RemoveAll(dir)
+		return AttachmentModel{}, status.Errorf(codes.InvalidArgument, "размер принятых данных не совпадает с размером вложения %v байт", att.Size)
+	}
+
+	err = e.unitOfWork(ctx, func(repo EntityRepo, blobs *blobWork) error {
+		blobs.created = append(blobs.created, dir)
+
+		// за время передачи данных могли быть добавлены другие вложения;
+		// блокировка сущности не дает параллельным загрузкам пройти проверку количества одновременно
+		err := repo.LockEntity(ctx, att.EntityID)
+		if err != nil {
+			return err
+		}
+		err = checkAttachmentsCount(ctx, repo, att.EntityID)
+		if err != nil {
+			return err
+		}
+
+		att.CreatedAt = time.Now()
+		att.ID, err = repo.CreateAttachment(ctx, att)
+		return err
+	})
+	if err != nil {
+		return AttachmentModel{}, err
+	}
+
+	err = stream.SendAndClose(&pb.AddAttachmentResponse{Id: att.ID, Size: received})
+	if err != nil {
+		return att, err
+	}
+
+	return att, nil
+}
+
+// receiveAttachmentChunks запись фрагментов вложения в отдельные файлы, возвращает размер принятых данных
+func receiveAttachmentChunks(stream pb.Keeper_AddAttachmentServer, att *AttachmentModel) (int64, error) {
+	var received int64
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return received, nil
+		}
+		if err != nil {
+			return received, status.Error(codes.Internal, err.Error())
+		}
+
+		chunk := req.GetChunkData()
+		if len(chunk) < utils.BinaryOverhead {
+			return received, status.Error(codes.InvalidArgument, "фрагмент вложения короче заголовка шифрования")
+		}
+		received += int64(len(chunk))
+		if received > constants.MaxAttachmentSize {
+			return received, status.Errorf(codes.InvalidArgument, "размер вложения превышает %v байт", constants.MaxAttachmentSize)
+		}
+
+		att.ChunkCount++
+		err = os.WriteFile(chunkFilename(att.Servername, att.ChunkCount), chunk, 0600)
+		if err != nil {
+			return received, status.Error(codes.Internal, err.Error())
+		}
+	}
+}
+
+// checkNewAttachment проверка описания нового вложения: сущность принадлежит пользователю,
+// размеры полей и количество вложений сущности не превышают ограничений
+func (e *Entity) checkNewAttachment(ctx context.Context, att AttachmentModel, userID int32) error {
+	err := e.checkEntityOwner(ctx, att.EntityID, userID)
+	if err != nil {
+		return err
+	}
+
+	if att.Name == "" {
+		return status.Error(codes.InvalidArgument, "не указано имя файла вложения")
+	}
+	if len(att.Name)+len(att.Digest) > constants.MaxAttachmentFieldsSize {
+		return status.Errorf(codes.InvalidArgument, "имя и контрольная сумма вложения длиннее %v байт", constants.MaxAttachmentFieldsSize)
+	}
+	if att.Size < 0 || att.Size > constants.MaxAttachmentSize {
+		return status.Errorf(codes.InvalidArgument, "размер вложения должен быть от 0 до %v байт", constants.MaxAttachmentSize)
+	}
+
+	return checkAttachmentsCount(ctx, e.repoEntity, att.EntityID)
+}
+
+// checkAttachmentsCount к сущности можно добавить еще одно вложение
+func checkAttachmentsCount(ctx context.Context, repo EntityRepo, entityID int32) error {
+	list, err := repo.GetAttachments(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if len(list) >= constants.MaxAttachments {
+		return status.Errorf(codes.InvalidArgument, "у сущности не может быть больше %v вложений", constants.MaxAttachments)
+	}
+
+	return nil
+}
+
+// ListAttachments вложения сущности пользователя в порядке добавления
+func (e *Entity) ListAttachments(ctx context.Context, entityID int32, userID int32) ([]AttachmentModel, error) {
+	err := e.checkEntityOwner(ctx, entityID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.repoEntity.GetAttachments(ctx, entityID)
+}
+
+// DownloadAttachment отдача зашифрованных данных вложения клиенту (сервер -> клиент)
+func (e *Entity) DownloadAttachment(id int32, userID int32, stream pb.Keeper_DownloadAttachmentServer) error {
+	att, err := e.attachment(stream.Context(), id, userID)
+	if err != nil {
+		return err
+	}
+
+	for index := int32(1); index <= att.ChunkCount; index++ {
+		chunk, err := os.ReadFile(chunkFilename(att.Servername, index))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := stream.Send(&pb.DownloadBinResponse{ChunkData: chunk}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteAttachment удаление вложения, папка с фрагментами удаляется после фиксации удаления в базе
+func (e *Entity) DeleteAttachment(ctx context.Context, id int32, userID int32) error {
+	att, err := e.attachment(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	return e.unitOfWork(ctx, func(repo EntityRepo, blobs *blobWork) error {
+		blobs.removed = append(blobs.removed, path.Dir(att.Servername)+"/")
+
+		return repo.DeleteAttachment(ctx, id)
+	})
+}
+
+// attachment вложение сущности пользователя
+func (e *Entity) attachment(ctx context.Context, id int32, userID int32) (AttachmentModel, error) {
+	att, err := e.repoEntity.GetAttachment(ctx, id)
+	if err != nil {
+		return AttachmentModel{}, status.Error(codes.NotFound, err.Error())
+	}
+
+	err = e.checkEntityOwner(ctx, att.EntityID, userID)
+	if err != nil {
+		return AttachmentModel{}, status.Errorf(codes.NotFound, "no attachment with id: %v", id)
+	}
+
+	return att, nil
+}
+
+// checkEntityOwner сущность существует, не находится в корзине и принадлежит пользователю
+func (e *Entity) checkEntityOwner(ctx context.Context, entityID int32, userID int32) error {
+	ent, err := e.repoEntity.GetEntity(ctx, entityID)
+	if err != nil || ent.UserID != userID {
+		return status.Errorf(codes.NotFound, "no entity with id: %v", entityID)
+	}
+
+	return nil
+}
+
+// scheduleAttachmentsRemoval отложенное (после фиксации) удаление папок с фрагментами вложений сущности
+func scheduleAttachmentsRemoval(ctx context.Context, repo EntityRepo, entityID int32, blobs *blobWork) error {
+	list, err := repo.GetAttachments(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	for _, att := range list {
+		blobs.removed = append(blobs.removed, path.Dir(att.Servername)+"/")
+	}
+
+	return nil
+}
+
+// newBlobDir создание папки ../filebank/<parts...>/<случайная_строка> для файлов данных
+// Возвращает путь к папке и случайную строку, используемую как основа имен файлов
+func newBlobDir(parts ...string) (string, string, error) {
+	b := make([]byte, 10)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+	randName := hex.EncodeToString(b)
+
+	execPath, _ := os.Executable()
+	dir := filepath.Join(append([]string{filepath.Dir(execPath), constants.FileBankDir}, append(parts, randName)...)...)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return "", "", err
+	}
+
+	return dir, randName, nil
+}
+
+// chunkFilename имя файла фрагмента с индексом index: <папка>/<индекс>_<имя файла>
+func chunkFilename(servername string, index int32) string {
+	return fmt.Sprintf("%v/%06d_%v", path.Dir(servername), index, path.Base(servername))
+}
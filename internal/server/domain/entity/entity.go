@@ -5,21 +5,22 @@ package entity
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path"
-	"path/filepath"
-	"strings"
+	"sort"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/dnsoftware/gophkeeper/internal/constants"
 	pb "github.com/dnsoftware/gophkeeper/internal/proto"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
 	"github.com/dnsoftware/gophkeeper/logger"
 )
 
@@ -29,7 +30,7 @@ type EntityRepo interface {
 	CreateEntity(ctx context.Context, entity EntityModel) (int32, error)
 	// UpdateEntity обновление (редактирование) существующей сущности
 	UpdateEntity(ctx context.Context, entity EntityModel) error
-	// DeleteEntity удаление сущности
+	// DeleteEntity удаление сущности (перемещение в корзину)
 	DeleteEntity(ctx context.Context, id int32, userID int32) error
 	// GetEntity получение сущности
 	GetEntity(ctx context.Context, id int32) (EntityModel, error)
@@ -37,13 +38,52 @@ type EntityRepo interface {
 	GetBinaryFilenameByEntityID(ctx context.Context, entityID int32) (string, error)
 	// SetChunkCountForCryptoBinary сохранение количества частей, на которые разбит файл с бинарными данными
 	SetChunkCountForCryptoBinary(ctx context.Context, entityID int32, chunkCount int32) error
-	// GetEntityListByType получение списка сущностей определенного типа
-	GetEntityListByType(ctx context.Context, etype string, userID int32) (map[int32][]string, error)
+	// GetEntityListByType получение списка сущностей определенного типа в порядке возрастания ID
+	// Фильтр отбирает сущности папки, с тегом или избранные (Recent не учитывается), при фильтре пустой etype - сущности всех типов
+	GetEntityListByType(ctx context.Context, etype string, userID int32, filter ListFilter) ([]EntitySummary, error)
+	// ListEntities страница списка сущностей пользователя: отбор, сортировка, пропуск до положения
+	// последней сущности предыдущей страницы и ограничение количества выполняются хранилищем
+	ListEntities(ctx context.Context, userID int32, page ListPage) ([]EntitySummary, error)
+	// SetFavorite добавление сущности пользователя в избранное или удаление из него
+	SetFavorite(ctx context.Context, id int32, userID int32, favorite bool) error
+	// TouchEntity запись времени получения сущности пользователя
+	TouchEntity(ctx context.Context, id int32, userID int32, accessedAt time.Time) error
+	// SearchEntities поиск сущностей пользователя, у которых есть все указанные токены слепого индекса
+	// Пустой etype - сущности всех типов
+	SearchEntities(ctx context.Context, userID int32, etype string, tokens []string) ([]FoundEntity, error)
+	// GetTrashList получение списка сущностей пользователя, находящихся в корзине
+	GetTrashList(ctx context.Context, userID int32) ([]TrashItem, error)
+	// GetDeletedEntity получение сущности пользователя, находящейся в корзине
+	GetDeletedEntity(ctx context.Context, id int32, userID int32) (EntityModel, error)
+	// RestoreEntity восстановление сущности из корзины
+	RestoreEntity(ctx context.Context, id int32, userID int32) error
+	// PurgeEntity окончательное удаление сущности, находящейся в корзине
+	PurgeEntity(ctx context.Context, id int32, userID int32) error
+	// GetExpiredTrash получение сущностей, перемещенных в корзину ранее указанного момента
+	GetExpiredTrash(ctx context.Context, deletedBefore time.Time) ([]TrashItem, error)
+	// LockEntity блокировка сущности до конца транзакции: транзакции, заблокировавшие одну сущность,
+	// выполняются по очереди. Ошибка, если сущности нет
+	LockEntity(ctx context.Context, id int32) error
+	// CreateAttachment сохранение описания вложения сущности
+	CreateAttachment(ctx context.Context, att AttachmentModel) (int32, error)
+	// GetAttachments вложения сущности в порядке добавления
+	GetAttachments(ctx context.Context, entityID int32) ([]AttachmentModel, error)
+	// GetAttachment получение вложения по ID
+	GetAttachment(ctx context.Context, id int32) (AttachmentModel, error)
+	// DeleteAttachment удаление описания вложения
+	DeleteAttachment(ctx context.Context, id int32) error
+	// Transaction выполнение набора операций с хранилищем в одной транзакции (единица работы)
+	// fn получает хранилище, привязанное к транзакции. Если fn вернула ошибку - транзакция откатывается
+	Transaction(ctx context.Context, fn func(repo EntityRepo) error) error
 }
 
 // FieldRepo интерфейс работы с базой данных (таблицей) описаний полей сущностей
 type FieldRepo interface {
 	IsFieldType(ctx context.Context, id int32, ftype string) (bool, error)
+	// GetEntityFields описания полей типа сущности, у несуществующего типа полей нет
+	GetEntityFields(ctx context.Context, etype string) ([]field.EntityFields, error)
+	// GetEntityCode тип сущности по коду, у несуществующего типа пустой Etype
+	GetEntityCode(ctx context.Context, etype string) (entity_code.CodeModel, error)
 }
 
 // Property свойство сущности
@@ -64,13 +104,99 @@ type Metainfo struct {
 
 // EntityModel данные сущности
 type EntityModel struct {
+	ID         int32      // уникальный ID
+	UserID     int32      // код пользователя
+	Etype      string     // тип сущности
+	Props      []Property // набор свойства сущности
+	Metainfo   []Metainfo // набор метаинформации по сущности
+	Tokens     []string   // токены слепого индекса (HMAC, вычисленные клиентом от метаинформации)
+	FolderID   int32      // код папки, 0 - вне папок
+	Tags       []string   // теги (зашифрованы клиентом)
+	Favorite   bool       // сущность в избранном
+	AccessedAt time.Time  // время последнего получения сущности, нулевое - не открывалась
+}
+
+// EntitySummary элемент списка сущностей
+type EntitySummary struct {
+	ID         int32      // уникальный ID
+	Etype      string     // тип сущности
+	FolderID   int32      // код папки, 0 - вне папок
+	Metainfo   []Metainfo // набор метаинформации по сущности
+	Favorite   bool       // сущность в избранном
+	CreatedAt  time.Time  // время создания
+	UpdatedAt  time.Time  // время последнего изменения, нулевое - не изменялась
+	AccessedAt time.Time  // время последнего получения сущности, нулевое - не открывалась
+	Revision   int64      // номер ревизии, увеличивается при каждом сохранении изменений
+}
+
+// Title описание сущности для списка: JSON объект название:значение из метаинформации
+// У сущности без метаинформации - объект с пустым названием
+func (s EntitySummary) Title() string {
+	a := make(map[string]string)
+	for _, m := range s.Metainfo {
+		a[m.Title] = m.Value
+	}
+	if len(s.Metainfo) == 0 {
+		a[""] = ""
+	}
+	meta, _ := json.Marshal(a)
+
+	return string(meta)
+}
+
+// ListFilter фильтр списка сущностей
+type ListFilter struct {
+	FolderID int32  // код папки: 0 - без фильтра, constants.RootFolder - только сущности вне папок
+	TagToken string // токен слепого индекса тега, пустая строка - без фильтра
+	Favorite bool   // только избранные сущности
+	Recent   int32  // только N последних открытых сущностей, 0 - без фильтра
+}
+
+// Empty фильтр не задан
+func (f ListFilter) Empty() bool {
+	return f.FolderID == 0 && f.TagToken == "" && !f.Favorite && f.Recent == 0
+}
+
+// Match сущность из папки folderID с токенами слепого индекса tokens проходит фильтр
+// Фильтр Recent здесь не проверяется: он зависит от остальных сущностей пользователя
+func (f ListFilter) Match(folderID int32, favorite bool, tokens []string) bool {
+	switch {
+	case f.FolderID == constants.RootFolder && folderID != 0:
+		return false
+	case f.FolderID > 0 && folderID != f.FolderID:
+		return false
+	case f.Favorite && !favorite:
+		return false
+	}
+
+	if f.TagToken == "" {
+		return true
+	}
+	for _, token := range tokens {
+		if token == f.TagToken {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FoundEntity сущность, найденная по токенам слепого индекса
+type FoundEntity struct {
 	ID       int32      // уникальный ID
-	UserID   int32      // код пользователя
 	Etype    string     // тип сущности
-	Props    []Property // набор свойства сущности
 	Metainfo []Metainfo // набор метаинформации по сущности
 }
 
+// TrashItem сущность, находящаяся в корзине
+type TrashItem struct {
+	ID        int32      // уникальный ID
+	UserID    int32      // код пользователя
+	Etype     string     // тип сущности
+	Metainfo  []Metainfo // набор метаинформации по сущности
+	DeletedAt time.Time  // время перемещения в корзину
+}
+
 // Entity все манипуляции с сущностями (получение, добавление, удаление, редкатирование)
 type Entity struct {
 	repoEntity EntityRepo // работа с хранилищем сущностей
@@ -96,47 +222,24 @@ func NewEntity(repoEntity EntityRepo, repoField FieldRepo) (*Entity, error) {
 }
 
 // AddEntity добавление сущности
+// Записи в базе и файлы бинарных данных создаются в одной единице работы:
+// при ошибке сохранения в базу созданные папки файлового хранилища удаляются
 func (e *Entity) AddEntity(ctx context.Context, entity EntityModel) (int32, error) {
+	err := e.validateEntity(ctx, entity.Etype, entity, true)
+	if err != nil {
+		return 0, err
+	}
 
-	// если среди добавляемых свойств есть ftype=path (означает что данные должны быть сохранены в файле)
-	// создаем файл
-	// ../filebank/<etype>/<код_пользователя>/<случайная_строка_как_имя_директории>/<chunk_index+эта_же_случайная_строка_как_имя_файла> и запоминаем путь к файлу как свойство
-	for i, val := range entity.Props {
-		isType, _ := e.repoField.IsFieldType(ctx, val.FieldID, constants.FieldTypePath)
-		if !isType {
-			continue
-		}
-
-		path, _ := os.Executable()
-		useridStr := fmt.Sprintf("%v", entity.UserID)
-
-		c := 10
-		b := make([]byte, c)
-		rand.Read(b)
-		randName := hex.EncodeToString(b)
-
-		fileBankDir := filepath.Dir(path) + "/" + constants.FileBankDir + "/" + entity.Etype + "/" + useridStr + "/" + randName
-		err := os.MkdirAll(fileBankDir, os.ModePerm)
+	var id int32
+	err = e.unitOfWork(ctx, func(repo EntityRepo, blobs *blobWork) error {
+		err := e.prepareBinaryProps(ctx, &entity, blobs)
 		if err != nil {
-			return 0, err
-		}
-		fileBankPath := fileBankDir + "/" + randName
-		f, err := os.Create(fileBankPath)
-		if err != nil {
-			return 0, err
-		}
-		f.Close()
-
-		p := BinaryFileProperty{
-			Servername: fileBankPath,
-			Clientname: val.Value,
+			return err
 		}
-		propval, _ := json.Marshal(p)
-
-		entity.Props[i].Value = string(propval)
-	}
 
-	id, err := e.repoEntity.CreateEntity(ctx, entity)
+		id, err = repo.CreateEntity(ctx, entity)
+		return err
+	})
 	if err != nil {
 		return 0, err
 	}
@@ -144,89 +247,77 @@ func (e *Entity) AddEntity(ctx context.Context, entity EntityModel) (int32, erro
 	return id, nil
 }
 
-// Entity получение сущности
-func (e *Entity) Entity(ctx context.Context, id int32) (*EntityModel, error) {
+// Entity получение сущности пользователя
+// Если сущность открыта пользователем (touch), время получения запоминается для списка последних открытых,
+// в ответе остается время предыдущего получения. Чтение для индекса поиска, агента и т.п. время не меняет.
+// Сущность другого пользователя не отдается, и время ее получения не меняется
+func (e *Entity) Entity(ctx context.Context, id int32, userID int32, touch bool) (*EntityModel, error) {
 
 	ent, err := e.repoEntity.GetEntity(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if ent.UserID != userID {
+		return nil, status.Errorf(codes.NotFound, "no entity with id: %v", id)
+	}
+
+	if !touch {
+		return &ent, nil
+	}
+
+	err = e.repoEntity.TouchEntity(ctx, id, userID, time.Now())
+	if err != nil {
+		logger.Log().Error("TouchEntity: " + err.Error())
+	}
 
 	return &ent, nil
 }
 
+// SetFavorite добавление сущности в избранное или удаление из него
+func (e *Entity) SetFavorite(ctx context.Context, id int32, userID int32, favorite bool) error {
+	return e.repoEntity.SetFavorite(ctx, id, userID, favorite)
+}
+
 // SaveEditEntity сохранение отредактированных данных сущности
+// Старые папки с файлами бинарных данных удаляются только после успешной фиксации изменений в базе,
+// при ошибке удаляются вновь созданные папки, а старые остаются на месте
 func (e *Entity) SaveEditEntity(ctx context.Context, entity EntityModel) error {
 
-	// Получаем старую сущность
-	entOld, err := e.repoEntity.GetEntity(ctx, entity.ID)
-	if err != nil {
-		return err
-	}
-
-	// Удаляем старую папку
-	for _, val := range entOld.Props {
-		isType, _ := e.repoField.IsFieldType(ctx, val.FieldID, constants.FieldTypePath)
-		if !isType {
-			continue
-		}
-
-		binprop := &BinaryFileProperty{}
-		err = json.Unmarshal([]byte(val.Value), binprop)
+	return e.unitOfWork(ctx, func(repo EntityRepo, blobs *blobWork) error {
+		// Получаем старую сущность
+		entOld, err := repo.GetEntity(ctx, entity.ID)
 		if err != nil {
 			return err
 		}
 
-		os.RemoveAll(path.Dir(binprop.Servername) + "/")
-	}
-
-	// если среди добавляемых свойств есть ftype=path (означает что данные должны быть сохранены в файле)
-	// удаляем папку со старыми файлами и заводим новую
-	for i, val := range entity.Props {
-		isType, _ := e.repoField.IsFieldType(ctx, val.FieldID, constants.FieldTypePath)
-		if !isType {
-			continue
+		if entOld.UserID != entity.UserID {
+			return fmt.Errorf("no entity with id: %v", entity.ID)
 		}
 
-		// Заводим новую папку
-		path, _ := os.Executable()
-		useridStr := fmt.Sprintf("%v", entity.UserID)
-
-		c := 10
-		b := make([]byte, c)
-		rand.Read(b)
-		randName := hex.EncodeToString(b)
-
-		fileBankDir := filepath.Dir(path) + "/" + constants.FileBankDir + "/" + entity.Etype + "/" + useridStr + "/" + randName
-		err := os.MkdirAll(fileBankDir, os.ModePerm)
+		// тип сущности не меняется, данные проверяются по полям сохраненного типа
+		err = e.validateEntity(ctx, entOld.Etype, entity, false)
 		if err != nil {
 			return err
 		}
-		fileBankPath := fileBankDir + "/" + randName
-		f, err := os.Create(fileBankPath)
+
+		// Старые папки удаляются после фиксации
+		err = e.scheduleBinaryRemoval(ctx, entOld.Props, blobs)
 		if err != nil {
 			return err
 		}
-		f.Close()
 
-		p := BinaryFileProperty{
-			Servername: fileBankPath,
-			Clientname: val.Value,
+		// если среди свойств есть ftype=path - заводим новую папку
+		err = e.prepareBinaryProps(ctx, &entity, blobs)
+		if err != nil {
+			return err
 		}
-		propval, _ := json.Marshal(p)
-
-		entity.Props[i].Value = string(propval)
-	}
-
-	err = e.repoEntity.UpdateEntity(ctx, entity)
-	if err != nil {
-		return err
-	}
 
-	return nil
+		return repo.UpdateEntity(ctx, entity)
+	})
 }
 
-// DeleteEntity удаление сущности
+// DeleteEntity удаление сущности (перемещение в корзину)
+// Файлы бинарных данных остаются на месте до окончательного удаления сущности из корзины
 func (e *Entity) DeleteEntity(ctx context.Context, id int32, userID int32) error {
 
 	// Получаем удаляемую сущность
@@ -235,28 +326,97 @@ func (e *Entity) DeleteEntity(ctx context.Context, id int32, userID int32) error
 		return err
 	}
 
+	if entOld.UserID != userID {
+		return fmt.Errorf("no entity with id: %v", id)
+	}
+
 	err = e.repoEntity.DeleteEntity(ctx, id, userID)
 	if err != nil {
 		return err
 	}
 
-	// Удаляем папку с файлами, если нужно
-	for _, val := range entOld.Props {
-		isType, _ := e.repoField.IsFieldType(ctx, val.FieldID, constants.FieldTypePath)
-		if !isType {
-			continue
+	return nil
+}
+
+// ListTrash получение списка сущностей пользователя, находящихся в корзине
+func (e *Entity) ListTrash(ctx context.Context, userID int32) ([]TrashItem, error) {
+	return e.repoEntity.GetTrashList(ctx, userID)
+}
+
+// RestoreEntity восстановление сущности из корзины
+func (e *Entity) RestoreEntity(ctx context.Context, id int32, userID int32) error {
+	return e.repoEntity.RestoreEntity(ctx, id, userID)
+}
+
+// PurgeEntity окончательное удаление сущности из корзины вместе с файлами бинарных данных и вложениями
+func (e *Entity) PurgeEntity(ctx context.Context, id int32, userID int32) error {
+
+	return e.unitOfWork(ctx, func(repo EntityRepo, blobs *blobWork) error {
+		// Получаем удаляемую сущность
+		entOld, err := repo.GetDeletedEntity(ctx, id, userID)
+		if err != nil {
+			return err
 		}
 
-		binprop := &BinaryFileProperty{}
-		err = json.Unmarshal([]byte(val.Value), binprop)
+		// Папки с файлами удаляются после фиксации
+		err = e.scheduleBinaryRemoval(ctx, entOld.Props, blobs)
 		if err != nil {
 			return err
 		}
+		err = scheduleAttachmentsRemoval(ctx, repo, id, blobs)
+		if err != nil {
+			return err
+		}
+
+		return repo.PurgeEntity(ctx, id, userID)
+	})
+}
+
+// PurgeExpiredTrash окончательное удаление сущностей, пролежавших в корзине дольше срока хранения
+// Ошибка удаления одной сущности не останавливает очистку остальных:
+// возвращает количество удаленных сущностей и объединенные ошибки неудавшихся
+func (e *Entity) PurgeExpiredTrash(ctx context.Context, retention time.Duration) (int, error) {
 
-		os.RemoveAll(path.Dir(binprop.Servername) + "/")
+	expired, err := e.repoEntity.GetExpiredTrash(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	purged := 0
+	var errs []error
+	for _, item := range expired {
+		err = e.PurgeEntity(ctx, item.ID, item.UserID)
+		if err != nil {
+			logger.Log().Error(fmt.Sprintf("PurgeEntity %v: %v", item.ID, err))
+			errs = append(errs, fmt.Errorf("entity %v: %w", item.ID, err))
+			continue
+		}
+		purged++
+	}
+
+	return purged, errors.Join(errs...)
+}
+
+// RunTrashPurge периодическая очистка корзины, работает до отмены контекста
+func (e *Entity) RunTrashPurge(ctx context.Context, retention time.Duration, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := e.PurgeExpiredTrash(ctx, retention)
+		if err != nil {
+			logger.Log().Error("PurgeExpiredTrash: " + err.Error())
+		}
+		if purged > 0 {
+			logger.Log().Info(fmt.Sprintf("Из корзины окончательно удалено сущностей: %v", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
 
 // UploadBinary загрузка незашифрованных бинарных данных (клиент -> сервер)
@@ -490,22 +650,62 @@ func (e *Entity) DownloadCryptoBinary(entityID int32, stream pb.Keeper_DownloadC
 	return nil
 }
 
+// SearchEntities поиск сущностей пользователя по токенам слепого индекса
+// Сервер сравнивает только токены и не видит открытых значений, по которым они вычислены
+func (e *Entity) SearchEntities(ctx context.Context, userID int32, etype string, tokens []string) ([]FoundEntity, error) {
+
+	uniq := make([]string, 0, len(tokens))
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if token == "" || seen[token] {
+			continue
+		}
+		seen[token] = true
+		uniq = append(uniq, token)
+	}
+
+	if len(uniq) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "не указаны токены для поиска")
+	}
+	if len(uniq) > constants.MaxSearchTokens {
+		return nil, status.Errorf(codes.InvalidArgument, "слишком много токенов для поиска: %v (не более %v)", len(uniq), constants.MaxSearchTokens)
+	}
+
+	return e.repoEntity.SearchEntities(ctx, userID, etype, uniq)
+}
+
 // EntityList Получение списка сущностей указанного типа для конкретного пользователя
-// Простая карта с кодом сущности и названием(составляется из метаданных)
-func (e *Entity) EntityList(ctx context.Context, etype string, userID int32) (map[int32]string, error) {
+// Без фильтра тип обязателен, с фильтром пустой тип - сущности всех типов
+func (e *Entity) EntityList(ctx context.Context, etype string, userID int32, filter ListFilter) ([]EntitySummary, error) {
+	if etype == "" && filter.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "не указан тип сущности")
+	}
+	if filter.Recent < 0 || filter.Recent > constants.MaxRecentEntities {
+		return nil, status.Errorf(codes.InvalidArgument, "количество последних сущностей должно быть от 1 до %v", constants.MaxRecentEntities)
+	}
 
-	list, err := e.repoEntity.GetEntityListByType(ctx, etype, userID)
+	list, err := e.repoEntity.GetEntityListByType(ctx, etype, userID, filter)
+	if err != nil || filter.Recent == 0 {
+		return list, err
+	}
 
-	data := make(map[int32]string, len(list))
-	for key, val := range list {
-		a := make(map[string]string)
-		for _, v := range val {
-			parts := strings.Split(v, ":")
-			a[parts[0]] = parts[1]
+	return recent(list, int(filter.Recent)), nil
+}
+
+// recent n последних открытых сущностей списка, начиная с последней
+func recent(list []EntitySummary, n int) []EntitySummary {
+	opened := make([]EntitySummary, 0, len(list))
+	for _, s := range list {
+		if !s.AccessedAt.IsZero() {
+			opened = append(opened, s)
 		}
-		meta, _ := json.Marshal(a)
-		data[key] = string(meta)
+	}
+	sort.SliceStable(opened, func(i, j int) bool {
+		return opened[i].AccessedAt.After(opened[j].AccessedAt)
+	})
+	if len(opened) > n {
+		opened = opened[:n]
 	}
 
-	return data, err
+	return opened
 }
@@ -0,0 +1,210 @@
+package entity_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	mock_domain "github.com/dnsoftware/gophkeeper/internal/server/mocks"
+)
+
+// expectTransaction единица работы в моке выполняется поверх того же мока хранилища
+func expectTransaction(repoEntity *mock_domain.MockEntityRepo) {
+	repoEntity.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, fn func(repo entity.EntityRepo) error) error {
+			return fn(repoEntity)
+		}).AnyTimes()
+}
+
+func TestTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoFields := mock_domain.NewMockFieldRepo(ctrl)
+	repoEntity := mock_domain.NewMockEntityRepo(ctrl)
+	entityService, _ := entity.NewEntity(repoEntity, repoFields)
+	expectTransaction(repoEntity)
+
+	ctx := context.Background()
+
+	t.Run("delete foreign", func(t *testing.T) {
+		repoEntity.EXPECT().GetEntity(ctx, int32(1)).Return(entity.EntityModel{ID: 1, UserID: 2}, nil)
+
+		err := entityService.DeleteEntity(ctx, 1, 1)
+		require.Error(t, err)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		repoEntity.EXPECT().RestoreEntity(ctx, int32(1), int32(1)).Return(nil)
+
+		err := entityService.RestoreEntity(ctx, 1, 1)
+		require.NoError(t, err)
+	})
+
+	t.Run("purge not in trash", func(t *testing.T) {
+		repoEntity.EXPECT().GetDeletedEntity(ctx, int32(1), int32(1)).Return(entity.EntityModel{}, errors.New("testerr"))
+
+		err := entityService.PurgeEntity(ctx, 1, 1)
+		require.Error(t, err)
+	})
+
+	t.Run("purge binary", func(t *testing.T) {
+		dir := t.TempDir() + "/bank"
+		require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+		value, _ := json.Marshal(entity.BinaryFileProperty{Servername: dir + "/file"})
+
+		ent := entity.EntityModel{
+			ID:     1,
+			UserID: 1,
+			Etype:  "binary",
+			Props:  []entity.Property{{EntityID: 1, FieldID: 7, Value: string(value)}},
+		}
+		attDir := t.TempDir() + "/attachment"
+		require.NoError(t, os.MkdirAll(attDir, os.ModePerm))
+		repoEntity.EXPECT().GetDeletedEntity(ctx, int32(1), int32(1)).Return(ent, nil)
+		repoEntity.EXPECT().GetAttachments(ctx, int32(1)).Return([]entity.AttachmentModel{{ID: 4, EntityID: 1, Servername: attDir + "/file"}}, nil)
+		repoEntity.EXPECT().PurgeEntity(ctx, int32(1), int32(1)).Return(nil)
+		repoFields.EXPECT().IsFieldType(ctx, int32(7), gomock.Any()).Return(true, nil)
+
+		err := entityService.PurgeEntity(ctx, 1, 1)
+		require.NoError(t, err)
+		assert.NoDirExists(t, dir)
+		assert.NoDirExists(t, attDir)
+	})
+
+	t.Run("purge expired", func(t *testing.T) {
+		expired := []entity.TrashItem{{ID: 1, UserID: 1}, {ID: 2, UserID: 3}}
+		repoEntity.EXPECT().GetExpiredTrash(ctx, gomock.Any()).Return(expired, nil)
+		for _, item := range expired {
+			repoEntity.EXPECT().GetDeletedEntity(ctx, item.ID, item.UserID).Return(entity.EntityModel{ID: item.ID, UserID: item.UserID}, nil)
+			repoEntity.EXPECT().GetAttachments(ctx, item.ID).Return(nil, nil)
+			repoEntity.EXPECT().PurgeEntity(ctx, item.ID, item.UserID).Return(nil)
+		}
+
+		purged, err := entityService.PurgeExpiredTrash(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+
+	t.Run("purge expired partial failure", func(t *testing.T) {
+		// ошибка удаления одной сущности не мешает удалить остальные
+		expired := []entity.TrashItem{{ID: 1, UserID: 1}, {ID: 2, UserID: 3}, {ID: 5, UserID: 1}}
+		repoEntity.EXPECT().GetExpiredTrash(ctx, gomock.Any()).Return(expired, nil)
+		for _, item := range expired {
+			repoEntity.EXPECT().GetDeletedEntity(ctx, item.ID, item.UserID).Return(entity.EntityModel{ID: item.ID, UserID: item.UserID}, nil)
+			repoEntity.EXPECT().GetAttachments(ctx, item.ID).Return(nil, nil)
+		}
+		repoEntity.EXPECT().PurgeEntity(ctx, int32(1), int32(1)).Return(nil)
+		repoEntity.EXPECT().PurgeEntity(ctx, int32(2), int32(3)).Return(errors.New("testerr"))
+		repoEntity.EXPECT().PurgeEntity(ctx, int32(5), int32(1)).Return(nil)
+
+		purged, err := entityService.PurgeExpiredTrash(ctx, time.Hour)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "entity 2: testerr")
+		assert.Equal(t, 2, purged)
+	})
+}
+
+func TestSearchEntities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoFields := mock_domain.NewMockFieldRepo(ctrl)
+	repoEntity := mock_domain.NewMockEntityRepo(ctrl)
+	entityService, _ := entity.NewEntity(repoEntity, repoFields)
+
+	ctx := context.Background()
+
+	t.Run("no tokens", func(t *testing.T) {
+		_, err := entityService.SearchEntities(ctx, 1, "", []string{"", ""})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("too many tokens", func(t *testing.T) {
+		tokens := make([]string, 0, constants.MaxSearchTokens+1)
+		for i := 0; i <= constants.MaxSearchTokens; i++ {
+			tokens = append(tokens, fmt.Sprint(i))
+		}
+		_, err := entityService.SearchEntities(ctx, 1, "", tokens)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unique tokens", func(t *testing.T) {
+		found := []entity.FoundEntity{{ID: 5, Etype: "card"}}
+		repoEntity.EXPECT().SearchEntities(ctx, int32(1), "card", []string{"a", "b"}).Return(found, nil)
+
+		list, err := entityService.SearchEntities(ctx, 1, "card", []string{"a", "b", "a", ""})
+		require.NoError(t, err)
+		assert.Equal(t, found, list)
+	})
+}
+
+func TestEntityListRecent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoFields := mock_domain.NewMockFieldRepo(ctrl)
+	repoEntity := mock_domain.NewMockEntityRepo(ctrl)
+	entityService, _ := entity.NewEntity(repoEntity, repoFields)
+
+	ctx := context.Background()
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	list := []entity.EntitySummary{
+		{ID: 1, Etype: "card", AccessedAt: at},
+		{ID: 2, Etype: "card"},
+		{ID: 3, Etype: "text", AccessedAt: at.Add(time.Hour)},
+		{ID: 4, Etype: "card", AccessedAt: at.Add(-time.Hour), Favorite: true},
+	}
+
+	t.Run("bad recent", func(t *testing.T) {
+		_, err := entityService.EntityList(ctx, "", 1, entity.ListFilter{Recent: constants.MaxRecentEntities + 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = entityService.EntityList(ctx, "", 1, entity.ListFilter{Recent: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("recent", func(t *testing.T) {
+		filter := entity.ListFilter{Recent: 2}
+		repoEntity.EXPECT().GetEntityListByType(ctx, "", int32(1), filter).Return(list, nil)
+
+		recent, err := entityService.EntityList(ctx, "", 1, filter)
+		require.NoError(t, err)
+		require.Len(t, recent, 2)
+		assert.Equal(t, int32(3), recent[0].ID)
+		assert.Equal(t, int32(1), recent[1].ID)
+	})
+
+	t.Run("access time", func(t *testing.T) {
+		repoEntity.EXPECT().GetEntity(ctx, int32(4)).Return(entity.EntityModel{ID: 4, UserID: 1, AccessedAt: at}, nil)
+		repoEntity.EXPECT().TouchEntity(ctx, int32(4), int32(1), gomock.Any()).Return(errors.New("testerr"))
+
+		// ошибка записи времени не мешает получению сущности
+		ent, err := entityService.Entity(ctx, 4, 1, true)
+		require.NoError(t, err)
+		assert.Equal(t, at, ent.AccessedAt)
+
+		// сущность другого пользователя не отдается и время ее получения не записывается
+		repoEntity.EXPECT().GetEntity(ctx, int32(4)).Return(entity.EntityModel{ID: 4, UserID: 2, AccessedAt: at}, nil)
+		_, err = entityService.Entity(ctx, 4, 1, true)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// чтение без открытия пользователем время получения не записывает
+		repoEntity.EXPECT().GetEntity(ctx, int32(4)).Return(entity.EntityModel{ID: 4, UserID: 1, AccessedAt: at}, nil)
+		_, err = entityService.Entity(ctx, 4, 1, false)
+		require.NoError(t, err)
+	})
+
+	assert.Equal(t, `{"":""}`, list[1].Title())
+}
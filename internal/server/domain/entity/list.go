@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+)
+
+// ListSort порядок сортировки списка сущностей
+type ListSort int32
+
+// поля сортировки, при равных значениях сущности упорядочены по ID
+const (
+	SortID       ListSort = iota // по ID (порядок создания)
+	SortCreated                  // по времени создания
+	SortUpdated                  // по времени последнего изменения
+	SortAccessed                 // по времени последнего получения
+)
+
+// ListQuery запрос страницы списка сущностей
+type ListQuery struct {
+	Etypes    []string   // типы сущностей, пустой - все типы
+	Filter    ListFilter // отбор по папке, тегу и избранному (Recent не используется)
+	Sort      ListSort   // поле сортировки
+	Desc      bool       // сортировка по убыванию
+	PageSize  int32      // размер страницы, 0 - constants.DefaultPageSize
+	PageToken string     // токен страницы из предыдущего ответа, пустой - первая страница
+}
+
+// EntityPage страница списка сущностей
+type EntityPage struct {
+	Items         []EntitySummary // сущности страницы
+	NextPageToken string          // токен следующей страницы, пустой - страница последняя
+}
+
+// ListPage запрос страницы списка к хранилищу
+// Хранилище отбирает сущности, упорядочивает их по полю сортировки и ID, пропускает сущности
+// до After включительно и возвращает не больше Limit сущностей
+type ListPage struct {
+	Etypes []string   // типы сущностей, пустой - все типы
+	Filter ListFilter // отбор по папке, тегу и избранному (Recent не используется)
+	Sort   ListSort   // поле сортировки
+	Desc   bool       // сортировка по убыванию
+	After  *PageKey   // положение последней сущности предыдущей страницы, nil - первая страница
+	Limit  int32      // наибольшее количество сущностей
+}
+
+// PageKey положение сущности в списке: значение поля сортировки и ID
+type PageKey struct {
+	Time time.Time // время сортировки, нулевое - время не задано (раньше любого другого), при SortID не используется
+	ID   int32
+}
+
+// Key положение сущности в списке с сортировкой запроса
+func (p ListPage) Key(s EntitySummary) PageKey {
+	key := PageKey{ID: s.ID}
+	switch p.Sort {
+	case SortCreated:
+		key.Time = s.CreatedAt
+	case SortUpdated:
+		key.Time = s.UpdatedAt
+	case SortAccessed:
+		key.Time = s.AccessedAt
+	}
+
+	return key
+}
+
+// Before сущность с положением a идет в списке раньше сущности с положением b
+func (p ListPage) Before(a, b PageKey) bool {
+	if p.Desc {
+		a, b = b, a
+	}
+	if !a.Time.Equal(b.Time) {
+		return a.Time.Before(b.Time)
+	}
+
+	return a.ID < b.ID
+}
+
+// pageToken положение в списке: ключ сортировки и ID последней сущности предыдущей страницы
+// Сортировка запоминается, чтобы токен нельзя было применить к списку в другом порядке
+type pageToken struct {
+	Sort ListSort `json:"s"`
+	Desc bool     `json:"d"`
+	Key  int64    `json:"k"` // время сортировки в наносекундах, 0 - время не задано
+	ID   int32    `json:"i"`
+}
+
+// ListEntities страница списка сущностей пользователя
+// Страницы отсчитываются от последней выданной сущности, поэтому добавление и удаление
+// сущностей между запросами не приводит к пропускам и повторам
+func (e *Entity) ListEntities(ctx context.Context, userID int32, query ListQuery) (EntityPage, error) {
+	if query.Sort < SortID || query.Sort > SortAccessed {
+		return EntityPage{}, status.Errorf(codes.InvalidArgument, "неизвестный порядок сортировки: %v", query.Sort)
+	}
+	if query.PageSize < 0 || query.PageSize > constants.MaxPageSize {
+		return EntityPage{}, status.Errorf(codes.InvalidArgument, "размер страницы должен быть от 1 до %v", constants.MaxPageSize)
+	}
+	if query.PageSize == 0 {
+		query.PageSize = constants.DefaultPageSize
+	}
+
+	page := ListPage{
+		Etypes: query.Etypes,
+		Filter: query.Filter,
+		Sort:   query.Sort,
+		Desc:   query.Desc,
+		Limit:  query.PageSize + 1, // лишняя сущность показывает, что страница не последняя
+	}
+	page.Filter.Recent = 0
+	if query.PageToken != "" {
+		token, err := decodePageToken(query.PageToken)
+		if err != nil || token.Sort != query.Sort || token.Desc != query.Desc {
+			return EntityPage{}, status.Error(codes.InvalidArgument, "неверный токен страницы")
+		}
+		page.After = &PageKey{ID: token.ID}
+		if token.Key != 0 {
+			page.After.Time = time.Unix(0, token.Key).UTC()
+		}
+	}
+
+	list, err := e.repoEntity.ListEntities(ctx, userID, page)
+	if err != nil {
+		return EntityPage{}, err
+	}
+
+	res := EntityPage{Items: list}
+	if len(list) > int(query.PageSize) {
+		res.Items = list[:query.PageSize]
+		last := page.Key(res.Items[len(res.Items)-1])
+		token := pageToken{Sort: query.Sort, Desc: query.Desc, ID: last.ID}
+		if !last.Time.IsZero() {
+			token.Key = last.Time.UnixNano()
+		}
+		res.NextPageToken = encodePageToken(token)
+	}
+
+	return res, nil
+}
+
+// encodePageToken непрозрачный для клиента токен страницы
+func encodePageToken(token pageToken) string {
+	data, _ := json.Marshal(token)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken разбор токена страницы
+func decodePageToken(str string) (pageToken, error) {
+	var token pageToken
+	data, err := base64.RawURLEncoding.DecodeString(str)
+	if err != nil {
+		return token, err
+	}
+	err = json.Unmarshal(data, &token)
+
+	return token, err
+}
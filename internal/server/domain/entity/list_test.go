@@ -0,0 +1,102 @@
+package entity_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	mock_domain "github.com/dnsoftware/gophkeeper/internal/server/mocks"
+)
+
+func TestListEntities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoFields := mock_domain.NewMockFieldRepo(ctrl)
+	repoEntity := mock_domain.NewMockEntityRepo(ctrl)
+	entityService, _ := entity.NewEntity(repoEntity, repoFields)
+
+	ctx := context.Background()
+	at := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	list := func() []entity.EntitySummary {
+		return []entity.EntitySummary{
+			{ID: 1, Etype: "card", UpdatedAt: at},
+			{ID: 2, Etype: "text"},
+			{ID: 3, Etype: "logopas", UpdatedAt: at.Add(time.Hour)},
+			{ID: 4, Etype: "card", UpdatedAt: at},
+		}
+	}
+	ids := func(page entity.EntityPage) []int32 {
+		var ids []int32
+		for _, item := range page.Items {
+			ids = append(ids, item.ID)
+		}
+		return ids
+	}
+
+	t.Run("bad query", func(t *testing.T) {
+		_, err := entityService.ListEntities(ctx, 1, entity.ListQuery{Sort: entity.SortAccessed + 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = entityService.ListEntities(ctx, 1, entity.ListQuery{PageSize: constants.MaxPageSize + 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = entityService.ListEntities(ctx, 1, entity.ListQuery{PageToken: "!"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		first := entity.ListPage{Sort: entity.SortUpdated, Desc: true, Limit: 4}
+		repoEntity.EXPECT().ListEntities(ctx, int32(1), first).Return(list(), nil)
+
+		query := entity.ListQuery{Sort: entity.SortUpdated, Desc: true, PageSize: 3}
+		page, err := entityService.ListEntities(ctx, 1, query)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{1, 2, 3}, ids(page))
+		require.NotEmpty(t, page.NextPageToken)
+
+		// токен другой сортировки не принимается
+		_, err = entityService.ListEntities(ctx, 1, entity.ListQuery{PageToken: page.NextPageToken})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// следующая страница запрашивается от последней сущности, время без зоны восстанавливается в UTC
+		next := first
+		next.After = &entity.PageKey{Time: at.Add(time.Hour), ID: 3}
+		repoEntity.EXPECT().ListEntities(ctx, int32(1), next).Return(list()[3:], nil)
+		query.PageToken = page.NextPageToken
+		page, err = entityService.ListEntities(ctx, 1, query)
+		require.NoError(t, err)
+		assert.Equal(t, []int32{4}, ids(page))
+		assert.Empty(t, page.NextPageToken)
+
+		// у сущности без времени сортировки в токене остается только ID
+		repoEntity.EXPECT().ListEntities(ctx, int32(1), entity.ListPage{Sort: entity.SortUpdated, Limit: 2}).Return(list()[1:3], nil)
+		page, err = entityService.ListEntities(ctx, 1, entity.ListQuery{Sort: entity.SortUpdated, PageSize: 1})
+		require.NoError(t, err)
+		repoEntity.EXPECT().ListEntities(ctx, int32(1), entity.ListPage{Sort: entity.SortUpdated, Limit: 2, After: &entity.PageKey{ID: 2}}).Return(nil, nil)
+		_, err = entityService.ListEntities(ctx, 1, entity.ListQuery{Sort: entity.SortUpdated, PageSize: 1, PageToken: page.NextPageToken})
+		require.NoError(t, err)
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		repoEntity.EXPECT().ListEntities(ctx, int32(1), entity.ListPage{
+			Etypes: []string{"card", "text"},
+			Filter: entity.ListFilter{Favorite: true},
+			Limit:  constants.DefaultPageSize + 1,
+		}).Return(list(), nil)
+
+		page, err := entityService.ListEntities(ctx, 1, entity.ListQuery{
+			Etypes: []string{"card", "text"},
+			Filter: entity.ListFilter{Favorite: true, Recent: 5},
+		})
+		require.NoError(t, err)
+		assert.Len(t, page.Items, 4)
+		assert.Empty(t, page.NextPageToken)
+	})
+}
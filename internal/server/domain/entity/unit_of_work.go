@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/logger"
+)
+
+// blobWork операции с файловым хранилищем, выполняемые в рамках единицы работы
+type blobWork struct {
+	created []string // папки, созданные в ходе единицы работы (удаляются при откате)
+	removed []string // папки, которые нужно удалить после фиксации
+}
+
+// rollback компенсирующее удаление созданных папок
+func (b *blobWork) rollback() {
+	for _, dir := range b.created {
+		err := os.RemoveAll(dir)
+		if err != nil {
+			logger.Log().Error("blob rollback: " + err.Error())
+		}
+	}
+}
+
+// commit удаление папок, которые больше не используются
+func (b *blobWork) commit() {
+	for _, dir := range b.removed {
+		err := os.RemoveAll(dir)
+		if err != nil {
+			logger.Log().Error("blob commit: " + err.Error())
+		}
+	}
+}
+
+// unitOfWork выполнение fn в транзакции хранилища вместе с операциями над файловым хранилищем
+// Записи в базе и файлы либо сохраняются вместе, либо не сохраняются вовсе
+func (e *Entity) unitOfWork(ctx context.Context, fn func(repo EntityRepo, blobs *blobWork) error) error {
+	blobs := &blobWork{}
+
+	err := e.repoEntity.Transaction(ctx, func(repo EntityRepo) error {
+		return fn(repo, blobs)
+	})
+	if err != nil {
+		blobs.rollback()
+		return err
+	}
+
+	blobs.commit()
+
+	return nil
+}
+
+// prepareBinaryProps если среди свойств есть ftype=path (означает что данные должны быть сохранены в файле)
+// создаем файл
+// ../filebank/<etype>/<код_пользователя>/<случайная_строка_как_имя_директории>/<chunk_index+эта_же_случайная_строка_как_имя_файла> и запоминаем путь к файлу как свойство
+func (e *Entity) prepareBinaryProps(ctx context.Context, entity *EntityModel, blobs *blobWork) error {
+	for i, val := range entity.Props {
+		isType, _ := e.repoField.IsFieldType(ctx, val.FieldID, constants.FieldTypePath)
+		if !isType {
+			continue
+		}
+
+		execPath, _ := os.Executable()
+		useridStr := fmt.Sprintf("%v", entity.UserID)
+
+		b := make([]byte, 10)
+		_, err := rand.Read(b)
+		if err != nil {
+			return err
+		}
+		randName := hex.EncodeToString(b)
+
+		fileBankDir := filepath.Dir(execPath) + "/" + constants.FileBankDir + "/" + entity.Etype + "/" + useridStr + "/" + randName
+		err = os.MkdirAll(fileBankDir, os.ModePerm)
+		if err != nil {
+			return err
+		}
+		blobs.created = append(blobs.created, fileBankDir)
+
+		fileBankPath := fileBankDir + "/" + randName
+		f, err := os.Create(fileBankPath)
+		if err != nil {
+			return err
+		}
+		f.Close()
+
+		p := BinaryFileProperty{
+			Servername: fileBankPath,
+			Clientname: val.Value,
+		}
+		propval, _ := json.Marshal(p)
+
+		entity.Props[i].Value = string(propval)
+	}
+
+	return nil
+}
+
+// scheduleBinaryRemoval отложенное (после фиксации) удаление папок с файлами бинарных данных,
+// на которые ссылаются свойства сущности
+func (e *Entity) scheduleBinaryRemoval(ctx context.Context, props []Property, blobs *blobWork) error {
+	for _, val := range props {
+		isType, _ := e.repoField.IsFieldType(ctx, val.FieldID, constants.FieldTypePath)
+		if !isType {
+			continue
+		}
+
+		binprop := &BinaryFileProperty{}
+		err := json.Unmarshal([]byte(val.Value), binprop)
+		if err != nil {
+			return err
+		}
+
+		blobs.removed = append(blobs.removed, path.Dir(binprop.Servername)+"/")
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package entity_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+	mock_domain "github.com/dnsoftware/gophkeeper/internal/server/mocks"
+)
+
+func TestUnitOfWork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoFields := mock_domain.NewMockFieldRepo(ctrl)
+	repoEntity := mock_domain.NewMockEntityRepo(ctrl)
+	entityService, _ := entity.NewEntity(repoEntity, repoFields)
+	expectTransaction(repoEntity)
+
+	ctx := context.Background()
+	repoFields.EXPECT().GetEntityFields(ctx, "binary").Return([]field.EntityFields{
+		{ID: 7, Etype: "binary", Ftype: constants.FieldTypePath, ValidateRules: "required,file"},
+	}, nil).AnyTimes()
+	repoFields.EXPECT().GetEntityCode(ctx, "binary").Return(entity_code.CodeModel{Etype: "binary", Name: "Бинарные данные"}, nil).AnyTimes()
+
+	// servername путь к файлу из свойства бинарной сущности
+	servername := func(t *testing.T, value string) string {
+		binprop := &entity.BinaryFileProperty{}
+		require.NoError(t, json.Unmarshal([]byte(value), binprop))
+		return binprop.Servername
+	}
+
+	newEntity := func() entity.EntityModel {
+		return entity.EntityModel{
+			ID:     1,
+			UserID: 1,
+			Etype:  "binary",
+			Props:  []entity.Property{{EntityID: 1, FieldID: 7, Value: "file.txt"}},
+		}
+	}
+
+	t.Run("add rollback", func(t *testing.T) {
+		var created string
+		repoFields.EXPECT().IsFieldType(ctx, int32(7), gomock.Any()).Return(true, nil)
+		repoEntity.EXPECT().CreateEntity(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ent entity.EntityModel) (int32, error) {
+				created = servername(t, ent.Props[0].Value)
+				return 0, errors.New("testerr")
+			})
+
+		_, err := entityService.AddEntity(ctx, newEntity())
+		require.Error(t, err)
+		require.NotEmpty(t, created)
+		assert.NoFileExists(t, created)
+		assert.NoDirExists(t, path.Dir(created))
+	})
+
+	t.Run("add commit error", func(t *testing.T) {
+		var created string
+		repoUow := mock_domain.NewMockEntityRepo(ctrl)
+		service, _ := entity.NewEntity(repoUow, repoFields)
+		repoUow.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(repo entity.EntityRepo) error) error {
+				err := fn(repoUow)
+				require.NoError(t, err)
+				return errors.New("commit error")
+			})
+		repoFields.EXPECT().IsFieldType(ctx, int32(7), gomock.Any()).Return(true, nil)
+		repoUow.EXPECT().CreateEntity(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ent entity.EntityModel) (int32, error) {
+				created = servername(t, ent.Props[0].Value)
+				return 1, nil
+			})
+
+		_, err := service.AddEntity(ctx, newEntity())
+		require.Error(t, err)
+		assert.NoDirExists(t, path.Dir(created))
+	})
+
+	t.Run("add ok", func(t *testing.T) {
+		var created string
+		repoFields.EXPECT().IsFieldType(ctx, int32(7), gomock.Any()).Return(true, nil)
+		repoEntity.EXPECT().CreateEntity(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ent entity.EntityModel) (int32, error) {
+				created = servername(t, ent.Props[0].Value)
+				return 1, nil
+			})
+
+		id, err := entityService.AddEntity(ctx, newEntity())
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), id)
+		assert.FileExists(t, created)
+		_ = os.RemoveAll(path.Dir(created))
+	})
+
+	// старая сущность с папкой бинарных данных
+	oldEntity := func(t *testing.T) (entity.EntityModel, string) {
+		dir := t.TempDir() + "/bank"
+		require.NoError(t, os.MkdirAll(dir, os.ModePerm))
+		value, _ := json.Marshal(entity.BinaryFileProperty{Servername: dir + "/file"})
+		ent := newEntity()
+		ent.Props[0].Value = string(value)
+		return ent, dir
+	}
+
+	t.Run("edit rollback", func(t *testing.T) {
+		var created string
+		old, oldDir := oldEntity(t)
+		repoEntity.EXPECT().GetEntity(ctx, int32(1)).Return(old, nil)
+		repoFields.EXPECT().IsFieldType(ctx, int32(7), gomock.Any()).Return(true, nil).Times(2)
+		repoEntity.EXPECT().UpdateEntity(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ent entity.EntityModel) error {
+				created = servername(t, ent.Props[0].Value)
+				return errors.New("testerr")
+			})
+
+		err := entityService.SaveEditEntity(ctx, newEntity())
+		require.Error(t, err)
+		assert.DirExists(t, oldDir)
+		assert.NoDirExists(t, path.Dir(created))
+	})
+
+	t.Run("edit foreign", func(t *testing.T) {
+		old, oldDir := oldEntity(t)
+		old.UserID = 2
+		repoEntity.EXPECT().GetEntity(ctx, int32(1)).Return(old, nil)
+
+		err := entityService.SaveEditEntity(ctx, newEntity())
+		require.Error(t, err)
+		assert.DirExists(t, oldDir)
+	})
+
+	t.Run("edit ok", func(t *testing.T) {
+		var created string
+		old, oldDir := oldEntity(t)
+		repoEntity.EXPECT().GetEntity(ctx, int32(1)).Return(old, nil)
+		repoFields.EXPECT().IsFieldType(ctx, int32(7), gomock.Any()).Return(true, nil).Times(2)
+		repoEntity.EXPECT().UpdateEntity(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ent entity.EntityModel) error {
+				created = servername(t, ent.Props[0].Value)
+				return nil
+			})
+
+		err := entityService.SaveEditEntity(ctx, newEntity())
+		require.NoError(t, err)
+		assert.NoDirExists(t, oldDir)
+		assert.FileExists(t, created)
+		_ = os.RemoveAll(path.Dir(created))
+	})
+
+	t.Run("purge rollback", func(t *testing.T) {
+		old, oldDir := oldEntity(t)
+		repoEntity.EXPECT().GetDeletedEntity(ctx, int32(1), int32(1)).Return(old, nil)
+		repoFields.EXPECT().IsFieldType(ctx, int32(7), gomock.Any()).Return(true, nil)
+		repoEntity.EXPECT().GetAttachments(ctx, int32(1)).Return(nil, nil)
+		repoEntity.EXPECT().PurgeEntity(ctx, int32(1), int32(1)).Return(errors.New("testerr"))
+
+		err := entityService.PurgeEntity(ctx, 1, 1)
+		require.Error(t, err)
+		assert.DirExists(t, oldDir)
+	})
+}
@@ -0,0 +1,143 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+)
+
+// violations нарушения, найденные при проверке данных сущности
+type violations []*errdetails.BadRequest_FieldViolation
+
+// add добавление нарушения для поля
+func (v *violations) add(fieldName string, format string, args ...any) {
+	*v = append(*v, &errdetails.BadRequest_FieldViolation{
+		Field:       fieldName,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+// err ошибка codes.InvalidArgument со списком нарушений в деталях, nil - нарушений нет
+// Текст ошибки перечисляет нарушения, чтобы их видели и клиенты, не разбирающие детали
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	parts := make([]string, 0, len(v))
+	for _, fv := range v {
+		parts = append(parts, fv.Field+": "+fv.Description)
+	}
+	st := status.New(codes.InvalidArgument, "данные сущности не прошли проверку: "+strings.Join(parts, "; "))
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// validateEntity проверка данных сущности по описаниям полей типа etype
+// Значения зашифрованы клиентом, поэтому правила validate_rules к ним не применяются:
+// проверяется состав свойств (поля типа, каждое не более одного раза, обязательные - ровно один раз) и размеры.
+// Тип должен быть встроенным или созданным владельцем сущности, новые сущности архивного типа не создаются (isNew)
+func (e *Entity) validateEntity(ctx context.Context, etype string, ent EntityModel, isNew bool) error {
+	var v violations
+
+	if ent.Etype != etype {
+		v.add("etype", "тип сущности %q изменить на %q нельзя", etype, ent.Etype)
+		return v.err()
+	}
+
+	code, err := e.repoField.GetEntityCode(ctx, etype)
+	if err != nil {
+		return err
+	}
+	if code.Etype == "" || (code.UserID != 0 && code.UserID != ent.UserID) {
+		v.add("etype", "неизвестный тип сущности %q", etype)
+		return v.err()
+	}
+	if isNew && code.Archived {
+		v.add("etype", "тип сущности %q в архиве", etype)
+		return v.err()
+	}
+
+	fields, err := e.repoField.GetEntityFields(ctx, etype)
+	if err != nil {
+		return err
+	}
+	if len(fields) == 0 {
+		v.add("etype", "неизвестный тип сущности %q", etype)
+		return v.err()
+	}
+
+	byID := make(map[int32]field.EntityFields, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	count := make(map[int32]int, len(ent.Props))
+	for _, prop := range ent.Props {
+		f, ok := byID[prop.FieldID]
+		if !ok {
+			v.add(fmt.Sprintf("props[%v]", prop.FieldID), "поле не относится к типу %q", etype)
+			continue
+		}
+		count[prop.FieldID]++
+		if count[prop.FieldID] == 2 {
+			v.add(f.Name, "поле указано несколько раз")
+		}
+		if len(prop.Value) > constants.MaxPropValueSize {
+			v.add(f.Name, "значение длиннее %v байт", constants.MaxPropValueSize)
+		}
+	}
+	for _, f := range fields {
+		if count[f.ID] == 0 && isRequired(f) {
+			v.add(f.Name, "обязательное поле не заполнено")
+		}
+	}
+
+	if len(ent.Metainfo) > constants.MaxMetainfoCount {
+		v.add("metainfo", "метаданных больше %v", constants.MaxMetainfoCount)
+	}
+	for i, meta := range ent.Metainfo {
+		if meta.Title == "" {
+			v.add(fmt.Sprintf("metainfo[%v]", i), "не указано название")
+		}
+		if len(meta.Title) > constants.MaxMetainfoSize || len(meta.Value) > constants.MaxMetainfoSize {
+			v.add(fmt.Sprintf("metainfo[%v]", i), "название или значение длиннее %v байт", constants.MaxMetainfoSize)
+		}
+	}
+
+	if len(ent.Tags) > constants.MaxTagsCount {
+		v.add("tags", "тегов больше %v", constants.MaxTagsCount)
+	}
+	for i, tag := range ent.Tags {
+		if len(tag) > constants.MaxTagSize {
+			v.add(fmt.Sprintf("tags[%v]", i), "тег длиннее %v байт", constants.MaxTagSize)
+		}
+	}
+	if len(ent.Tokens) > constants.MaxEntityTokens {
+		v.add("blind_index", "токенов слепого индекса больше %v", constants.MaxEntityTokens)
+	}
+
+	return v.err()
+}
+
+// isRequired поле обязательно: среди правил валидации есть required
+func isRequired(f field.EntityFields) bool {
+	for _, rule := range strings.Split(f.ValidateRules, ",") {
+		if strings.TrimSpace(rule) == "required" {
+			return true
+		}
+	}
+
+	return false
+}
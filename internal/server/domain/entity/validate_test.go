@@ -0,0 +1,125 @@
+package entity_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+	mock_domain "github.com/dnsoftware/gophkeeper/internal/server/mocks"
+)
+
+func TestValidateEntity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repoFields := mock_domain.NewMockFieldRepo(ctrl)
+	repoEntity := mock_domain.NewMockEntityRepo(ctrl)
+	entityService, _ := entity.NewEntity(repoEntity, repoFields)
+
+	ctx := context.Background()
+	repoFields.EXPECT().GetEntityFields(ctx, "logopas").Return([]field.EntityFields{
+		{ID: 1, Etype: "logopas", Name: "Логин", Ftype: "string", ValidateRules: "required"},
+		{ID: 2, Etype: "logopas", Name: "Пароль", Ftype: "string", ValidateRules: "required"},
+		{ID: 3, Etype: "logopas", Name: "Заметка", Ftype: "string"},
+	}, nil).AnyTimes()
+	repoFields.EXPECT().GetEntityFields(ctx, "unknown").Return(nil, nil).AnyTimes()
+	repoFields.EXPECT().GetEntityFields(ctx, "u2_wifi").Return([]field.EntityFields{
+		{ID: 20, Etype: "u2_wifi", Name: "SSID", Ftype: "string"},
+	}, nil).AnyTimes()
+	repoFields.EXPECT().GetEntityCode(ctx, "logopas").Return(entity_code.CodeModel{Etype: "logopas", Name: "Логин и пароль"}, nil).AnyTimes()
+	repoFields.EXPECT().GetEntityCode(ctx, "unknown").Return(entity_code.CodeModel{}, nil).AnyTimes()
+	repoFields.EXPECT().GetEntityCode(ctx, "u2_wifi").Return(entity_code.CodeModel{Etype: "u2_wifi", Name: "Wi-Fi", UserID: 2, Archived: true}, nil).AnyTimes()
+	repoFields.EXPECT().IsFieldType(ctx, gomock.Any(), constants.FieldTypePath).Return(false, nil).AnyTimes()
+	expectTransaction(repoEntity)
+
+	// violations нарушения из деталей ошибки
+	violations := func(t *testing.T, err error) map[string]string {
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.InvalidArgument, st.Code())
+		res := make(map[string]string)
+		for _, detail := range st.Details() {
+			br, ok := detail.(*errdetails.BadRequest)
+			require.True(t, ok)
+			for _, fv := range br.FieldViolations {
+				res[fv.Field] = fv.Description
+			}
+		}
+		return res
+	}
+
+	t.Run("unknown etype", func(t *testing.T) {
+		_, err := entityService.AddEntity(ctx, entity.EntityModel{UserID: 1, Etype: "unknown"})
+		assert.Contains(t, violations(t, err), "etype")
+	})
+
+	t.Run("custom etype", func(t *testing.T) {
+		props := []entity.Property{{FieldID: 20, Value: "home"}}
+
+		// тип другого пользователя не виден
+		_, err := entityService.AddEntity(ctx, entity.EntityModel{UserID: 1, Etype: "u2_wifi", Props: props})
+		assert.Contains(t, violations(t, err)["etype"], "неизвестный")
+
+		// новые сущности архивного типа не создаются, существующие изменяются
+		_, err = entityService.AddEntity(ctx, entity.EntityModel{UserID: 2, Etype: "u2_wifi", Props: props})
+		assert.Contains(t, violations(t, err)["etype"], "в архиве")
+
+		repoEntity.EXPECT().GetEntity(ctx, int32(5)).Return(entity.EntityModel{ID: 5, UserID: 2, Etype: "u2_wifi"}, nil)
+		repoEntity.EXPECT().UpdateEntity(ctx, gomock.Any()).Return(nil)
+		require.NoError(t, entityService.SaveEditEntity(ctx, entity.EntityModel{ID: 5, UserID: 2, Etype: "u2_wifi", Props: props}))
+	})
+
+	t.Run("props", func(t *testing.T) {
+		_, err := entityService.AddEntity(ctx, entity.EntityModel{
+			UserID: 1,
+			Etype:  "logopas",
+			Props: []entity.Property{
+				{FieldID: 1, Value: "a"},
+				{FieldID: 1, Value: "b"},
+				{FieldID: 5, Value: "c"},
+				{FieldID: 3, Value: strings.Repeat("x", constants.MaxPropValueSize+1)},
+			},
+			Metainfo: []entity.Metainfo{{Title: "", Value: "v"}},
+			Tags:     make([]string, constants.MaxTagsCount+1),
+		})
+		got := violations(t, err)
+		assert.Len(t, got, 6)
+		assert.Contains(t, got["Логин"], "несколько раз")
+		assert.Contains(t, got["Пароль"], "обязательное")
+		assert.Contains(t, got["Заметка"], "длиннее")
+		assert.Contains(t, got, "props[5]")
+		assert.Contains(t, got, "metainfo[0]")
+		assert.Contains(t, got, "tags")
+		assert.Contains(t, err.Error(), "Пароль: обязательное поле не заполнено")
+	})
+
+	t.Run("edit etype", func(t *testing.T) {
+		repoEntity.EXPECT().GetEntity(ctx, int32(4)).Return(entity.EntityModel{ID: 4, UserID: 1, Etype: "logopas"}, nil)
+
+		err := entityService.SaveEditEntity(ctx, entity.EntityModel{ID: 4, UserID: 1, Etype: "card"})
+		assert.Contains(t, violations(t, err), "etype")
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		repoEntity.EXPECT().CreateEntity(ctx, gomock.Any()).Return(int32(9), nil)
+
+		id, err := entityService.AddEntity(ctx, entity.EntityModel{
+			UserID: 1,
+			Etype:  "logopas",
+			Props:  []entity.Property{{FieldID: 1, Value: "a"}, {FieldID: 2, Value: "b"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int32(9), id)
+	})
+}
@@ -1,14 +1,64 @@
 // Package entity_code работа со справочником типов сущностей
+// Кроме встроенных типов пользователь может создавать собственные типы с произвольным набором полей
 package entity_code
 
-import "context"
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+)
 
 // EntityCodeStorage интерфейс работы с хранилищем
 type EntityCodeStorage interface {
-	// GetEntityCodes получение кодов доступных сущностей
-	GetEntityCodes(ctx context.Context) (map[string]string, error)
+	// GetEntityCodes типы сущностей, доступные пользователю: встроенные и созданные им (в том числе архивные)
+	GetEntityCodes(ctx context.Context, userID int32) ([]CodeModel, error)
+	// GetEntityCode тип сущности по коду, у несуществующего типа пустой Etype
+	GetEntityCode(ctx context.Context, etype string) (CodeModel, error)
+	// GetEntityFields описания полей типа сущности
+	GetEntityFields(ctx context.Context, etype string) ([]field.EntityFields, error)
+	// CreateEntityType создание пользовательского типа сущности вместе с описаниями полей
+	CreateEntityType(ctx context.Context, code CodeModel, fields []field.EntityFields) error
+	// UpdateEntityType изменение названия пользовательского типа, изменение полей и добавление новых (ID = 0)
+	UpdateEntityType(ctx context.Context, code CodeModel, fields []field.EntityFields) error
+	// SetEntityTypeArchived перемещение пользовательского типа в архив (archived = true) или возврат из архива
+	SetEntityTypeArchived(ctx context.Context, etype string, userID int32, archived bool) error
+}
+
+// CodeModel тип сущности
+type CodeModel struct {
+	Etype    string // код типа
+	Name     string // название типа
+	UserID   int32  // владелец пользовательского типа, 0 - встроенный тип
+	Archived bool   // тип в архиве: новые сущности не создаются, существующие доступны
 }
 
+// customFieldTypes типы полей, доступные в пользовательских типах сущностей
+// Файловые, OTP и SSH поля обрабатываются клиентом особым образом и есть только у встроенных типов
+var customFieldTypes = map[string]bool{
+	constants.FieldTypeString:    true,
+	constants.FieldTypeSecret:    true,
+	constants.FieldTypeMultiline: true,
+	constants.FieldTypeURL:       true,
+	constants.FieldTypeDate:      true,
+	constants.FieldTypeMonthYear: true,
+	constants.FieldTypeNumber:    true,
+	constants.FieldTypeSelect:    true,
+}
+
+// rulesValidator проверка синтаксиса правил валидации пользовательских полей
+var rulesValidator = validator.New()
+
 // EntityCode код-название сущности
 type EntityCode struct {
 	storage EntityCodeStorage
@@ -22,20 +72,291 @@ func NewEntityCode(storage EntityCodeStorage) (*EntityCode, error) {
 	return user, nil
 }
 
-// EntityCodes получить список типов сущностей
-func (k *EntityCode) EntityCodes(ctx context.Context) (map[string]string, error) {
-	codes, err := k.storage.GetEntityCodes(ctx)
+// EntityCodes получить список типов сущностей, доступных пользователю
+func (k *EntityCode) EntityCodes(ctx context.Context, userID int32) ([]CodeModel, error) {
+	list, err := k.storage.GetEntityCodes(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return codes, nil
+	return list, nil
+}
+
+// EntityType тип сущности, доступный пользователю: встроенный или созданный им
+func (k *EntityCode) EntityType(ctx context.Context, userID int32, etype string) (CodeModel, error) {
+	code, err := k.storage.GetEntityCode(ctx, etype)
+	if err != nil {
+		return CodeModel{}, err
+	}
+	if code.Etype == "" || (code.UserID != 0 && code.UserID != userID) {
+		return CodeModel{}, status.Errorf(codes.NotFound, "no entity type: %v", etype)
+	}
+
+	return code, nil
+}
+
+// CreateEntityType создание пользовательского типа сущности, возвращает код созданного типа
+// Порядок полей в форме - порядок в fields
+func (k *EntityCode) CreateEntityType(ctx context.Context, code CodeModel, fields []field.EntityFields) (string, error) {
+	code.Name = strings.TrimSpace(code.Name)
+	list, err := k.storage.GetEntityCodes(ctx, code.UserID)
+	if err != nil {
+		return "", err
+	}
+	err = checkTypeName(code, list)
+	if err != nil {
+		return "", err
+	}
+
+	custom := 0
+	for _, c := range list {
+		if c.UserID != 0 {
+			custom++
+		}
+	}
+	if custom >= constants.MaxCustomTypes {
+		return "", status.Errorf(codes.ResourceExhausted, "создано максимальное количество типов: %v", constants.MaxCustomTypes)
+	}
+
+	err = checkFields(fields, nil)
+	if err != nil {
+		return "", err
+	}
+
+	code.Etype, err = newEtype(code.UserID)
+	if err != nil {
+		return "", err
+	}
+	code.Archived = false
+
+	err = k.storage.CreateEntityType(ctx, code, arrange(code.Etype, fields))
+	if err != nil {
+		return "", err
+	}
+
+	return code.Etype, nil
+}
+
+// UpdateEntityType изменение названия, полей и порядка полей пользовательского типа
+// fields - полный набор полей: поля с ID изменяются, без ID - добавляются. Удалять поля нельзя,
+// в сущностях могут храниться их значения
+func (k *EntityCode) UpdateEntityType(ctx context.Context, code CodeModel, fields []field.EntityFields) error {
+	_, err := k.ownType(ctx, code.UserID, code.Etype)
+	if err != nil {
+		return err
+	}
+
+	code.Name = strings.TrimSpace(code.Name)
+	list, err := k.storage.GetEntityCodes(ctx, code.UserID)
+	if err != nil {
+		return err
+	}
+	err = checkTypeName(code, list)
+	if err != nil {
+		return err
+	}
+
+	existing, err := k.storage.GetEntityFields(ctx, code.Etype)
+	if err != nil {
+		return err
+	}
+	err = checkFields(fields, existing)
+	if err != nil {
+		return err
+	}
+
+	return k.storage.UpdateEntityType(ctx, code, arrange(code.Etype, fields))
+}
+
+// ArchiveEntityType перемещение пользовательского типа в архив (archived = true) или возврат из архива
+func (k *EntityCode) ArchiveEntityType(ctx context.Context, etype string, userID int32, archived bool) error {
+	_, err := k.ownType(ctx, userID, etype)
+	if err != nil {
+		return err
+	}
+
+	return k.storage.SetEntityTypeArchived(ctx, etype, userID, archived)
+}
+
+// ownType тип сущности, созданный пользователем, встроенные типы не изменяются
+func (k *EntityCode) ownType(ctx context.Context, userID int32, etype string) (CodeModel, error) {
+	code, err := k.EntityType(ctx, userID, etype)
+	if err != nil {
+		return CodeModel{}, err
+	}
+	if code.UserID == 0 {
+		return CodeModel{}, status.Errorf(codes.PermissionDenied, "встроенный тип сущности %v изменить нельзя", etype)
+	}
+
+	return code, nil
+}
+
+// checkTypeName проверка названия типа: не пустое и не совпадает с названиями других доступных пользователю типов
+func checkTypeName(code CodeModel, list []CodeModel) error {
+	if code.Name == "" {
+		return status.Error(codes.InvalidArgument, "не указано название типа")
+	}
+	if utf8.RuneCountInString(code.Name) > constants.MaxTypeNameSize {
+		return status.Errorf(codes.InvalidArgument, "название типа длиннее %v символов", constants.MaxTypeNameSize)
+	}
+	for _, c := range list {
+		if c.Etype != code.Etype && strings.EqualFold(c.Name, code.Name) {
+			return status.Errorf(codes.InvalidArgument, "тип с названием %q уже есть", code.Name)
+		}
+	}
+
+	return nil
+}
+
+// checkFields проверка описаний полей типа, existing - уже сохраненные поля типа
+func checkFields(fields []field.EntityFields, existing []field.EntityFields) error {
+	if len(fields) == 0 {
+		return status.Error(codes.InvalidArgument, "у типа должно быть хотя бы одно поле")
+	}
+	if len(fields) > constants.MaxCustomFields {
+		return status.Errorf(codes.InvalidArgument, "полей больше %v", constants.MaxCustomFields)
+	}
+
+	known := make(map[int32]bool, len(existing))
+	for _, f := range existing {
+		known[f.ID] = true
+	}
+
+	names := make(map[string]bool, len(fields))
+	ids := make(map[int32]bool, len(fields))
+	for i, f := range fields {
+		name := strings.TrimSpace(f.Name)
+		if name == "" {
+			return status.Errorf(codes.InvalidArgument, "поле %v: не указано название", i+1)
+		}
+		if utf8.RuneCountInString(name) > constants.MaxTypeNameSize {
+			return status.Errorf(codes.InvalidArgument, "поле %v: название длиннее %v символов", i+1, constants.MaxTypeNameSize)
+		}
+		if names[strings.ToLower(name)] {
+			return status.Errorf(codes.InvalidArgument, "поле %q указано несколько раз", name)
+		}
+		names[strings.ToLower(name)] = true
+
+		if f.ID != 0 {
+			if !known[f.ID] || ids[f.ID] {
+				return status.Errorf(codes.InvalidArgument, "поле %q: неверный ID %v", name, f.ID)
+			}
+			ids[f.ID] = true
+		}
+		if !customFieldTypes[f.Ftype] {
+			return status.Errorf(codes.InvalidArgument, "поле %q: тип %q не поддерживается", name, f.Ftype)
+		}
+		err := checkOptions(f)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "поле %q: %v", name, err)
+		}
+		err = checkRules(f)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "поле %q: %v", name, err)
+		}
+	}
+
+	for _, f := range existing {
+		if !ids[f.ID] {
+			return status.Errorf(codes.InvalidArgument, "поле %q нельзя удалить: его значения хранятся в сущностях", f.Name)
+		}
+	}
+
+	return nil
 }
 
-//// EntityProps свойство сущности
-//type EntityProps struct {
-//	name             string // название
-//	ftype            string //
-//	validateRules    string
-//	validateMessages string
-//}
+// checkOptions проверка вариантов значения: они есть только у поля select, не пустые и не повторяются
+func checkOptions(f field.EntityFields) error {
+	if f.Ftype != constants.FieldTypeSelect {
+		if len(f.Options) > 0 {
+			return fmt.Errorf("варианты значения указываются только для типа %q", constants.FieldTypeSelect)
+		}
+		return nil
+	}
+
+	if len(f.Options) == 0 {
+		return fmt.Errorf("не указаны варианты значения")
+	}
+	if len(f.Options) > constants.MaxSelectOptions {
+		return fmt.Errorf("вариантов значения больше %v", constants.MaxSelectOptions)
+	}
+	seen := make(map[string]bool, len(f.Options))
+	for _, option := range f.Options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return fmt.Errorf("пустой вариант значения")
+		}
+		if utf8.RuneCountInString(option) > constants.MaxTypeNameSize {
+			return fmt.Errorf("вариант значения длиннее %v символов", constants.MaxTypeNameSize)
+		}
+		if seen[strings.ToLower(option)] {
+			return fmt.Errorf("вариант %q указан несколько раз", option)
+		}
+		seen[strings.ToLower(option)] = true
+	}
+
+	return nil
+}
+
+// checkRules проверка правил валидации (теги go-playground/validator) и сообщений валидации (JSON объект)
+func checkRules(f field.EntityFields) (err error) {
+	if len(f.ValidateRules) > constants.MaxValidateLength || len(f.ValidateMessages) > constants.MaxValidateLength {
+		return fmt.Errorf("правила или сообщения валидации длиннее %v байт", constants.MaxValidateLength)
+	}
+
+	if f.ValidateMessages != "" {
+		var messages map[string]string
+		if json.Unmarshal([]byte(f.ValidateMessages), &messages) != nil {
+			return fmt.Errorf("сообщения валидации должны быть JSON объектом")
+		}
+	}
+
+	if f.ValidateRules == "" {
+		return nil
+	}
+	// validator паникует на неизвестных тегах и неверных параметрах правил
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("неверные правила валидации %q", f.ValidateRules)
+		}
+	}()
+	_ = rulesValidator.Var("", f.ValidateRules)
+
+	return nil
+}
+
+// arrange подготовка полей к сохранению: тип сущности, порядок по месту в списке, пустые сообщения валидации - {}
+// Поле типа secret всегда секретно
+func arrange(etype string, fields []field.EntityFields) []field.EntityFields {
+	res := make([]field.EntityFields, 0, len(fields))
+	for i, f := range fields {
+		f.Etype = etype
+		f.Name = strings.TrimSpace(f.Name)
+		f.Position = int32(i + 1)
+		f.Secret = f.Secret || f.Ftype == constants.FieldTypeSecret
+		if len(f.Options) > 0 {
+			options := make([]string, 0, len(f.Options))
+			for _, option := range f.Options {
+				options = append(options, strings.TrimSpace(option))
+			}
+			f.Options = options
+		}
+		if f.ValidateMessages == "" {
+			f.ValidateMessages = "{}"
+		}
+		res = append(res, f)
+	}
+
+	return res
+}
+
+// newEtype код пользовательского типа: префикс, ID пользователя и случайный суффикс
+func newEtype(userID int32) (string, error) {
+	suffix := make([]byte, 4)
+	_, err := rand.Read(suffix)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%v%v_%v", constants.CustomEtypePrefix, userID, hex.EncodeToString(suffix)), nil
+}
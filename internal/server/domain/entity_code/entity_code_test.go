@@ -0,0 +1,113 @@
+package entity_code_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+	"github.com/dnsoftware/gophkeeper/internal/server/mocks"
+)
+
+func TestEntityCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockEntityCodeStorage(ctrl)
+	service, err := entity_code.NewEntityCode(mockStorage)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	codesList := []entity_code.CodeModel{
+		{Etype: "card", Name: "Банковская карта"},
+		{Etype: "u1_00000001", Name: "Wi-Fi", UserID: 1},
+	}
+	mockStorage.EXPECT().GetEntityCodes(ctx, int32(1)).Return(codesList, nil).AnyTimes()
+	mockStorage.EXPECT().GetEntityCode(ctx, "card").Return(codesList[0], nil).AnyTimes()
+	mockStorage.EXPECT().GetEntityCode(ctx, "u1_00000001").Return(codesList[1], nil).AnyTimes()
+	mockStorage.EXPECT().GetEntityCode(ctx, "u2_00000002").Return(entity_code.CodeModel{Etype: "u2_00000002", Name: "Чужой", UserID: 2}, nil).AnyTimes()
+
+	// чужой тип не виден
+	_, err = service.EntityType(ctx, 1, "u2_00000002")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// создание: проверки названия и полей
+	str := constants.FieldTypeString
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: " "}, []field.EntityFields{{Name: "a", Ftype: str}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "wi-fi"}, []field.EntityFields{{Name: "a", Ftype: str}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, []field.EntityFields{{Name: "a", Ftype: str}, {Name: "A", Ftype: str}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, []field.EntityFields{{Name: "a", Ftype: constants.FieldTypePath}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, []field.EntityFields{{Name: "a", Ftype: str, ValidateRules: "nosuchrule"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, []field.EntityFields{{Name: "a", Ftype: str, ValidateMessages: "[]"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, []field.EntityFields{{Name: "a", Ftype: constants.FieldTypeSelect}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, []field.EntityFields{{Name: "a", Ftype: constants.FieldTypeSelect, Options: []string{"x", "X"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: "Сервер"}, []field.EntityFields{{Name: "a", Ftype: str, Options: []string{"x"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	var saved []field.EntityFields
+	mockStorage.EXPECT().CreateEntityType(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, code entity_code.CodeModel, fields []field.EntityFields) error {
+			assert.Equal(t, int32(1), code.UserID)
+			assert.Equal(t, "Сервер", code.Name)
+			saved = fields
+			return nil
+		})
+	etype, err := service.CreateEntityType(ctx, entity_code.CodeModel{UserID: 1, Name: " Сервер "}, []field.EntityFields{
+		{Name: " Хост ", Ftype: str, ValidateRules: "required,max=64"},
+		{Name: "Пароль", Ftype: constants.FieldTypeSecret},
+		{Name: "ОС", Ftype: constants.FieldTypeSelect, Options: []string{" Linux ", "Windows"}},
+	})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(etype, constants.CustomEtypePrefix+"1_"))
+	require.Len(t, saved, 3)
+	assert.Equal(t, []string{"Linux", "Windows"}, saved[2].Options)
+	assert.Equal(t, field.EntityFields{Etype: etype, Name: "Хост", Ftype: str, ValidateRules: "required,max=64", ValidateMessages: "{}", Position: 1}, saved[0])
+	assert.True(t, saved[1].Secret)
+	assert.Equal(t, int32(2), saved[1].Position)
+
+	// встроенные типы не изменяются
+	err = service.ArchiveEntityType(ctx, "card", 1, true)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = service.ArchiveEntityType(ctx, "u2_00000002", 1, true)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockStorage.EXPECT().SetEntityTypeArchived(ctx, "u1_00000001", int32(1), true).Return(nil)
+	require.NoError(t, service.ArchiveEntityType(ctx, "u1_00000001", 1, true))
+
+	// изменение: поля не удаляются, ID должны быть полями типа
+	existing := []field.EntityFields{{ID: 10, Etype: "u1_00000001", Name: "SSID", Ftype: str}}
+	mockStorage.EXPECT().GetEntityFields(ctx, "u1_00000001").Return(existing, nil).AnyTimes()
+	err = service.UpdateEntityType(ctx, entity_code.CodeModel{Etype: "u1_00000001", UserID: 1, Name: "Wi-Fi"}, []field.EntityFields{{Name: "Пароль", Ftype: str}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = service.UpdateEntityType(ctx, entity_code.CodeModel{Etype: "u1_00000001", UserID: 1, Name: "Wi-Fi"}, []field.EntityFields{{ID: 11, Name: "SSID", Ftype: str}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = service.UpdateEntityType(ctx, entity_code.CodeModel{Etype: "u1_00000001", UserID: 1, Name: "Банковская карта"}, []field.EntityFields{{ID: 10, Name: "SSID", Ftype: str}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockStorage.EXPECT().UpdateEntityType(ctx, entity_code.CodeModel{Etype: "u1_00000001", UserID: 1, Name: "Домашний Wi-Fi"}, []field.EntityFields{
+		{Etype: "u1_00000001", Name: "Пароль", Ftype: str, Secret: true, ValidateMessages: "{}", Position: 1},
+		{ID: 10, Etype: "u1_00000001", Name: "Сеть", Ftype: str, ValidateMessages: "{}", Position: 2},
+	}).Return(nil)
+	err = service.UpdateEntityType(ctx, entity_code.CodeModel{Etype: "u1_00000001", UserID: 1, Name: "Домашний Wi-Fi"}, []field.EntityFields{
+		{Name: "Пароль", Ftype: str, Secret: true},
+		{ID: 10, Name: "Сеть", Ftype: str},
+	})
+	require.NoError(t, err)
+}
@@ -14,6 +14,9 @@ type EntityFields struct {
 	Ftype            string
 	ValidateRules    string
 	ValidateMessages string
+	Secret           bool     // значение скрывается при просмотре и не индексируется клиентом для поиска
+	Position         int32    // порядок поля в форме, поля упорядочены по Position, затем по ID
+	Options          []string // варианты значения поля типа select
 }
 
 type Field struct {
@@ -0,0 +1,112 @@
+// Package folder папки пользователя для группировки сущностей
+// Папки образуют дерево, названия шифруются на клиенте и сервером не читаются
+package folder
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FolderStorage интерфейс работы с хранилищем папок
+type FolderStorage interface {
+	// GetFolders получение всех папок пользователя
+	GetFolders(ctx context.Context, userID int32) ([]FolderModel, error)
+	// CreateFolder создание папки
+	CreateFolder(ctx context.Context, folder FolderModel) (int32, error)
+	// UpdateFolder изменение названия и родительской папки
+	UpdateFolder(ctx context.Context, folder FolderModel) error
+	// DeleteFolder удаление папки, вложенные папки и сущности переносятся в родительскую папку
+	DeleteFolder(ctx context.Context, id int32, userID int32) error
+}
+
+// FolderModel данные папки
+type FolderModel struct {
+	ID       int32  // уникальный ID
+	UserID   int32  // код пользователя
+	ParentID int32  // код родительской папки, 0 - папка верхнего уровня
+	Name     string // название (зашифровано клиентом)
+}
+
+// Folder работа с папками
+type Folder struct {
+	storage FolderStorage
+}
+
+// NewFolder конструктор
+func NewFolder(storage FolderStorage) (*Folder, error) {
+	f := &Folder{
+		storage: storage,
+	}
+
+	return f, nil
+}
+
+// Folders все папки пользователя
+func (f *Folder) Folders(ctx context.Context, userID int32) ([]FolderModel, error) {
+	return f.storage.GetFolders(ctx, userID)
+}
+
+// CreateFolder создание папки, родительская папка должна принадлежать пользователю
+func (f *Folder) CreateFolder(ctx context.Context, folder FolderModel) (int32, error) {
+	if folder.Name == "" {
+		return 0, status.Error(codes.InvalidArgument, "не указано название папки")
+	}
+
+	if folder.ParentID != 0 {
+		folders, err := f.storage.GetFolders(ctx, folder.UserID)
+		if err != nil {
+			return 0, err
+		}
+		if parents(folders)[folder.ParentID] == nil {
+			return 0, status.Errorf(codes.NotFound, "no folder with id: %v", folder.ParentID)
+		}
+	}
+
+	return f.storage.CreateFolder(ctx, folder)
+}
+
+// UpdateFolder переименование и перемещение папки
+// Папку нельзя переместить в саму себя или во вложенную в нее папку
+func (f *Folder) UpdateFolder(ctx context.Context, folder FolderModel) error {
+	if folder.Name == "" {
+		return status.Error(codes.InvalidArgument, "не указано название папки")
+	}
+
+	folders, err := f.storage.GetFolders(ctx, folder.UserID)
+	if err != nil {
+		return err
+	}
+	byID := parents(folders)
+	if byID[folder.ID] == nil {
+		return status.Errorf(codes.NotFound, "no folder with id: %v", folder.ID)
+	}
+
+	// поднимаемся от новой родительской папки к корню, не встречая перемещаемую
+	for parent := folder.ParentID; parent != 0; parent = byID[parent].ParentID {
+		if byID[parent] == nil {
+			return status.Errorf(codes.NotFound, "no folder with id: %v", parent)
+		}
+		if parent == folder.ID {
+			return status.Error(codes.InvalidArgument, "папку нельзя переместить в саму себя или во вложенную папку")
+		}
+	}
+
+	return f.storage.UpdateFolder(ctx, folder)
+}
+
+// DeleteFolder удаление папки, содержимое переносится в родительскую папку
+func (f *Folder) DeleteFolder(ctx context.Context, id int32, userID int32) error {
+	return f.storage.DeleteFolder(ctx, id, userID)
+}
+
+// parents папки по ID
+func parents(folders []FolderModel) map[int32]*FolderModel {
+	byID := make(map[int32]*FolderModel, len(folders))
+	for i := range folders {
+		byID[folders[i].ID] = &folders[i]
+	}
+
+	return byID
+}
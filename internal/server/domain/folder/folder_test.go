@@ -0,0 +1,50 @@
+package folder_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
+	"github.com/dnsoftware/gophkeeper/internal/server/mocks"
+)
+
+func TestFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockFolderStorage(ctrl)
+	service, err := folder.NewFolder(mockStorage)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// дерево: 1 -> 2 -> 3
+	tree := []folder.FolderModel{{ID: 1, UserID: 1, Name: "a"}, {ID: 2, UserID: 1, ParentID: 1, Name: "b"}, {ID: 3, UserID: 1, ParentID: 2, Name: "c"}}
+	mockStorage.EXPECT().GetFolders(ctx, int32(1)).Return(tree, nil).AnyTimes()
+
+	_, err = service.CreateFolder(ctx, folder.FolderModel{UserID: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = service.CreateFolder(ctx, folder.FolderModel{UserID: 1, ParentID: 9, Name: "x"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockStorage.EXPECT().CreateFolder(ctx, folder.FolderModel{UserID: 1, ParentID: 3, Name: "d"}).Return(int32(4), nil)
+	id, err := service.CreateFolder(ctx, folder.FolderModel{UserID: 1, ParentID: 3, Name: "d"})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), id)
+
+	// перемещение в себя и во вложенную папку запрещено
+	err = service.UpdateFolder(ctx, folder.FolderModel{ID: 1, UserID: 1, ParentID: 1, Name: "a"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = service.UpdateFolder(ctx, folder.FolderModel{ID: 1, UserID: 1, ParentID: 3, Name: "a"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = service.UpdateFolder(ctx, folder.FolderModel{ID: 7, UserID: 1, Name: "a"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockStorage.EXPECT().UpdateFolder(ctx, folder.FolderModel{ID: 3, UserID: 1, Name: "c2"}).Return(nil)
+	require.NoError(t, service.UpdateFolder(ctx, folder.FolderModel{ID: 3, UserID: 1, Name: "c2"}))
+}
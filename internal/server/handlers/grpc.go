@@ -11,7 +11,9 @@ import (
 
 	pb "github.com/dnsoftware/gophkeeper/internal/proto"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
 )
 
 // UserService интерфейс для работы с регистрацией и аутентификацией/авторизацией
@@ -25,8 +27,16 @@ type UserService interface {
 
 // EntityCodeService интерфейс для работы со справочником сущностей
 type EntityCodeService interface {
-	// EntityCodes запрос списка доступных к добавлению типов сущностей (таблица entity_codes)
-	EntityCodes(ctx context.Context) (map[string]string, error)
+	// EntityCodes запрос списка типов сущностей, доступных пользователю (таблица entity_codes)
+	EntityCodes(ctx context.Context, userID int32) ([]entity_code.CodeModel, error)
+	// EntityType тип сущности, доступный пользователю: встроенный или созданный им
+	EntityType(ctx context.Context, userID int32, etype string) (entity_code.CodeModel, error)
+	// CreateEntityType создание пользовательского типа сущности, возвращает код типа
+	CreateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) (string, error)
+	// UpdateEntityType изменение названия, полей и порядка полей пользовательского типа
+	UpdateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) error
+	// ArchiveEntityType перемещение пользовательского типа в архив или возврат из архива
+	ArchiveEntityType(ctx context.Context, etype string, userID int32, archived bool) error
 }
 
 // FieldsService интерфейс для работа с полями свойств сущностей
@@ -40,12 +50,24 @@ type EntityService interface {
 	AddEntity(ctx context.Context, entity entity.EntityModel) (int32, error)
 	// SaveEditEntity сохранить отредактированную
 	SaveEditEntity(ctx context.Context, entity entity.EntityModel) error
-	// DeleteEntity удалить сущность
+	// DeleteEntity удалить сущность (переместить в корзину)
 	DeleteEntity(ctx context.Context, id int32, userID int32) error
+	// ListTrash список сущностей пользователя, находящихся в корзине
+	ListTrash(ctx context.Context, userID int32) ([]entity.TrashItem, error)
+	// RestoreEntity восстановить сущность из корзины
+	RestoreEntity(ctx context.Context, id int32, userID int32) error
+	// PurgeEntity окончательно удалить сущность из корзины
+	PurgeEntity(ctx context.Context, id int32, userID int32) error
 	// Entity Получить сущность
-	Entity(ctx context.Context, id int32) (*entity.EntityModel, error)
-	// EntityList Список сущностей определенного типа для пользователя
-	EntityList(ctx context.Context, etype string, userID int32) (map[int32]string, error)
+	Entity(ctx context.Context, id int32, userID int32, touch bool) (*entity.EntityModel, error)
+	// EntityList Список сущностей определенного типа для пользователя (устарело, см. ListEntities)
+	EntityList(ctx context.Context, etype string, userID int32, filter entity.ListFilter) ([]entity.EntitySummary, error)
+	// ListEntities страница списка сущностей пользователя с сортировкой и отбором по типам
+	ListEntities(ctx context.Context, userID int32, query entity.ListQuery) (entity.EntityPage, error)
+	// SetFavorite добавить сущность в избранное или убрать из него
+	SetFavorite(ctx context.Context, id int32, userID int32, favorite bool) error
+	// SearchEntities поиск сущностей пользователя по токенам слепого индекса
+	SearchEntities(ctx context.Context, userID int32, etype string, tokens []string) ([]entity.FoundEntity, error)
 
 	// UploadBinary потоковая загрузка незашифрованного бинарного файла
 	UploadBinary(stream pb.Keeper_UploadBinaryServer) (int32, error)
@@ -56,6 +78,27 @@ type EntityService interface {
 	UploadCryptoBinary(stream pb.Keeper_UploadCryptoBinaryServer) (int32, error)
 	// DownloadCryptoBinary потоковая отдача зашифрованного бинарного файла
 	DownloadCryptoBinary(entityID int32, stream pb.Keeper_DownloadCryptoBinaryServer) error
+
+	// AddAttachment потоковая загрузка зашифрованного вложения сущности пользователя
+	AddAttachment(stream pb.Keeper_AddAttachmentServer, userID int32) (entity.AttachmentModel, error)
+	// ListAttachments вложения сущности пользователя
+	ListAttachments(ctx context.Context, entityID int32, userID int32) ([]entity.AttachmentModel, error)
+	// DownloadAttachment потоковая отдача зашифрованного вложения
+	DownloadAttachment(id int32, userID int32, stream pb.Keeper_DownloadAttachmentServer) error
+	// DeleteAttachment удаление вложения
+	DeleteAttachment(ctx context.Context, id int32, userID int32) error
+}
+
+// FolderService интерфейс работы с папками пользователя
+type FolderService interface {
+	// Folders все папки пользователя
+	Folders(ctx context.Context, userID int32) ([]folder.FolderModel, error)
+	// CreateFolder создать папку
+	CreateFolder(ctx context.Context, folder folder.FolderModel) (int32, error)
+	// UpdateFolder переименовать или переместить папку
+	UpdateFolder(ctx context.Context, folder folder.FolderModel) error
+	// DeleteFolder удалить папку (содержимое переносится в родительскую)
+	DeleteFolder(ctx context.Context, id int32, userID int32) error
 }
 
 // Services сервисы
@@ -64,6 +107,7 @@ type Services struct {
 	EntityCodeService EntityCodeService // работа с данными пользователя (сохранение, получение, изменение)
 	FieldService      FieldService      // работа с полями свойств сущностей
 	EntityService     EntityService     // работа с сущностью
+	FolderService     FolderService     // работа с папками сущностей
 }
 
 // GRPCServer gRPC сервер
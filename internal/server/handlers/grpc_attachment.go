@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/dnsoftware/gophkeeper/internal/proto"
+	"github.com/dnsoftware/gophkeeper/logger"
+)
+
+// AddAttachment потоковая загрузка зашифрованного вложения сущности
+func (g *GRPCServer) AddAttachment(stream pb.Keeper_AddAttachmentServer) error {
+	userID, err := streamUserID(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	att, err := g.svs.EntityService.AddAttachment(stream, userID)
+	if err != nil {
+		return err
+	}
+
+	logger.Log().Info(fmt.Sprintf("Загружено вложение %v сущности %v", att.ID, att.EntityID))
+
+	return nil
+}
+
+// ListAttachments список вложений сущности
+func (g *GRPCServer) ListAttachments(ctx context.Context, in *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	userID := getContextUserID(ctx)
+
+	list, err := g.svs.EntityService.ListAttachments(ctx, in.EntityId, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var attachments = make([]*pb.Attachment, 0, len(list))
+	for _, att := range list {
+		attachments = append(attachments, &pb.Attachment{
+			Id:        att.ID,
+			EntityId:  att.EntityID,
+			Name:      att.Name,
+			Size:      att.Size,
+			Digest:    att.Digest,
+			CreatedAt: unixTime(att.CreatedAt),
+		})
+	}
+
+	return &pb.ListAttachmentsResponse{Attachments: attachments}, nil
+}
+
+// DownloadAttachment потоковая отдача зашифрованного вложения
+func (g *GRPCServer) DownloadAttachment(in *pb.DownloadAttachmentRequest, stream pb.Keeper_DownloadAttachmentServer) error {
+	userID, err := streamUserID(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	return g.svs.EntityService.DownloadAttachment(in.Id, userID, stream)
+}
+
+// DeleteAttachment удаление вложения
+func (g *GRPCServer) DeleteAttachment(ctx context.Context, in *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	userID := getContextUserID(ctx)
+
+	err := g.svs.EntityService.DeleteAttachment(ctx, in.Id, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAttachmentResponse{Error: ""}, nil
+}
+
+// streamUserID код пользователя потокового запроса
+// Потоковые запросы не проходят через checkUserInterceptor, поэтому токен проверяется здесь
+func streamUserID(ctx context.Context) (int32, error) {
+	userID := getContextUserID(ctx)
+	if userID <= 0 {
+		return 0, status.Errorf(codes.PermissionDenied, `Unauthorized`)
+	}
+
+	return int32(userID), nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 
@@ -40,6 +41,9 @@ func (g *GRPCServer) AddEntity(ctx context.Context, in *pb.AddEntityRequest) (*p
 		Etype:    in.Etype,
 		Props:    props,
 		Metainfo: metainfo,
+		Tokens:   in.BlindIndex,
+		FolderID: in.FolderId,
+		Tags:     in.Tags,
 	}
 
 	id, err := g.svs.EntityService.AddEntity(ctx, ent)
@@ -82,6 +86,9 @@ func (g *GRPCServer) SaveEditEntity(ctx context.Context, in *pb.SaveEntityReques
 		Etype:    in.Etype,
 		Props:    props,
 		Metainfo: metainfo,
+		Tokens:   in.BlindIndex,
+		FolderID: in.FolderId,
+		Tags:     in.Tags,
 	}
 
 	err := g.svs.EntityService.SaveEditEntity(ctx, ent)
@@ -98,7 +105,9 @@ func (g *GRPCServer) SaveEditEntity(ctx context.Context, in *pb.SaveEntityReques
 // Entity получение сущности
 func (g *GRPCServer) Entity(ctx context.Context, in *pb.EntityRequest) (*pb.EntityResponse, error) {
 
-	ent, err := g.svs.EntityService.Entity(ctx, in.Id)
+	userID := getContextUserID(ctx)
+
+	ent, err := g.svs.EntityService.Entity(ctx, in.Id, int32(userID), in.Touch)
 	if err != nil {
 		return nil, err
 	}
@@ -122,16 +131,20 @@ func (g *GRPCServer) Entity(ctx context.Context, in *pb.EntityRequest) (*pb.Enti
 	}
 
 	ret := &pb.EntityResponse{
-		Id:       ent.ID,
-		Etype:    ent.Etype,
-		Props:    props,
-		Metainfo: metainfo,
+		Id:         ent.ID,
+		Etype:      ent.Etype,
+		Props:      props,
+		Metainfo:   metainfo,
+		FolderId:   ent.FolderID,
+		Tags:       ent.Tags,
+		Favorite:   ent.Favorite,
+		AccessedAt: unixTime(ent.AccessedAt),
 	}
 
 	return ret, err
 }
 
-// DeleteEntity удаление сущности
+// DeleteEntity удаление сущности (перемещение в корзину)
 func (g *GRPCServer) DeleteEntity(ctx context.Context, in *pb.DeleteEntityRequest) (*pb.DeleteEntityResponse, error) {
 
 	userID := getContextUserID(ctx)
@@ -144,6 +157,64 @@ func (g *GRPCServer) DeleteEntity(ctx context.Context, in *pb.DeleteEntityReques
 	return &pb.DeleteEntityResponse{Error: ""}, nil
 }
 
+// ListTrash получение содержимого корзины пользователя
+func (g *GRPCServer) ListTrash(ctx context.Context, _ *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+
+	userID := getContextUserID(ctx)
+
+	list, err := g.svs.EntityService.ListTrash(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var items = make([]*pb.TrashItem, 0, len(list))
+	for _, item := range list {
+		var metainfo = make([]*pb.Metainfo, 0, len(item.Metainfo))
+		for _, val := range item.Metainfo {
+			metainfo = append(metainfo, &pb.Metainfo{
+				EntityId: val.EntityID,
+				Title:    val.Title,
+				Value:    val.Value,
+			})
+		}
+
+		items = append(items, &pb.TrashItem{
+			Id:        item.ID,
+			Etype:     item.Etype,
+			Metainfo:  metainfo,
+			DeletedAt: item.DeletedAt.Unix(),
+		})
+	}
+
+	return &pb.ListTrashResponse{Items: items}, nil
+}
+
+// RestoreEntity восстановление сущности из корзины
+func (g *GRPCServer) RestoreEntity(ctx context.Context, in *pb.RestoreEntityRequest) (*pb.RestoreEntityResponse, error) {
+
+	userID := getContextUserID(ctx)
+
+	err := g.svs.EntityService.RestoreEntity(ctx, in.Id, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreEntityResponse{Error: ""}, nil
+}
+
+// PurgeEntity окончательное удаление сущности из корзины
+func (g *GRPCServer) PurgeEntity(ctx context.Context, in *pb.PurgeEntityRequest) (*pb.PurgeEntityResponse, error) {
+
+	userID := getContextUserID(ctx)
+
+	err := g.svs.EntityService.PurgeEntity(ctx, in.Id, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PurgeEntityResponse{Error: ""}, nil
+}
+
 // UploadBinary загрузка незашифрованных бинарных данных (клиент -> сервер)
 func (g *GRPCServer) UploadBinary(stream pb.Keeper_UploadBinaryServer) error {
 
@@ -193,20 +264,135 @@ func (g *GRPCServer) DownloadCryptoBinary(in *pb.DownloadBinRequest, stream pb.K
 }
 
 // EntityList Получение списка сущностей указанного типа для конкретного пользователя
-// Простая карта с кодом сущности и названием(составляется из метаданных)
+// Карта с кодом сущности и названием(составляется из метаданных) и отметки сущностей в порядке списка
+// Устарело: оставлено для старых клиентов, новые используют ListEntities
 func (g *GRPCServer) EntityList(ctx context.Context, in *pb.EntityListRequest) (*pb.EntityListResponse, error) {
 	userID := getContextUserID(ctx)
 
-	list, err := g.svs.EntityService.EntityList(ctx, in.Etype, int32(userID))
+	filter := entity.ListFilter{FolderID: in.FolderId, TagToken: in.Tag, Favorite: in.Favorite, Recent: in.Recent}
+	list, err := g.svs.EntityService.EntityList(ctx, in.Etype, int32(userID), filter)
 	if err != nil {
 		return nil, err
 	}
 
+	titles := make(map[int32]string, len(list))
+	marks := make([]*pb.EntityMark, 0, len(list))
+	for _, item := range list {
+		titles[item.ID] = item.Title()
+		marks = append(marks, &pb.EntityMark{
+			Id:         item.ID,
+			Etype:      item.Etype,
+			Favorite:   item.Favorite,
+			AccessedAt: unixTime(item.AccessedAt),
+		})
+	}
+
 	return &pb.EntityListResponse{
-		List: list,
+		List:  titles,
+		Marks: marks,
 	}, nil
 }
 
+// ListEntities Постраничное получение списка сущностей пользователя
+// Метаинформация отдается в зашифрованном виде, расшифровывает ее клиент
+func (g *GRPCServer) ListEntities(ctx context.Context, in *pb.ListEntitiesRequest) (*pb.ListEntitiesResponse, error) {
+	userID := getContextUserID(ctx)
+
+	query := entity.ListQuery{
+		Etypes:    in.Etypes,
+		Filter:    entity.ListFilter{FolderID: in.FolderId, TagToken: in.Tag, Favorite: in.Favorite},
+		Sort:      entity.ListSort(in.Sort),
+		Desc:      in.Descending,
+		PageSize:  in.PageSize,
+		PageToken: in.PageToken,
+	}
+	page, err := g.svs.EntityService.ListEntities(ctx, int32(userID), query)
+	if err != nil {
+		return nil, err
+	}
+
+	var items = make([]*pb.EntitySummary, 0, len(page.Items))
+	for _, item := range page.Items {
+		var metainfo = make([]*pb.Metainfo, 0, len(item.Metainfo))
+		for _, val := range item.Metainfo {
+			metainfo = append(metainfo, &pb.Metainfo{
+				EntityId: val.EntityID,
+				Title:    val.Title,
+				Value:    val.Value,
+			})
+		}
+
+		items = append(items, &pb.EntitySummary{
+			Id:         item.ID,
+			Etype:      item.Etype,
+			Metainfo:   metainfo,
+			FolderId:   item.FolderID,
+			Favorite:   item.Favorite,
+			CreatedAt:  unixTime(item.CreatedAt),
+			UpdatedAt:  unixTime(item.UpdatedAt),
+			AccessedAt: unixTime(item.AccessedAt),
+			Revision:   item.Revision,
+		})
+	}
+
+	return &pb.ListEntitiesResponse{
+		Entities:      items,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+// SetFavorite добавление сущности в избранное или удаление из него
+func (g *GRPCServer) SetFavorite(ctx context.Context, in *pb.SetFavoriteRequest) (*pb.SetFavoriteResponse, error) {
+	userID := getContextUserID(ctx)
+
+	err := g.svs.EntityService.SetFavorite(ctx, in.Id, int32(userID), in.Favorite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetFavoriteResponse{Error: ""}, nil
+}
+
+// SearchEntities поиск сущностей пользователя по токенам слепого индекса
+// Метаинформация найденных сущностей отдается в зашифрованном виде, расшифровывает ее клиент
+func (g *GRPCServer) SearchEntities(ctx context.Context, in *pb.SearchEntitiesRequest) (*pb.SearchEntitiesResponse, error) {
+	userID := getContextUserID(ctx)
+
+	list, err := g.svs.EntityService.SearchEntities(ctx, int32(userID), in.Etype, in.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	var items = make([]*pb.FoundEntity, 0, len(list))
+	for _, item := range list {
+		var metainfo = make([]*pb.Metainfo, 0, len(item.Metainfo))
+		for _, val := range item.Metainfo {
+			metainfo = append(metainfo, &pb.Metainfo{
+				EntityId: val.EntityID,
+				Title:    val.Title,
+				Value:    val.Value,
+			})
+		}
+
+		items = append(items, &pb.FoundEntity{
+			Id:       item.ID,
+			Etype:    item.Etype,
+			Metainfo: metainfo,
+		})
+	}
+
+	return &pb.SearchEntitiesResponse{Items: items}, nil
+}
+
+// unixTime время в unix timestamp, нулевое время - 0
+func unixTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.Unix()
+}
+
 // getContextUserID получение кода порльзователя из переданного контекста
 func getContextUserID(ctx context.Context) int {
 	var token string
@@ -5,25 +5,101 @@ import (
 
 	"github.com/dnsoftware/gophkeeper/internal/constants"
 	pb "github.com/dnsoftware/gophkeeper/internal/proto"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
 )
 
-// EntityCodes получение справочника кодов сущностей
+// EntityCodes получение справочника кодов сущностей: встроенные типы и типы, созданные пользователем
 func (g *GRPCServer) EntityCodes(ctx context.Context, _ *pb.EntityCodesRequest) (*pb.EntityCodesResponse, error) {
 	ctx, cancel := context.WithTimeout(ctx, constants.DBContextTimeout)
 	defer cancel()
 
-	codes, err := g.svs.EntityCodeService.EntityCodes(ctx)
+	userID := getContextUserID(ctx)
+
+	codes, err := g.svs.EntityCodeService.EntityCodes(ctx, int32(userID))
 	if err != nil {
 		return nil, err
 	}
 
 	var ec []*pb.EntityCode
-	for etype, name := range codes {
+	for _, code := range codes {
 		ec = append(ec, &pb.EntityCode{
-			Etype: etype,
-			Name:  name,
+			Etype:    code.Etype,
+			Name:     code.Name,
+			Custom:   code.UserID != 0,
+			Archived: code.Archived,
 		})
 	}
 
 	return &pb.EntityCodesResponse{EntityCodes: ec}, nil
 }
+
+// CreateEntityType создание пользовательского типа сущности
+func (g *GRPCServer) CreateEntityType(ctx context.Context, in *pb.CreateEntityTypeRequest) (*pb.CreateEntityTypeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DBContextTimeout)
+	defer cancel()
+
+	userID := getContextUserID(ctx)
+
+	etype, err := g.svs.EntityCodeService.CreateEntityType(ctx, entity_code.CodeModel{
+		UserID: int32(userID),
+		Name:   in.Name,
+	}, fieldDefinitions(in.Fields))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateEntityTypeResponse{Etype: etype}, nil
+}
+
+// UpdateEntityType изменение пользовательского типа сущности
+func (g *GRPCServer) UpdateEntityType(ctx context.Context, in *pb.UpdateEntityTypeRequest) (*pb.UpdateEntityTypeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DBContextTimeout)
+	defer cancel()
+
+	userID := getContextUserID(ctx)
+
+	err := g.svs.EntityCodeService.UpdateEntityType(ctx, entity_code.CodeModel{
+		Etype:  in.Etype,
+		UserID: int32(userID),
+		Name:   in.Name,
+	}, fieldDefinitions(in.Fields))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateEntityTypeResponse{}, nil
+}
+
+// ArchiveEntityType перемещение пользовательского типа сущности в архив и возврат из архива
+func (g *GRPCServer) ArchiveEntityType(ctx context.Context, in *pb.ArchiveEntityTypeRequest) (*pb.ArchiveEntityTypeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, constants.DBContextTimeout)
+	defer cancel()
+
+	userID := getContextUserID(ctx)
+
+	err := g.svs.EntityCodeService.ArchiveEntityType(ctx, in.Etype, int32(userID), in.Archived)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ArchiveEntityTypeResponse{}, nil
+}
+
+// fieldDefinitions описания полей пользовательского типа из запроса, порядок полей - порядок в запросе
+func fieldDefinitions(in []*pb.Field) []field.EntityFields {
+	fields := make([]field.EntityFields, 0, len(in))
+	for _, f := range in {
+		fields = append(fields, field.EntityFields{
+			ID:               f.Id,
+			Name:             f.Name,
+			Ftype:            f.Ftype,
+			ValidateRules:    f.ValidateRules,
+			ValidateMessages: f.ValidateMessages,
+			Secret:           f.Secret,
+			Options:          f.Options,
+		})
+	}
+
+	return fields
+}
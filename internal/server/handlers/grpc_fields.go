@@ -12,6 +12,13 @@ func (g *GRPCServer) Fields(ctx context.Context, in *pb.FieldsRequest) (*pb.Fiel
 	ctx, cancel := context.WithTimeout(ctx, constants.DBContextTimeout)
 	defer cancel()
 
+	// поля чужих пользовательских типов не выдаются
+	userID := getContextUserID(ctx)
+	_, err := g.svs.EntityCodeService.EntityType(ctx, int32(userID), in.Etype)
+	if err != nil {
+		return nil, err
+	}
+
 	fields, err := g.svs.FieldService.Fields(ctx, in.Etype)
 	if err != nil {
 		return nil, err
@@ -26,6 +33,9 @@ func (g *GRPCServer) Fields(ctx context.Context, in *pb.FieldsRequest) (*pb.Fiel
 			Ftype:            val.Ftype,
 			ValidateRules:    val.ValidateRules,
 			ValidateMessages: val.ValidateMessages,
+			Secret:           val.Secret,
+			Position:         val.Position,
+			Options:          val.Options,
 		})
 	}
 
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/dnsoftware/gophkeeper/internal/proto"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
+)
+
+// Folders получение всех папок пользователя
+func (g *GRPCServer) Folders(ctx context.Context, _ *pb.FoldersRequest) (*pb.FoldersResponse, error) {
+	userID := getContextUserID(ctx)
+
+	list, err := g.svs.FolderService.Folders(ctx, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var folders = make([]*pb.Folder, 0, len(list))
+	for _, f := range list {
+		folders = append(folders, &pb.Folder{
+			Id:       f.ID,
+			ParentId: f.ParentID,
+			Name:     f.Name,
+		})
+	}
+
+	return &pb.FoldersResponse{Folders: folders}, nil
+}
+
+// CreateFolder создание папки
+func (g *GRPCServer) CreateFolder(ctx context.Context, in *pb.CreateFolderRequest) (*pb.CreateFolderResponse, error) {
+	userID := getContextUserID(ctx)
+
+	id, err := g.svs.FolderService.CreateFolder(ctx, folder.FolderModel{
+		UserID:   int32(userID),
+		ParentID: in.ParentId,
+		Name:     in.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateFolderResponse{Id: id}, nil
+}
+
+// UpdateFolder переименование и перемещение папки
+func (g *GRPCServer) UpdateFolder(ctx context.Context, in *pb.UpdateFolderRequest) (*pb.UpdateFolderResponse, error) {
+	userID := getContextUserID(ctx)
+
+	err := g.svs.FolderService.UpdateFolder(ctx, folder.FolderModel{
+		ID:       in.Id,
+		UserID:   int32(userID),
+		ParentID: in.ParentId,
+		Name:     in.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateFolderResponse{}, nil
+}
+
+// DeleteFolder удаление папки, вложенные папки и сущности переносятся в родительскую
+func (g *GRPCServer) DeleteFolder(ctx context.Context, in *pb.DeleteFolderRequest) (*pb.DeleteFolderResponse, error) {
+	userID := getContextUserID(ctx)
+
+	err := g.svs.FolderService.DeleteFolder(ctx, in.Id, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteFolderResponse{}, nil
+}
@@ -728,3 +728,73 @@ func TestDeleteEntity(t *testing.T) {
 	require.Contains(t, err.Error(), "no entity with id: 1")
 
 }
+
+// Корзина: удаление, восстановление и окончательное удаление сущности
+func TestTrashEntity(t *testing.T) {
+	client, conn, err := setupFull(cfg, cfgClient)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// регистрируем пользователя
+	login := "username"
+	password := "userpass"
+	_, err = client.Registration(login, password, password)
+	require.NoError(t, err)
+
+	entreq := domain.Entity{
+		Etype: constants.LogopasEntity,
+		Props: []*domain.Property{
+			{FieldId: 1, Value: "login"},
+			{FieldId: 2, Value: "password"},
+		},
+		Metainfo: []*domain.Metainfo{
+			{Title: "Сайт", Value: "gopher.ru"},
+		},
+	}
+
+	idEnt, err := client.AddEntity(entreq)
+	require.NoError(t, err)
+
+	// перемещаем в корзину
+	err = client.DeleteEntity(idEnt)
+	require.NoError(t, err)
+
+	list, err := client.EntityList(constants.LogopasEntity)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(list))
+
+	trash, err := client.ListTrash()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(trash))
+	require.Equal(t, idEnt, trash[0].Id)
+	require.Equal(t, "Сайт", trash[0].Metainfo[0].Title)
+	require.Equal(t, "gopher.ru", trash[0].Metainfo[0].Value)
+
+	// восстанавливаем
+	err = client.RestoreEntity(idEnt)
+	require.NoError(t, err)
+
+	ent, err := client.Entity(idEnt)
+	require.NoError(t, err)
+	require.Equal(t, "login", ent.Props[0].Value)
+
+	// повторное восстановление невозможно - сущности нет в корзине
+	err = client.RestoreEntity(idEnt)
+	require.Error(t, err)
+
+	// окончательное удаление возможно только из корзины
+	err = client.PurgeEntity(idEnt)
+	require.Error(t, err)
+
+	err = client.DeleteEntity(idEnt)
+	require.NoError(t, err)
+	err = client.PurgeEntity(idEnt)
+	require.NoError(t, err)
+
+	trash, err = client.ListTrash()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(trash))
+
+	err = client.RestoreEntity(idEnt)
+	require.Error(t, err)
+}
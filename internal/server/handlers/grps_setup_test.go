@@ -28,6 +28,7 @@ import (
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/user"
 	mock_domain "github.com/dnsoftware/gophkeeper/internal/server/mocks"
 	"github.com/dnsoftware/gophkeeper/internal/storage/postgresql"
@@ -47,8 +48,11 @@ func setupLight(cfg config.ServerConfig) error {
 	fieldsService, _ := field.NewField(repoFields)
 
 	repoEntity := &mock_domain.MockEntityRepo{}
-	entityService, _ := entity.NewEntity(repoEntity, repoFields)
-	server, err := NewGRPCServer(Services{userService, entityCodeService, fieldsService, entityService}, cfg.SertificateKeyPath, cfg.PrivateKeyPath)
+	entityService, _ := entity.NewEntity(repoEntity, &mock_domain.MockFieldRepo{})
+
+	repoFolder := &mock_domain.MockFolderStorage{}
+	folderService, _ := folder.NewFolder(repoFolder)
+	server, err := NewGRPCServer(Services{userService, entityCodeService, fieldsService, entityService, folderService}, cfg.SertificateKeyPath, cfg.PrivateKeyPath)
 	if err != nil {
 		return errors.New("Not start GRPC server: " + err.Error())
 	}
@@ -81,7 +85,8 @@ func setupFull(cfg config.ServerConfig, cfgClient configclient.ClientConfig) (*d
 	entityCodeService, _ := entity_code.NewEntityCode(repository)
 	fieldService, _ := field.NewField(repository)
 	entityService, _ := entity.NewEntity(repository, repository)
-	server, err := NewGRPCServer(Services{userService, entityCodeService, fieldService, entityService}, cfg.SertificateKeyPath, cfg.PrivateKeyPath)
+	folderService, _ := folder.NewFolder(repository)
+	server, err := NewGRPCServer(Services{userService, entityCodeService, fieldService, entityService, folderService}, cfg.SertificateKeyPath, cfg.PrivateKeyPath)
 	if err != nil {
 		return nil, nil, errors.New("Not start GRPC server: " + err.Error())
 	}
@@ -7,8 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	entity_code "github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	field "github.com/dnsoftware/gophkeeper/internal/server/domain/field"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +38,21 @@ func (m *MockEntityRepo) EXPECT() *MockEntityRepoMockRecorder {
 	return m.recorder
 }
 
+// CreateAttachment mocks base method.
+func (m *MockEntityRepo) CreateAttachment(ctx context.Context, att entity.AttachmentModel) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", ctx, att)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockEntityRepoMockRecorder) CreateAttachment(ctx, att interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockEntityRepo)(nil).CreateAttachment), ctx, att)
+}
+
 // CreateEntity mocks base method.
 func (m *MockEntityRepo) CreateEntity(ctx context.Context, entity entity.EntityModel) (int32, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +68,20 @@ func (mr *MockEntityRepoMockRecorder) CreateEntity(ctx, entity interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntity", reflect.TypeOf((*MockEntityRepo)(nil).CreateEntity), ctx, entity)
 }
 
+// DeleteAttachment mocks base method.
+func (m *MockEntityRepo) DeleteAttachment(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockEntityRepoMockRecorder) DeleteAttachment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockEntityRepo)(nil).DeleteAttachment), ctx, id)
+}
+
 // DeleteEntity mocks base method.
 func (m *MockEntityRepo) DeleteEntity(ctx context.Context, id, userID int32) error {
 	m.ctrl.T.Helper()
@@ -64,6 +96,36 @@ func (mr *MockEntityRepoMockRecorder) DeleteEntity(ctx, id, userID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntity", reflect.TypeOf((*MockEntityRepo)(nil).DeleteEntity), ctx, id, userID)
 }
 
+// GetAttachment mocks base method.
+func (m *MockEntityRepo) GetAttachment(ctx context.Context, id int32) (entity.AttachmentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", ctx, id)
+	ret0, _ := ret[0].(entity.AttachmentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockEntityRepoMockRecorder) GetAttachment(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockEntityRepo)(nil).GetAttachment), ctx, id)
+}
+
+// GetAttachments mocks base method.
+func (m *MockEntityRepo) GetAttachments(ctx context.Context, entityID int32) ([]entity.AttachmentModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachments", ctx, entityID)
+	ret0, _ := ret[0].([]entity.AttachmentModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachments indicates an expected call of GetAttachments.
+func (mr *MockEntityRepoMockRecorder) GetAttachments(ctx, entityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachments", reflect.TypeOf((*MockEntityRepo)(nil).GetAttachments), ctx, entityID)
+}
+
 // GetBinaryFilenameByEntityID mocks base method.
 func (m *MockEntityRepo) GetBinaryFilenameByEntityID(ctx context.Context, entityID int32) (string, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +141,21 @@ func (mr *MockEntityRepoMockRecorder) GetBinaryFilenameByEntityID(ctx, entityID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBinaryFilenameByEntityID", reflect.TypeOf((*MockEntityRepo)(nil).GetBinaryFilenameByEntityID), ctx, entityID)
 }
 
+// GetDeletedEntity mocks base method.
+func (m *MockEntityRepo) GetDeletedEntity(ctx context.Context, id, userID int32) (entity.EntityModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedEntity", ctx, id, userID)
+	ret0, _ := ret[0].(entity.EntityModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedEntity indicates an expected call of GetDeletedEntity.
+func (mr *MockEntityRepoMockRecorder) GetDeletedEntity(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedEntity", reflect.TypeOf((*MockEntityRepo)(nil).GetDeletedEntity), ctx, id, userID)
+}
+
 // GetEntity mocks base method.
 func (m *MockEntityRepo) GetEntity(ctx context.Context, id int32) (entity.EntityModel, error) {
 	m.ctrl.T.Helper()
@@ -95,18 +172,120 @@ func (mr *MockEntityRepoMockRecorder) GetEntity(ctx, id interface{}) *gomock.Cal
 }
 
 // GetEntityListByType mocks base method.
-func (m *MockEntityRepo) GetEntityListByType(ctx context.Context, etype string, userID int32) (map[int32][]string, error) {
+func (m *MockEntityRepo) GetEntityListByType(ctx context.Context, etype string, userID int32, filter entity.ListFilter) ([]entity.EntitySummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntityListByType", ctx, etype, userID)
-	ret0, _ := ret[0].(map[int32][]string)
+	ret := m.ctrl.Call(m, "GetEntityListByType", ctx, etype, userID, filter)
+	ret0, _ := ret[0].([]entity.EntitySummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEntityListByType indicates an expected call of GetEntityListByType.
-func (mr *MockEntityRepoMockRecorder) GetEntityListByType(ctx, etype, userID interface{}) *gomock.Call {
+func (mr *MockEntityRepoMockRecorder) GetEntityListByType(ctx, etype, userID, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityListByType", reflect.TypeOf((*MockEntityRepo)(nil).GetEntityListByType), ctx, etype, userID, filter)
+}
+
+// GetExpiredTrash mocks base method.
+func (m *MockEntityRepo) GetExpiredTrash(ctx context.Context, deletedBefore time.Time) ([]entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredTrash", ctx, deletedBefore)
+	ret0, _ := ret[0].([]entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredTrash indicates an expected call of GetExpiredTrash.
+func (mr *MockEntityRepoMockRecorder) GetExpiredTrash(ctx, deletedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredTrash", reflect.TypeOf((*MockEntityRepo)(nil).GetExpiredTrash), ctx, deletedBefore)
+}
+
+// GetTrashList mocks base method.
+func (m *MockEntityRepo) GetTrashList(ctx context.Context, userID int32) ([]entity.TrashItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashList", ctx, userID)
+	ret0, _ := ret[0].([]entity.TrashItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashList indicates an expected call of GetTrashList.
+func (mr *MockEntityRepoMockRecorder) GetTrashList(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashList", reflect.TypeOf((*MockEntityRepo)(nil).GetTrashList), ctx, userID)
+}
+
+// ListEntities mocks base method.
+func (m *MockEntityRepo) ListEntities(ctx context.Context, userID int32, page entity.ListPage) ([]entity.EntitySummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntities", ctx, userID, page)
+	ret0, _ := ret[0].([]entity.EntitySummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntities indicates an expected call of ListEntities.
+func (mr *MockEntityRepoMockRecorder) ListEntities(ctx, userID, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntities", reflect.TypeOf((*MockEntityRepo)(nil).ListEntities), ctx, userID, page)
+}
+
+// LockEntity mocks base method.
+func (m *MockEntityRepo) LockEntity(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockEntity", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockEntity indicates an expected call of LockEntity.
+func (mr *MockEntityRepoMockRecorder) LockEntity(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockEntity", reflect.TypeOf((*MockEntityRepo)(nil).LockEntity), ctx, id)
+}
+
+// PurgeEntity mocks base method.
+func (m *MockEntityRepo) PurgeEntity(ctx context.Context, id, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeEntity", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeEntity indicates an expected call of PurgeEntity.
+func (mr *MockEntityRepoMockRecorder) PurgeEntity(ctx, id, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityListByType", reflect.TypeOf((*MockEntityRepo)(nil).GetEntityListByType), ctx, etype, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeEntity", reflect.TypeOf((*MockEntityRepo)(nil).PurgeEntity), ctx, id, userID)
+}
+
+// RestoreEntity mocks base method.
+func (m *MockEntityRepo) RestoreEntity(ctx context.Context, id, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreEntity", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreEntity indicates an expected call of RestoreEntity.
+func (mr *MockEntityRepoMockRecorder) RestoreEntity(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreEntity", reflect.TypeOf((*MockEntityRepo)(nil).RestoreEntity), ctx, id, userID)
+}
+
+// SearchEntities mocks base method.
+func (m *MockEntityRepo) SearchEntities(ctx context.Context, userID int32, etype string, tokens []string) ([]entity.FoundEntity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchEntities", ctx, userID, etype, tokens)
+	ret0, _ := ret[0].([]entity.FoundEntity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchEntities indicates an expected call of SearchEntities.
+func (mr *MockEntityRepoMockRecorder) SearchEntities(ctx, userID, etype, tokens interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchEntities", reflect.TypeOf((*MockEntityRepo)(nil).SearchEntities), ctx, userID, etype, tokens)
 }
 
 // SetChunkCountForCryptoBinary mocks base method.
@@ -123,6 +302,48 @@ func (mr *MockEntityRepoMockRecorder) SetChunkCountForCryptoBinary(ctx, entityID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChunkCountForCryptoBinary", reflect.TypeOf((*MockEntityRepo)(nil).SetChunkCountForCryptoBinary), ctx, entityID, chunkCount)
 }
 
+// SetFavorite mocks base method.
+func (m *MockEntityRepo) SetFavorite(ctx context.Context, id, userID int32, favorite bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavorite", ctx, id, userID, favorite)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockEntityRepoMockRecorder) SetFavorite(ctx, id, userID, favorite interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockEntityRepo)(nil).SetFavorite), ctx, id, userID, favorite)
+}
+
+// TouchEntity mocks base method.
+func (m *MockEntityRepo) TouchEntity(ctx context.Context, id, userID int32, accessedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchEntity", ctx, id, userID, accessedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchEntity indicates an expected call of TouchEntity.
+func (mr *MockEntityRepoMockRecorder) TouchEntity(ctx, id, userID, accessedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchEntity", reflect.TypeOf((*MockEntityRepo)(nil).TouchEntity), ctx, id, userID, accessedAt)
+}
+
+// Transaction mocks base method.
+func (m *MockEntityRepo) Transaction(ctx context.Context, fn func(entity.EntityRepo) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockEntityRepoMockRecorder) Transaction(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockEntityRepo)(nil).Transaction), ctx, fn)
+}
+
 // UpdateEntity mocks base method.
 func (m *MockEntityRepo) UpdateEntity(ctx context.Context, entity entity.EntityModel) error {
 	m.ctrl.T.Helper()
@@ -160,6 +381,36 @@ func (m *MockFieldRepo) EXPECT() *MockFieldRepoMockRecorder {
 	return m.recorder
 }
 
+// GetEntityCode mocks base method.
+func (m *MockFieldRepo) GetEntityCode(ctx context.Context, etype string) (entity_code.CodeModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityCode", ctx, etype)
+	ret0, _ := ret[0].(entity_code.CodeModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityCode indicates an expected call of GetEntityCode.
+func (mr *MockFieldRepoMockRecorder) GetEntityCode(ctx, etype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityCode", reflect.TypeOf((*MockFieldRepo)(nil).GetEntityCode), ctx, etype)
+}
+
+// GetEntityFields mocks base method.
+func (m *MockFieldRepo) GetEntityFields(ctx context.Context, etype string) ([]field.EntityFields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityFields", ctx, etype)
+	ret0, _ := ret[0].([]field.EntityFields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityFields indicates an expected call of GetEntityFields.
+func (mr *MockFieldRepoMockRecorder) GetEntityFields(ctx, etype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityFields", reflect.TypeOf((*MockFieldRepo)(nil).GetEntityFields), ctx, etype)
+}
+
 // IsFieldType mocks base method.
 func (m *MockFieldRepo) IsFieldType(ctx context.Context, id int32, ftype string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -8,6 +8,8 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity_code "github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	field "github.com/dnsoftware/gophkeeper/internal/server/domain/field"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -34,17 +36,89 @@ func (m *MockEntityCodeStorage) EXPECT() *MockEntityCodeStorageMockRecorder {
 	return m.recorder
 }
 
+// CreateEntityType mocks base method.
+func (m *MockEntityCodeStorage) CreateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntityType", ctx, code, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEntityType indicates an expected call of CreateEntityType.
+func (mr *MockEntityCodeStorageMockRecorder) CreateEntityType(ctx, code, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntityType", reflect.TypeOf((*MockEntityCodeStorage)(nil).CreateEntityType), ctx, code, fields)
+}
+
+// GetEntityCode mocks base method.
+func (m *MockEntityCodeStorage) GetEntityCode(ctx context.Context, etype string) (entity_code.CodeModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityCode", ctx, etype)
+	ret0, _ := ret[0].(entity_code.CodeModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityCode indicates an expected call of GetEntityCode.
+func (mr *MockEntityCodeStorageMockRecorder) GetEntityCode(ctx, etype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityCode", reflect.TypeOf((*MockEntityCodeStorage)(nil).GetEntityCode), ctx, etype)
+}
+
 // GetEntityCodes mocks base method.
-func (m *MockEntityCodeStorage) GetEntityCodes(ctx context.Context) (map[string]string, error) {
+func (m *MockEntityCodeStorage) GetEntityCodes(ctx context.Context, userID int32) ([]entity_code.CodeModel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEntityCodes", ctx)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "GetEntityCodes", ctx, userID)
+	ret0, _ := ret[0].([]entity_code.CodeModel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetEntityCodes indicates an expected call of GetEntityCodes.
-func (mr *MockEntityCodeStorageMockRecorder) GetEntityCodes(ctx interface{}) *gomock.Call {
+func (mr *MockEntityCodeStorageMockRecorder) GetEntityCodes(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityCodes", reflect.TypeOf((*MockEntityCodeStorage)(nil).GetEntityCodes), ctx, userID)
+}
+
+// GetEntityFields mocks base method.
+func (m *MockEntityCodeStorage) GetEntityFields(ctx context.Context, etype string) ([]field.EntityFields, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntityFields", ctx, etype)
+	ret0, _ := ret[0].([]field.EntityFields)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntityFields indicates an expected call of GetEntityFields.
+func (mr *MockEntityCodeStorageMockRecorder) GetEntityFields(ctx, etype interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityFields", reflect.TypeOf((*MockEntityCodeStorage)(nil).GetEntityFields), ctx, etype)
+}
+
+// SetEntityTypeArchived mocks base method.
+func (m *MockEntityCodeStorage) SetEntityTypeArchived(ctx context.Context, etype string, userID int32, archived bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEntityTypeArchived", ctx, etype, userID, archived)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEntityTypeArchived indicates an expected call of SetEntityTypeArchived.
+func (mr *MockEntityCodeStorageMockRecorder) SetEntityTypeArchived(ctx, etype, userID, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEntityTypeArchived", reflect.TypeOf((*MockEntityCodeStorage)(nil).SetEntityTypeArchived), ctx, etype, userID, archived)
+}
+
+// UpdateEntityType mocks base method.
+func (m *MockEntityCodeStorage) UpdateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntityType", ctx, code, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEntityType indicates an expected call of UpdateEntityType.
+func (mr *MockEntityCodeStorageMockRecorder) UpdateEntityType(ctx, code, fields interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntityCodes", reflect.TypeOf((*MockEntityCodeStorage)(nil).GetEntityCodes), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntityType", reflect.TypeOf((*MockEntityCodeStorage)(nil).UpdateEntityType), ctx, code, fields)
 }
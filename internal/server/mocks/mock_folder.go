@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/server/domain/folder/folder.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	folder "github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFolderStorage is a mock of FolderStorage interface.
+type MockFolderStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockFolderStorageMockRecorder
+}
+
+// MockFolderStorageMockRecorder is the mock recorder for MockFolderStorage.
+type MockFolderStorageMockRecorder struct {
+	mock *MockFolderStorage
+}
+
+// NewMockFolderStorage creates a new mock instance.
+func NewMockFolderStorage(ctrl *gomock.Controller) *MockFolderStorage {
+	mock := &MockFolderStorage{ctrl: ctrl}
+	mock.recorder = &MockFolderStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFolderStorage) EXPECT() *MockFolderStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateFolder mocks base method.
+func (m *MockFolderStorage) CreateFolder(ctx context.Context, folder folder.FolderModel) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFolder", ctx, folder)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFolder indicates an expected call of CreateFolder.
+func (mr *MockFolderStorageMockRecorder) CreateFolder(ctx, folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFolder", reflect.TypeOf((*MockFolderStorage)(nil).CreateFolder), ctx, folder)
+}
+
+// DeleteFolder mocks base method.
+func (m *MockFolderStorage) DeleteFolder(ctx context.Context, id, userID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFolder", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFolder indicates an expected call of DeleteFolder.
+func (mr *MockFolderStorageMockRecorder) DeleteFolder(ctx, id, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFolder", reflect.TypeOf((*MockFolderStorage)(nil).DeleteFolder), ctx, id, userID)
+}
+
+// GetFolders mocks base method.
+func (m *MockFolderStorage) GetFolders(ctx context.Context, userID int32) ([]folder.FolderModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFolders", ctx, userID)
+	ret0, _ := ret[0].([]folder.FolderModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFolders indicates an expected call of GetFolders.
+func (mr *MockFolderStorageMockRecorder) GetFolders(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFolders", reflect.TypeOf((*MockFolderStorage)(nil).GetFolders), ctx, userID)
+}
+
+// UpdateFolder mocks base method.
+func (m *MockFolderStorage) UpdateFolder(ctx context.Context, folder folder.FolderModel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFolder", ctx, folder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFolder indicates an expected call of UpdateFolder.
+func (mr *MockFolderStorageMockRecorder) UpdateFolder(ctx, folder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFolder", reflect.TypeOf((*MockFolderStorage)(nil).UpdateFolder), ctx, folder)
+}
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+)
+
+// LockEntity Проверка наличия сущности
+// Транзакция блокирует все хранилище, поэтому транзакции уже выполняются по очереди
+func (m *MemStorage) LockEntity(ctx context.Context, id int32) error {
+	defer m.rlock()()
+
+	if _, ok := m.data.entities[id]; !ok {
+		return fmt.Errorf("no entity with id: %v", id)
+	}
+
+	return nil
+}
+
+// CreateAttachment Сохранение описания вложения сущности
+func (m *MemStorage) CreateAttachment(ctx context.Context, att entity.AttachmentModel) (int32, error) {
+	defer m.lock()()
+
+	d := m.data
+	if _, ok := d.entities[att.EntityID]; !ok {
+		return 0, fmt.Errorf("no entity with id: %v", att.EntityID)
+	}
+
+	d.lastAttachmentID++
+	att.ID = d.lastAttachmentID
+	att.CreatedAt = time.Now()
+	d.attachments[att.ID] = att
+
+	return att.ID, nil
+}
+
+// GetAttachments Получение вложений сущности в порядке добавления
+func (m *MemStorage) GetAttachments(ctx context.Context, entityID int32) ([]entity.AttachmentModel, error) {
+	defer m.rlock()()
+
+	var list []entity.AttachmentModel
+	for _, att := range m.data.attachments {
+		if att.EntityID == entityID {
+			list = append(list, att)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return list, nil
+}
+
+// GetAttachment Получение вложения по ID
+func (m *MemStorage) GetAttachment(ctx context.Context, id int32) (entity.AttachmentModel, error) {
+	defer m.rlock()()
+
+	att, ok := m.data.attachments[id]
+	if !ok {
+		return entity.AttachmentModel{}, fmt.Errorf("no attachment with id: %v", id)
+	}
+
+	return att, nil
+}
+
+// DeleteAttachment Удаление описания вложения
+func (m *MemStorage) DeleteAttachment(ctx context.Context, id int32) error {
+	defer m.lock()()
+
+	if _, ok := m.data.attachments[id]; !ok {
+		return fmt.Errorf("no attachment with id: %v", id)
+	}
+	delete(m.data.attachments, id)
+
+	return nil
+}
@@ -0,0 +1,522 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+)
+
+// CreateEntity создание сущности
+func (m *MemStorage) CreateEntity(ctx context.Context, ent entity.EntityModel) (int32, error) {
+	defer m.lock()()
+
+	d := m.data
+	if _, ok := d.users[int(ent.UserID)]; !ok {
+		return 0, fmt.Errorf("no user with id: %v", ent.UserID)
+	}
+	err := m.dict.checkEntity(ent)
+	if err != nil {
+		return 0, err
+	}
+	err = d.checkFolder(ent.FolderID, ent.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	d.lastEntityID++
+	row := &entityRow{
+		id:        d.lastEntityID,
+		userID:    ent.UserID,
+		etype:     ent.Etype,
+		createdAt: time.Now(),
+		revision:  1,
+		tokens:    uniqueTokens(ent.Tokens),
+		folderID:  ent.FolderID,
+		tags:      append([]string(nil), ent.Tags...),
+	}
+	d.entities[row.id] = row
+
+	for _, prop := range ent.Props {
+		d.upsertProperty(row.id, prop.FieldID, prop.Value)
+	}
+	d.addMetainfo(row.id, ent.Metainfo)
+
+	return row.id, nil
+}
+
+// UpdateEntity Сохранение отредактированной сущности
+func (m *MemStorage) UpdateEntity(ctx context.Context, ent entity.EntityModel) error {
+	defer m.lock()()
+
+	d := m.data
+	row, ok := d.entities[ent.ID]
+	if !ok || row.userID != ent.UserID || row.deletedAt != nil {
+		return fmt.Errorf("no entity with id: %v", ent.ID)
+	}
+	err := m.dict.checkEntity(ent)
+	if err != nil {
+		return err
+	}
+	err = d.checkFolder(ent.FolderID, ent.UserID)
+	if err != nil {
+		return err
+	}
+
+	row.updatedAt = time.Now()
+	row.revision++
+	row.folderID = ent.FolderID
+	row.tags = append([]string(nil), ent.Tags...)
+	for _, prop := range ent.Props {
+		d.upsertProperty(ent.ID, prop.FieldID, prop.Value)
+	}
+
+	d.deleteMetainfo(ent.ID)
+	d.addMetainfo(ent.ID, ent.Metainfo)
+	row.tokens = uniqueTokens(ent.Tokens)
+
+	return nil
+}
+
+// GetEntity получить сущность
+func (m *MemStorage) GetEntity(ctx context.Context, id int32) (entity.EntityModel, error) {
+	defer m.rlock()()
+
+	row, ok := m.data.entities[id]
+	if !ok || row.deletedAt != nil {
+		return entity.EntityModel{}, fmt.Errorf("no entity with id: %v", id)
+	}
+
+	return m.data.model(row), nil
+}
+
+// GetBinaryFilenameByEntityID Получение данных по именам файлов хранения бинарных данных
+func (m *MemStorage) GetBinaryFilenameByEntityID(ctx context.Context, entityID int32) (string, error) {
+	defer m.rlock()()
+
+	row, ok := m.data.entities[entityID]
+	if ok && row.deletedAt == nil {
+		for _, prop := range m.data.properties {
+			if prop.EntityID == entityID {
+				return prop.Value, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no property with entityID: %v", entityID)
+}
+
+// SetChunkCountForCryptoBinary Сохранение кол-ва фрагментов, на которые разбит бинарный файл
+func (m *MemStorage) SetChunkCountForCryptoBinary(ctx context.Context, entityID int32, chunkCount int32) error {
+	defer m.lock()()
+
+	for i, prop := range m.data.properties {
+		if prop.EntityID != entityID {
+			continue
+		}
+
+		fd := &entity.BinaryFileProperty{}
+		err := json.Unmarshal([]byte(prop.Value), fd)
+		if err != nil {
+			return err
+		}
+
+		fd.Chunkcount = chunkCount
+		filedata, err := json.Marshal(fd)
+		if err != nil {
+			return err
+		}
+		m.data.properties[i].Value = string(filedata)
+
+		return nil
+	}
+
+	return fmt.Errorf("no property with entityID: %v", entityID)
+}
+
+// GetEntityListByType Получение списка сущностей указанного типа для конкретного пользователя
+// Сущности с метаинформацией и отметками в порядке возрастания ID
+func (m *MemStorage) GetEntityListByType(ctx context.Context, etype string, userID int32, filter entity.ListFilter) ([]entity.EntitySummary, error) {
+	defer m.rlock()()
+
+	var list []entity.EntitySummary
+	for _, row := range m.data.sortedEntities() {
+		if etype != "" && row.etype != etype || row.userID != userID || row.deletedAt != nil {
+			continue
+		}
+		if !filter.Match(row.folderID, row.favorite, row.tokens) {
+			continue
+		}
+
+		list = append(list, entity.EntitySummary{
+			ID:         row.id,
+			Etype:      row.etype,
+			FolderID:   row.folderID,
+			Metainfo:   m.data.entityMetainfo(row.id),
+			Favorite:   row.favorite,
+			CreatedAt:  row.createdAt,
+			UpdatedAt:  row.updatedAt,
+			AccessedAt: row.accessedAt,
+			Revision:   row.revision,
+		})
+	}
+
+	return list, nil
+}
+
+// SetFavorite Добавление сущности пользователя в избранное или удаление из него
+func (m *MemStorage) SetFavorite(ctx context.Context, id int32, userID int32, favorite bool) error {
+	defer m.lock()()
+
+	row, ok := m.data.entities[id]
+	if !ok || row.userID != userID || row.deletedAt != nil {
+		return fmt.Errorf("no entity with id: %v", id)
+	}
+	row.favorite = favorite
+
+	return nil
+}
+
+// ListEntities Страница списка сущностей пользователя в порядке сортировки запроса
+func (m *MemStorage) ListEntities(ctx context.Context, userID int32, page entity.ListPage) ([]entity.EntitySummary, error) {
+	defer m.rlock()()
+
+	etypes := make(map[string]bool, len(page.Etypes))
+	for _, etype := range page.Etypes {
+		etypes[etype] = true
+	}
+
+	var list []entity.EntitySummary
+	for _, row := range m.data.entities {
+		if len(etypes) > 0 && !etypes[row.etype] || row.userID != userID || row.deletedAt != nil {
+			continue
+		}
+		if !page.Filter.Match(row.folderID, row.favorite, row.tokens) {
+			continue
+		}
+
+		item := entity.EntitySummary{
+			ID:         row.id,
+			Etype:      row.etype,
+			FolderID:   row.folderID,
+			Favorite:   row.favorite,
+			CreatedAt:  row.createdAt,
+			UpdatedAt:  row.updatedAt,
+			AccessedAt: row.accessedAt,
+			Revision:   row.revision,
+		}
+		if page.After != nil && !page.Before(*page.After, page.Key(item)) {
+			continue
+		}
+		list = append(list, item)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return page.Before(page.Key(list[i]), page.Key(list[j]))
+	})
+	if len(list) > int(page.Limit) {
+		list = list[:page.Limit]
+	}
+	for i := range list {
+		list[i].Metainfo = m.data.entityMetainfo(list[i].ID)
+	}
+
+	return list, nil
+}
+
+// TouchEntity Запись времени получения сущности пользователя
+func (m *MemStorage) TouchEntity(ctx context.Context, id int32, userID int32, accessedAt time.Time) error {
+	defer m.lock()()
+
+	if row, ok := m.data.entities[id]; ok && row.userID == userID {
+		row.accessedAt = accessedAt
+	}
+
+	return nil
+}
+
+// SearchEntities Поиск сущностей пользователя, у которых есть все указанные токены слепого индекса
+// Пустой etype - сущности всех типов
+func (m *MemStorage) SearchEntities(ctx context.Context, userID int32, etype string, tokens []string) ([]entity.FoundEntity, error) {
+	defer m.rlock()()
+
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	var list []entity.FoundEntity
+	for _, row := range m.data.sortedEntities() {
+		if row.userID != userID || row.deletedAt != nil || etype != "" && row.etype != etype {
+			continue
+		}
+		if !hasTokens(row.tokens, tokens) {
+			continue
+		}
+		list = append(list, entity.FoundEntity{
+			ID:       row.id,
+			Etype:    row.etype,
+			Metainfo: m.data.entityMetainfo(row.id),
+		})
+	}
+
+	return list, nil
+}
+
+// DeleteEntity Перемещение сущности в корзину (мягкое удаление)
+func (m *MemStorage) DeleteEntity(ctx context.Context, id int32, userID int32) error {
+	defer m.lock()()
+
+	row, ok := m.data.entities[id]
+	if !ok || row.userID != userID || row.deletedAt != nil {
+		return fmt.Errorf("no entity with id: %v", id)
+	}
+
+	now := time.Now()
+	row.deletedAt = &now
+
+	return nil
+}
+
+// GetTrashList Получение списка сущностей пользователя, находящихся в корзине (новые удаленные - первыми)
+func (m *MemStorage) GetTrashList(ctx context.Context, userID int32) ([]entity.TrashItem, error) {
+	defer m.rlock()()
+
+	var list []entity.TrashItem
+	for _, row := range m.data.sortedEntities() {
+		if row.userID != userID || row.deletedAt == nil {
+			continue
+		}
+		list = append(list, m.data.trashItem(row))
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].DeletedAt.After(list[j].DeletedAt)
+	})
+
+	return list, nil
+}
+
+// GetDeletedEntity Получение сущности пользователя, находящейся в корзине
+func (m *MemStorage) GetDeletedEntity(ctx context.Context, id int32, userID int32) (entity.EntityModel, error) {
+	defer m.rlock()()
+
+	row, ok := m.data.entities[id]
+	if !ok || row.userID != userID || row.deletedAt == nil {
+		return entity.EntityModel{}, fmt.Errorf("no entity in trash with id: %v", id)
+	}
+
+	return m.data.model(row), nil
+}
+
+// RestoreEntity Восстановление сущности из корзины
+func (m *MemStorage) RestoreEntity(ctx context.Context, id int32, userID int32) error {
+	defer m.lock()()
+
+	row, ok := m.data.entities[id]
+	if !ok || row.userID != userID || row.deletedAt == nil {
+		return fmt.Errorf("no entity in trash with id: %v", id)
+	}
+
+	row.deletedAt = nil
+	row.updatedAt = time.Now()
+
+	return nil
+}
+
+// PurgeEntity Окончательное удаление данных сущности, находящейся в корзине, вместе со свойствами, метаинформацией и вложениями
+func (m *MemStorage) PurgeEntity(ctx context.Context, id int32, userID int32) error {
+	defer m.lock()()
+
+	d := m.data
+	row, ok := d.entities[id]
+	if !ok || row.userID != userID || row.deletedAt == nil {
+		return fmt.Errorf("no entity in trash with id: %v", id)
+	}
+
+	delete(d.entities, id)
+
+	props := d.properties[:0]
+	for _, prop := range d.properties {
+		if prop.EntityID != id {
+			props = append(props, prop)
+		}
+	}
+	d.properties = props
+	d.deleteMetainfo(id)
+	for attID, att := range d.attachments {
+		if att.EntityID == id {
+			delete(d.attachments, attID)
+		}
+	}
+
+	return nil
+}
+
+// GetExpiredTrash Получение сущностей, перемещенных в корзину ранее указанного момента
+func (m *MemStorage) GetExpiredTrash(ctx context.Context, deletedBefore time.Time) ([]entity.TrashItem, error) {
+	defer m.rlock()()
+
+	var list []entity.TrashItem
+	for _, row := range m.data.sortedEntities() {
+		if row.deletedAt == nil || !row.deletedAt.Before(deletedBefore) {
+			continue
+		}
+		list = append(list, entity.TrashItem{
+			ID:        row.id,
+			UserID:    row.userID,
+			Etype:     row.etype,
+			DeletedAt: *row.deletedAt,
+		})
+	}
+
+	return list, nil
+}
+
+// checkEntity проверка ссылок сущности на тип и свойств на описания полей (аналог внешних ключей)
+func (d *dictionary) checkEntity(ent entity.EntityModel) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if _, ok := d.entityCodes[ent.Etype]; !ok {
+		return fmt.Errorf("no entity type: %v", ent.Etype)
+	}
+	for _, prop := range ent.Props {
+		if d.field(prop.FieldID) == nil {
+			return fmt.Errorf("no field with id: %v", prop.FieldID)
+		}
+	}
+
+	return nil
+}
+
+// upsertProperty добавление свойства или обновление значения существующего (пара entity_id, field_id уникальна)
+func (d *memData) upsertProperty(entityID int32, fieldID int32, value string) {
+	for i, prop := range d.properties {
+		if prop.EntityID == entityID && prop.FieldID == fieldID {
+			d.properties[i].Value = value
+			return
+		}
+	}
+
+	d.lastPropertyID++
+	d.properties = append(d.properties, entity.Property{
+		ID:       d.lastPropertyID,
+		EntityID: entityID,
+		FieldID:  fieldID,
+		Value:    value,
+	})
+}
+
+// addMetainfo добавление метаинформации сущности
+func (d *memData) addMetainfo(entityID int32, metainfo []entity.Metainfo) {
+	for _, meta := range metainfo {
+		d.lastMetaID++
+		d.metainfo = append(d.metainfo, entity.Metainfo{
+			ID:       d.lastMetaID,
+			EntityID: entityID,
+			Title:    meta.Title,
+			Value:    meta.Value,
+		})
+	}
+}
+
+// deleteMetainfo удаление метаинформации сущности
+func (d *memData) deleteMetainfo(entityID int32) {
+	metainfo := d.metainfo[:0]
+	for _, meta := range d.metainfo {
+		if meta.EntityID != entityID {
+			metainfo = append(metainfo, meta)
+		}
+	}
+	d.metainfo = metainfo
+}
+
+// entityMetainfo метаинформация сущности
+func (d *memData) entityMetainfo(entityID int32) []entity.Metainfo {
+	var metainfo []entity.Metainfo
+	for _, meta := range d.metainfo {
+		if meta.EntityID == entityID {
+			metainfo = append(metainfo, meta)
+		}
+	}
+
+	return metainfo
+}
+
+// model сущность со свойствами и метаинформацией
+func (d *memData) model(row *entityRow) entity.EntityModel {
+	ent := entity.EntityModel{
+		ID:         row.id,
+		UserID:     row.userID,
+		Etype:      row.etype,
+		Metainfo:   d.entityMetainfo(row.id),
+		FolderID:   row.folderID,
+		Tags:       append([]string(nil), row.tags...),
+		Favorite:   row.favorite,
+		AccessedAt: row.accessedAt,
+	}
+
+	for _, prop := range d.properties {
+		if prop.EntityID == row.id {
+			ent.Props = append(ent.Props, prop)
+		}
+	}
+
+	return ent
+}
+
+// trashItem элемент корзины
+func (d *memData) trashItem(row *entityRow) entity.TrashItem {
+	return entity.TrashItem{
+		ID:        row.id,
+		UserID:    row.userID,
+		Etype:     row.etype,
+		Metainfo:  d.entityMetainfo(row.id),
+		DeletedAt: *row.deletedAt,
+	}
+}
+
+// uniqueTokens токены без повторов (аналог первичного ключа таблицы blind_index)
+func uniqueTokens(tokens []string) []string {
+	var uniq []string
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		if !seen[token] {
+			seen[token] = true
+			uniq = append(uniq, token)
+		}
+	}
+
+	return uniq
+}
+
+// hasTokens у сущности есть все искомые токены
+func hasTokens(have []string, want []string) bool {
+	set := make(map[string]bool, len(have))
+	for _, token := range have {
+		set[token] = true
+	}
+	for _, token := range want {
+		if !set[token] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortedEntities сущности в порядке возрастания ID
+func (d *memData) sortedEntities() []*entityRow {
+	rows := make([]*entityRow, 0, len(d.entities))
+	for _, row := range d.entities {
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].id < rows[j].id
+	})
+
+	return rows
+}
@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+)
+
+// GetEntityCodes получение типов сущностей, доступных пользователю: встроенные и созданные им
+func (m *MemStorage) GetEntityCodes(ctx context.Context, userID int32) ([]entity_code.CodeModel, error) {
+	m.dict.mu.RLock()
+	defer m.dict.mu.RUnlock()
+
+	var res []entity_code.CodeModel
+	for _, code := range m.dict.entityCodes {
+		if code.UserID == 0 || code.UserID == userID {
+			res = append(res, code)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].UserID != res[j].UserID {
+			return res[i].UserID < res[j].UserID
+		}
+		return res[i].Etype < res[j].Etype
+	})
+
+	return res, nil
+}
+
+// GetEntityCode получение типа сущности по коду, у несуществующего типа пустой Etype
+func (m *MemStorage) GetEntityCode(ctx context.Context, etype string) (entity_code.CodeModel, error) {
+	m.dict.mu.RLock()
+	defer m.dict.mu.RUnlock()
+
+	return m.dict.entityCodes[etype], nil
+}
+
+// CreateEntityType создание пользовательского типа сущности вместе с описаниями полей
+func (m *MemStorage) CreateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) error {
+	m.dict.mu.Lock()
+	defer m.dict.mu.Unlock()
+
+	if _, ok := m.dict.entityCodes[code.Etype]; ok {
+		return fmt.Errorf("CreateEntityType: entity type %v already exists", code.Etype)
+	}
+	m.dict.entityCodes[code.Etype] = code
+
+	return m.dict.saveFields(fields)
+}
+
+// UpdateEntityType изменение названия пользовательского типа, изменение полей и добавление новых (ID = 0)
+func (m *MemStorage) UpdateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) error {
+	m.dict.mu.Lock()
+	defer m.dict.mu.Unlock()
+
+	current, ok := m.dict.entityCodes[code.Etype]
+	if !ok || current.UserID == 0 || current.UserID != code.UserID {
+		return fmt.Errorf("no entity type: %v", code.Etype)
+	}
+	for _, f := range fields {
+		if f.ID != 0 {
+			if stored := m.dict.field(f.ID); stored == nil || stored.Etype != f.Etype {
+				return fmt.Errorf("saveFields: no field with id: %v", f.ID)
+			}
+		}
+	}
+
+	current.Name = code.Name
+	m.dict.entityCodes[code.Etype] = current
+
+	return m.dict.saveFields(fields)
+}
+
+// SetEntityTypeArchived перемещение пользовательского типа в архив или возврат из архива
+func (m *MemStorage) SetEntityTypeArchived(ctx context.Context, etype string, userID int32, archived bool) error {
+	m.dict.mu.Lock()
+	defer m.dict.mu.Unlock()
+
+	code, ok := m.dict.entityCodes[etype]
+	if !ok || code.UserID == 0 || code.UserID != userID {
+		return fmt.Errorf("no entity type: %v", etype)
+	}
+	code.Archived = archived
+	m.dict.entityCodes[etype] = code
+
+	return nil
+}
+
+// saveFields изменение описаний полей с ID и добавление полей без ID (блокировка уже захвачена)
+func (d *dictionary) saveFields(fields []field.EntityFields) error {
+	for _, f := range fields {
+		f.Options = append([]string(nil), f.Options...)
+		if f.ID != 0 {
+			stored := d.field(f.ID)
+			if stored == nil {
+				return fmt.Errorf("saveFields: no field with id: %v", f.ID)
+			}
+			*stored = f
+			continue
+		}
+		f.ID = d.fields[len(d.fields)-1].ID + 1
+		d.fields = append(d.fields, f)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+)
+
+// GetEntityFields получение набора полей сущности в порядке их следования в форме
+func (m *MemStorage) GetEntityFields(ctx context.Context, etype string) ([]field.EntityFields, error) {
+	m.dict.mu.RLock()
+	defer m.dict.mu.RUnlock()
+
+	var fields []field.EntityFields
+	for _, f := range m.dict.fields {
+		if f.Etype == etype {
+			fields = append(fields, f)
+		}
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Position < fields[j].Position
+	})
+
+	return fields, nil
+}
+
+// GetFieldByEtypeAndName получить код записи и тип по типу сущности и имени
+func (m *MemStorage) GetFieldByEtypeAndName(ctx context.Context, etype string, name string) (int32, string, error) {
+	m.dict.mu.RLock()
+	defer m.dict.mu.RUnlock()
+
+	for _, f := range m.dict.fields {
+		if f.Etype == etype && f.Name == name {
+			return f.ID, f.Ftype, nil
+		}
+	}
+
+	return 0, "", nil
+}
+
+// IsFieldType имеет ли поле с указанным идентификатором определенный тип?
+func (m *MemStorage) IsFieldType(ctx context.Context, id int32, ftype string) (bool, error) {
+	m.dict.mu.RLock()
+	defer m.dict.mu.RUnlock()
+
+	f := m.dict.field(id)
+
+	return f != nil && f.Ftype == ftype, nil
+}
+
+// field поиск описания поля по ID
+func (d *dictionary) field(id int32) *field.EntityFields {
+	for i := range d.fields {
+		if d.fields[i].ID == id {
+			return &d.fields[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
+)
+
+// GetFolders Получение всех папок пользователя
+func (m *MemStorage) GetFolders(ctx context.Context, userID int32) ([]folder.FolderModel, error) {
+	defer m.rlock()()
+
+	var list []folder.FolderModel
+	for id := int32(1); id <= m.data.lastFolderID; id++ {
+		row, ok := m.data.folders[id]
+		if !ok || row.userID != userID {
+			continue
+		}
+		list = append(list, folder.FolderModel{ID: row.id, UserID: row.userID, ParentID: row.parentID, Name: row.name})
+	}
+
+	return list, nil
+}
+
+// CreateFolder Создание папки
+func (m *MemStorage) CreateFolder(ctx context.Context, f folder.FolderModel) (int32, error) {
+	defer m.lock()()
+
+	d := m.data
+	if _, ok := d.users[int(f.UserID)]; !ok {
+		return 0, fmt.Errorf("no user with id: %v", f.UserID)
+	}
+	if f.ParentID != 0 && d.folders[f.ParentID] == nil {
+		return 0, fmt.Errorf("no folder with id: %v", f.ParentID)
+	}
+
+	d.lastFolderID++
+	d.folders[d.lastFolderID] = &folderRow{id: d.lastFolderID, userID: f.UserID, parentID: f.ParentID, name: f.Name}
+
+	return d.lastFolderID, nil
+}
+
+// UpdateFolder Изменение названия и родительской папки
+func (m *MemStorage) UpdateFolder(ctx context.Context, f folder.FolderModel) error {
+	defer m.lock()()
+
+	row, ok := m.data.folders[f.ID]
+	if !ok || row.userID != f.UserID {
+		return fmt.Errorf("no folder with id: %v", f.ID)
+	}
+	if f.ParentID != 0 && m.data.folders[f.ParentID] == nil {
+		return fmt.Errorf("no folder with id: %v", f.ParentID)
+	}
+
+	row.parentID = f.ParentID
+	row.name = f.Name
+
+	return nil
+}
+
+// DeleteFolder Удаление папки, вложенные папки и сущности (в том числе в корзине) переносятся в родительскую папку
+func (m *MemStorage) DeleteFolder(ctx context.Context, id int32, userID int32) error {
+	defer m.lock()()
+
+	d := m.data
+	row, ok := d.folders[id]
+	if !ok || row.userID != userID {
+		return fmt.Errorf("no folder with id: %v", id)
+	}
+
+	for _, child := range d.folders {
+		if child.parentID == id {
+			child.parentID = row.parentID
+		}
+	}
+	for _, ent := range d.entities {
+		if ent.folderID == id {
+			ent.folderID = row.parentID
+		}
+	}
+	delete(d.folders, id)
+
+	return nil
+}
+
+// checkFolder проверка принадлежности папки пользователю, 0 - вне папок (аналог внешнего ключа)
+func (d *memData) checkFolder(folderID int32, userID int32) error {
+	if folderID == 0 {
+		return nil
+	}
+	if row, ok := d.folders[folderID]; !ok || row.userID != userID {
+		return fmt.Errorf("no folder with id: %v", folderID)
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+// Package memory хранилище данных в оперативной памяти.
+// Семантика совпадает с хранилищем Postgresql, используется в тестах и в демонстрационном режиме сервера
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+)
+
+// userRow запись пользователя
+type userRow struct {
+	id        int
+	login     string
+	password  string
+	salt      string
+	createdAt time.Time
+}
+
+// entityRow запись сущности
+type entityRow struct {
+	id         int32
+	userID     int32
+	etype      string
+	createdAt  time.Time
+	updatedAt  time.Time
+	deletedAt  *time.Time // nil - сущность не в корзине
+	tokens     []string   // токены слепого индекса (срез только заменяется целиком, поэтому копия строки безопасна)
+	folderID   int32      // папка, 0 - вне папок
+	tags       []string   // теги (срез только заменяется целиком)
+	favorite   bool       // сущность в избранном
+	accessedAt time.Time  // время последнего получения, нулевое - не открывалась
+	revision   int64      // номер ревизии, увеличивается при каждом сохранении изменений
+}
+
+// folderRow запись папки
+type folderRow struct {
+	id       int32
+	userID   int32
+	parentID int32 // 0 - папка верхнего уровня
+	name     string
+}
+
+// dictionary справочники типов сущностей и описаний полей
+// Имеют отдельную блокировку, поэтому доступны и во время транзакции (например для FieldRepo доменного сервиса)
+type dictionary struct {
+	mu          sync.RWMutex
+	entityCodes map[string]entity_code.CodeModel
+	fields      []field.EntityFields
+}
+
+// memData набор таблиц хранилища
+type memData struct {
+	users       map[int]*userRow
+	entities    map[int32]*entityRow
+	properties  []entity.Property
+	metainfo    []entity.Metainfo
+	folders     map[int32]*folderRow
+	attachments map[int32]entity.AttachmentModel
+
+	lastUserID       int
+	lastEntityID     int32
+	lastPropertyID   int32
+	lastMetaID       int32
+	lastFolderID     int32
+	lastAttachmentID int32
+}
+
+// MemStorage работает с данными в оперативной памяти, безопасно для конкурентного использования
+type MemStorage struct {
+	mu   *sync.RWMutex
+	data *memData
+	dict *dictionary
+	inTx bool // хранилище привязано к единице работы (блокировка уже захвачена)
+}
+
+func NewMemoryStorage() (*MemStorage, error) {
+	ms := &MemStorage{
+		mu: &sync.RWMutex{},
+		data: &memData{
+			users:       make(map[int]*userRow),
+			entities:    make(map[int32]*entityRow),
+			folders:     make(map[int32]*folderRow),
+			attachments: make(map[int32]entity.AttachmentModel),
+		},
+		dict: seedDictionary(),
+	}
+
+	return ms, nil
+}
+
+// rlock захват блокировки на чтение, возвращает функцию освобождения
+func (m *MemStorage) rlock() func() {
+	if m.inTx {
+		return func() {}
+	}
+	m.mu.RLock()
+
+	return m.mu.RUnlock
+}
+
+// lock захват блокировки на запись, возвращает функцию освобождения
+func (m *MemStorage) lock() func() {
+	if m.inTx {
+		return func() {}
+	}
+	m.mu.Lock()
+
+	return m.mu.Unlock
+}
+
+// Transaction выполнение набора операций с хранилищем в одной транзакции (единица работы)
+// fn работает с копией данных, которая заменяет исходные только при успешном завершении.
+// На время транзакции хранилище блокируется
+func (m *MemStorage) Transaction(ctx context.Context, fn func(repo entity.EntityRepo) error) error {
+	if m.inTx {
+		return fn(m)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	txData := m.data.clone()
+	err := fn(&MemStorage{mu: m.mu, data: txData, dict: m.dict, inTx: true})
+	if err != nil {
+		return err
+	}
+
+	m.data = txData
+
+	return nil
+}
+
+// clone полная копия данных
+func (d *memData) clone() *memData {
+	c := &memData{
+		users:            make(map[int]*userRow, len(d.users)),
+		entities:         make(map[int32]*entityRow, len(d.entities)),
+		properties:       append([]entity.Property(nil), d.properties...),
+		metainfo:         append([]entity.Metainfo(nil), d.metainfo...),
+		lastUserID:       d.lastUserID,
+		lastEntityID:     d.lastEntityID,
+		lastPropertyID:   d.lastPropertyID,
+		lastMetaID:       d.lastMetaID,
+		folders:          make(map[int32]*folderRow, len(d.folders)),
+		lastFolderID:     d.lastFolderID,
+		attachments:      make(map[int32]entity.AttachmentModel, len(d.attachments)),
+		lastAttachmentID: d.lastAttachmentID,
+	}
+
+	for k, v := range d.users {
+		u := *v
+		c.users[k] = &u
+	}
+	for k, v := range d.entities {
+		e := *v
+		if v.deletedAt != nil {
+			t := *v.deletedAt
+			e.deletedAt = &t
+		}
+		c.entities[k] = &e
+	}
+	for k, v := range d.folders {
+		f := *v
+		c.folders[k] = &f
+	}
+	for k, v := range d.attachments {
+		c.attachments[k] = v
+	}
+
+	return c
+}
+
+// seedDictionary начальные данные справочников (аналог миграций 000007_fill_tables, 000013_add_otp_entity, 000014_add_sshkey_entity, 000019_add_custom_entity_types и 000020_add_field_types)
+func seedDictionary() *dictionary {
+	d := &dictionary{
+		entityCodes: make(map[string]entity_code.CodeModel),
+	}
+	for etype, name := range map[string]string{
+		"logopas": "Логин и пароль",
+		"card":    "Банковская карта",
+		"text":    "Текстовые данные",
+		"binary":  "Бинарные данные",
+		"otp":     "Одноразовые пароли (OTP)",
+		"sshkey":  "SSH ключи",
+	} {
+		d.entityCodes[etype] = entity_code.CodeModel{Etype: etype, Name: name}
+	}
+
+	fields := []field.EntityFields{
+		{Etype: "logopas", Name: "Логин", Ftype: "string", ValidateRules: "required", ValidateMessages: `{"required": "Логин не может быть пустым"}`},
+		{Etype: "logopas", Name: "Пароль", Ftype: "secret", ValidateRules: "required", ValidateMessages: `{"required": "Пароль не может быть пустым"}`},
+		{Etype: "card", Name: "Номер банковской карты", Ftype: "string", ValidateRules: "credit_card", ValidateMessages: `{"credit_card": "Неправильный формат номера карты"}`},
+		{Etype: "card", Name: "Месяц/Год (mm/yy) до которого действует карта", Ftype: "monthyear", ValidateRules: "", ValidateMessages: `{}`},
+		{Etype: "card", Name: "Код проверки подлинности", Ftype: "secret", ValidateRules: "len=3,number", ValidateMessages: `{"len": "Код должен состоять из трех цифр", "number": "Только число"}`},
+		{Etype: "text", Name: "Произвольные текстовые данные (путь к файлу)", Ftype: "path", ValidateRules: "required,file", ValidateMessages: `{"requred": "Путь к файлу не может быть пустым", "file": "Файла не существует"}`},
+		{Etype: "binary", Name: "Произвольные бинарные данные (путь к файлу)", Ftype: "path", ValidateRules: "required,file", ValidateMessages: `{"required": "Путь к файлу не может быть пустым", "file": "Файла не существует"}`},
+		{Etype: "otp", Name: "Секрет (base32 или otpauth:// URI)", Ftype: "otp", ValidateRules: "required,otpauth", ValidateMessages: `{"required": "Секрет не может быть пустым", "otpauth": "Неверный формат секрета или otpauth:// URI"}`},
+		{Etype: "sshkey", Name: "Приватный ключ (путь к файлу)", Ftype: "sshkey", ValidateRules: "required,file,sshkey", ValidateMessages: `{"required": "Путь к файлу не может быть пустым", "file": "Файла не существует", "sshkey": "Файл не содержит приватный SSH ключ"}`},
+		{Etype: "sshkey", Name: "Пароль ключа (пусто - ключ не зашифрован)", Ftype: "sshpassphrase", ValidateRules: "", ValidateMessages: `{}`},
+		{Etype: "sshkey", Name: "Публичный ключ (пусто - вычислить из приватного)", Ftype: "sshpub", ValidateRules: "omitempty,sshpub", ValidateMessages: `{"sshpub": "Неверный формат публичного ключа"}`},
+		{Etype: "sshkey", Name: "Комментарий", Ftype: "sshcomment", ValidateRules: "", ValidateMessages: `{}`},
+	}
+	for i := range fields {
+		fields[i].ID = int32(i + 1)
+		// секретны пароль, код проверки карты, секрет OTP, приватный SSH ключ и его пароль (миграция 000019_add_custom_entity_types)
+		switch fields[i].Ftype {
+		case constants.FieldTypeSecret, constants.FieldTypeOTP, constants.FieldTypeSSHKey, constants.FieldTypeSSHPassphrase:
+			fields[i].Secret = true
+		}
+	}
+	d.fields = fields
+
+	return d
+}
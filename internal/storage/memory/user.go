@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/utils"
+)
+
+// GetUser получение данных пользователя (возвращает ID и дату добавления, если ID = 0 - такого пользователя нет)
+func (m *MemStorage) GetUser(ctx context.Context, login string) (int, time.Time, error) {
+	defer m.rlock()()
+
+	u := m.data.userByLogin(login)
+	if u == nil {
+		return 0, time.Time{}, nil
+	}
+
+	return u.id, u.createdAt, nil
+}
+
+// UserCreate регистрация нового пользователя.
+func (m *MemStorage) UserCreate(ctx context.Context, login string, password string, salt string) (int, error) {
+	defer m.lock()()
+
+	if m.data.userByLogin(login) != nil {
+		return 0, fmt.Errorf("duplicate login: %v", login)
+	}
+
+	m.data.lastUserID++
+	u := &userRow{
+		id:        m.data.lastUserID,
+		login:     login,
+		password:  password,
+		salt:      salt,
+		createdAt: time.Now(),
+	}
+	m.data.users[u.id] = u
+
+	return u.id, nil
+}
+
+// LoginUser проверка наличия пары логин-пароль, пароль подается в исходном виде
+// возвращает ID пользователя и пустую строку в случае успеха или 0 с текстом описания, если пользователя нет в базе
+func (m *MemStorage) LoginUser(ctx context.Context, login string, password string) (int, string) {
+	defer m.rlock()()
+
+	u := m.data.userByLogin(login)
+	if u == nil {
+		return 0, constants.ErrNoSuchUser
+	}
+
+	if utils.PassGenerate(password, u.salt) != u.password {
+		return 0, constants.ErrBadPassword
+	}
+
+	return u.id, ""
+}
+
+// userByLogin поиск пользователя по логину
+func (d *memData) userByLogin(login string) *userRow {
+	for _, u := range d.users {
+		if u.login == login {
+			return u
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+)
+
+func TestTransaction(t *testing.T) {
+	db, err := setupDatabase()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	userID, err := db.UserCreate(ctx, "uow", "password", "salt")
+	require.NoError(t, err)
+
+	ent := entity.EntityModel{
+		UserID:   int32(userID),
+		Etype:    "card",
+		Metainfo: []entity.Metainfo{{Title: "Банк", Value: "Тест"}},
+	}
+
+	// откат: сущность, созданная внутри единицы работы, не сохраняется
+	var id int32
+	err = db.Transaction(ctx, func(repo entity.EntityRepo) error {
+		id, err = repo.CreateEntity(ctx, ent)
+		require.NoError(t, err)
+		return errors.New("testerr")
+	})
+	require.Error(t, err)
+	_, err = db.GetEntity(ctx, id)
+	require.Error(t, err)
+
+	// фиксация
+	err = db.Transaction(ctx, func(repo entity.EntityRepo) error {
+		id, err = repo.CreateEntity(ctx, ent)
+		return err
+	})
+	require.NoError(t, err)
+	saved, err := db.GetEntity(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, ent.Metainfo[0].Value, saved.Metainfo[0].Value)
+
+	// изменение чужой сущности
+	foreign := saved
+	foreign.UserID = saved.UserID + 1
+	err = db.UpdateEntity(ctx, foreign)
+	require.Error(t, err)
+}
+
+func TestSchemaConstraints(t *testing.T) {
+	db, err := setupDatabase()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	userID, err := db.UserCreate(ctx, "constraints", "password", "salt")
+	require.NoError(t, err)
+	fieldID, _, err := db.GetFieldByEtypeAndName(ctx, "card", "Номер банковской карты")
+	require.NoError(t, err)
+
+	ent := entity.EntityModel{
+		UserID:   int32(userID),
+		Etype:    "card",
+		Props:    []entity.Property{{FieldID: fieldID, Value: strings.Repeat("a", 4096)}},
+		Metainfo: []entity.Metainfo{{Title: "Банк", Value: "Тест"}},
+	}
+	id, err := db.CreateEntity(ctx, ent)
+	require.NoError(t, err)
+
+	// повторное свойство для той же пары сущность/поле
+	_, err = db.db.ExecContext(ctx, "INSERT INTO properties (entity_id, field_id, value) VALUES ($1, $2, $3)", id, fieldID, "dup")
+	require.Error(t, err)
+
+	// свойство несуществующей сущности
+	_, err = db.db.ExecContext(ctx, "INSERT INTO properties (entity_id, field_id, value) VALUES ($1, $2, $3)", id+100, fieldID, "orphan")
+	require.Error(t, err)
+
+	// каскадное удаление свойств и метаинформации
+	require.NoError(t, db.DeleteEntity(ctx, id, int32(userID)))
+	require.NoError(t, db.PurgeEntity(ctx, id, int32(userID)))
+
+	var count int
+	err = db.db.QueryRowContext(ctx, "SELECT (SELECT count(*) FROM properties WHERE entity_id = $1) + (SELECT count(*) FROM metainfo WHERE entity_id = $1)", id).Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
@@ -3,17 +3,19 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
 )
 
-// GetEntityFields получение набора полей сущности
+// GetEntityFields получение набора полей сущности в порядке их следования в форме
 func (p *PgStorage) GetEntityFields(ctx context.Context, etype string) ([]field.EntityFields, error) {
 
-	query := `SELECT id, name, ftype, validate_rules, validate_messages FROM fields WHERE etype = $1`
-	rows, err := p.db.QueryContext(ctx, query, etype)
+	query := `SELECT id, name, ftype, validate_rules, validate_messages, secret, position, options FROM fields
+			  WHERE etype = $1 ORDER BY position, id`
+	rows, err := p.conn().QueryContext(ctx, query, etype)
 	if err != nil {
 		return nil, fmt.Errorf("GetEntityCodes error: %w", err)
 	}
@@ -21,8 +23,13 @@ func (p *PgStorage) GetEntityFields(ctx context.Context, etype string) ([]field.
 
 	var ef field.EntityFields
 	var fields []field.EntityFields
+	var options string
 	for rows.Next() {
-		err := rows.Scan(&ef.ID, &ef.Name, &ef.Ftype, &ef.ValidateRules, &ef.ValidateMessages)
+		err := rows.Scan(&ef.ID, &ef.Name, &ef.Ftype, &ef.ValidateRules, &ef.ValidateMessages, &ef.Secret, &ef.Position, &options)
+		if err != nil {
+			return nil, fmt.Errorf("GetEntityFields: %w", err)
+		}
+		ef.Options, err = unmarshalOptions(options)
 		if err != nil {
 			return nil, fmt.Errorf("GetEntityFields: %w", err)
 		}
@@ -40,7 +47,7 @@ func (p *PgStorage) GetFieldByEtypeAndName(ctx context.Context, etype string, na
 
 	var id int32
 	var ftype string
-	row := p.db.QueryRowContext(ctx, query, etype, name)
+	row := p.conn().QueryRowContext(ctx, query, etype, name)
 	err := row.Scan(&id, &ftype)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -57,7 +64,7 @@ func (p *PgStorage) IsFieldType(ctx context.Context, id int32, ftype string) (bo
 	query := `SELECT name FROM fields WHERE id = $1 AND ftype = $2`
 
 	var name string
-	row := p.db.QueryRowContext(ctx, query, id, ftype)
+	row := p.conn().QueryRowContext(ctx, query, id, ftype)
 	err := row.Scan(&name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -68,3 +75,24 @@ func (p *PgStorage) IsFieldType(ctx context.Context, id int32, ftype string) (bo
 
 	return true, nil
 }
+
+// marshalOptions варианты значения поля select в виде JSON массива для колонки options
+func marshalOptions(options []string) (string, error) {
+	if len(options) == 0 {
+		return "[]", nil
+	}
+	data, err := json.Marshal(options)
+
+	return string(data), err
+}
+
+// unmarshalOptions варианты значения поля select из колонки options
+func unmarshalOptions(data string) ([]string, error) {
+	var options []string
+	if data == "" || data == "[]" {
+		return nil, nil
+	}
+	err := json.Unmarshal([]byte(data), &options)
+
+	return options, err
+}
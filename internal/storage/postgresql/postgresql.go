@@ -1,16 +1,26 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
 	"github.com/dnsoftware/gophkeeper/logger"
 )
 
+// dbExecutor общий набор методов *sql.DB и *sql.Tx, через который выполняются запросы
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 // PgStorage работает с Postgresql базой данных.
 type PgStorage struct {
 	db *sql.DB
+	tx *sql.Tx // транзакция единицы работы (nil - хранилище работает вне транзакции)
 }
 
 func NewPostgresqlStorage(dsn string) (*PgStorage, error) {
@@ -27,3 +37,54 @@ func NewPostgresqlStorage(dsn string) (*PgStorage, error) {
 
 	return ps, nil
 }
+
+// conn текущее соединение: транзакция единицы работы, если она открыта, иначе пул соединений
+func (p *PgStorage) conn() dbExecutor {
+	if p.tx != nil {
+		return p.tx
+	}
+
+	return p.db
+}
+
+// withTx выполнение fn в транзакции
+// Внутри единицы работы используется ее транзакция, фиксацией которой управляет Transaction
+func (p *PgStorage) withTx(ctx context.Context, fn func(q dbExecutor) error) error {
+	if p.tx != nil {
+		return fn(p.tx)
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Transaction выполнение набора операций с хранилищем в одной транзакции (единица работы)
+// fn получает хранилище, привязанное к транзакции. Если fn вернула ошибку - транзакция откатывается
+func (p *PgStorage) Transaction(ctx context.Context, fn func(repo entity.EntityRepo) error) error {
+	if p.tx != nil {
+		return fn(p)
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(&PgStorage{db: p.db, tx: tx})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -16,7 +16,7 @@ import (
 func (p *PgStorage) GetUser(ctx context.Context, login string) (int, time.Time, error) {
 
 	query := `SELECT id, created_at FROM users WHERE login = $1`
-	row := p.db.QueryRowContext(ctx, query, login)
+	row := p.conn().QueryRowContext(ctx, query, login)
 
 	var (
 		id        int
@@ -38,14 +38,8 @@ func (p *PgStorage) GetUser(ctx context.Context, login string) (int, time.Time,
 func (p *PgStorage) UserCreate(ctx context.Context, login string, password string, salt string) (int, error) {
 
 	query := "INSERT INTO users (login, password, salt, created_at) VALUES ($1, $2, $3, $4) RETURNING id"
-	_, err := p.db.ExecContext(ctx, query, login, password, salt, time.Now())
-	if err != nil {
-		return 0, err
-	}
-	q := "SELECT LASTVAL() id"
-	r := p.db.QueryRowContext(ctx, q)
 	var idNew int
-	err = r.Scan(&idNew)
+	err := p.conn().QueryRowContext(ctx, query, login, password, salt, time.Now()).Scan(&idNew)
 	if err != nil {
 		return 0, err
 	}
@@ -63,7 +57,7 @@ func (p *PgStorage) LoginUser(ctx context.Context, login string, password string
 	)
 
 	query := `SELECT salt FROM users WHERE login = $1`
-	row := p.db.QueryRowContext(ctx, query, login)
+	row := p.conn().QueryRowContext(ctx, query, login)
 	err := row.Scan(&salt)
 	if err != nil {
 		logger.Log().Error("LoginCheckUser, get salt error: " + err.Error())
@@ -73,7 +67,7 @@ func (p *PgStorage) LoginUser(ctx context.Context, login string, password string
 	passHash := utils.PassGenerate(password, salt)
 
 	query = `SELECT id FROM users WHERE login = $1 AND password = $2`
-	row = p.db.QueryRowContext(ctx, query, login, passHash)
+	row = p.conn().QueryRowContext(ctx, query, login, passHash)
 
 	err = row.Scan(&id)
 	if err != nil {
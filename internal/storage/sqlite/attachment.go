@@ -0,0 +1,99 @@
+// Вложения сущностей
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+)
+
+// attachmentColumns поля вложения в порядке сканирования scanAttachment
+const attachmentColumns = "id, entity_id, name, size, digest, servername, chunk_count, created_at"
+
+// LockEntity Проверка наличия сущности
+// Транзакции SQLite открываются в режиме immediate и уже выполняются по очереди
+func (s *SqliteStorage) LockEntity(ctx context.Context, id int32) error {
+	query := "SELECT id FROM entities WHERE id = ?"
+	err := s.conn().QueryRowContext(ctx, query, id).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("no entity with id: %v", id)
+	}
+
+	return err
+}
+
+// CreateAttachment Сохранение описания вложения сущности
+func (s *SqliteStorage) CreateAttachment(ctx context.Context, att entity.AttachmentModel) (int32, error) {
+	var id int32
+	query := `INSERT INTO attachments (entity_id, name, size, digest, servername, chunk_count, created_at)
+                        VALUES (?, ?, ?, ?, ?, ?, ?) RETURNING id`
+	err := s.conn().QueryRowContext(ctx, query, att.EntityID, att.Name, att.Size, att.Digest, att.Servername, att.ChunkCount, now()).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetAttachments Получение вложений сущности в порядке добавления
+func (s *SqliteStorage) GetAttachments(ctx context.Context, entityID int32) ([]entity.AttachmentModel, error) {
+	query := "SELECT " + attachmentColumns + " FROM attachments WHERE entity_id = ? ORDER BY id"
+	rows, err := s.conn().QueryContext(ctx, query, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var list []entity.AttachmentModel
+	for rows.Next() {
+		att, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		list = append(list, att)
+	}
+
+	return list, rows.Err()
+}
+
+// GetAttachment Получение вложения по ID
+func (s *SqliteStorage) GetAttachment(ctx context.Context, id int32) (entity.AttachmentModel, error) {
+	query := "SELECT " + attachmentColumns + " FROM attachments WHERE id = ?"
+	att, err := scanAttachment(s.conn().QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return entity.AttachmentModel{}, fmt.Errorf("no attachment with id: %v", id)
+	}
+
+	return att, err
+}
+
+// DeleteAttachment Удаление описания вложения
+func (s *SqliteStorage) DeleteAttachment(ctx context.Context, id int32) error {
+	query := "DELETE FROM attachments WHERE id = ?"
+	res, err := s.conn().ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no attachment with id: %v", id)
+	}
+
+	return nil
+}
+
+// scanAttachment чтение вложения из строки результата запроса
+func scanAttachment(row interface{ Scan(dest ...any) error }) (entity.AttachmentModel, error) {
+	var att entity.AttachmentModel
+	err := row.Scan(&att.ID, &att.EntityID, &att.Name, &att.Size, &att.Digest, &att.Servername, &att.ChunkCount, &att.CreatedAt)
+
+	return att, err
+}
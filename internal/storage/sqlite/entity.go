@@ -0,0 +1,698 @@
+// Основная работа с сущностями (CRUD)
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+)
+
+// CreateEntity создание сущности
+func (s *SqliteStorage) CreateEntity(ctx context.Context, entity entity.EntityModel) (int32, error) {
+
+	var idEntity int32
+	err := s.withTx(ctx, func(q dbExecutor) error {
+		err := checkFolder(ctx, q, entity.FolderID, entity.UserID)
+		if err != nil {
+			return err
+		}
+
+		query := "INSERT INTO entities (user_id, etype, created_at, folder_id) VALUES (?, ?, ?, ?) RETURNING id"
+		err = q.QueryRowContext(ctx, query, entity.UserID, entity.Etype, now(), nullID(entity.FolderID)).Scan(&idEntity)
+		if err != nil {
+			return err
+		}
+
+		// заносим свойства
+		for _, prop := range entity.Props {
+			query := "INSERT INTO properties (entity_id, field_id, value) VALUES (?, ?, ?)"
+			_, err = q.ExecContext(ctx, query, idEntity, prop.FieldID, prop.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		// заносим метаинформацию
+		for _, meta := range entity.Metainfo {
+			query := "INSERT INTO metainfo (entity_id, title, value) VALUES (?, ?, ?)"
+			_, err = q.ExecContext(ctx, query, idEntity, meta.Title, meta.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = insertTags(ctx, q, idEntity, entity.Tags)
+		if err != nil {
+			return err
+		}
+
+		// заносим токены слепого индекса
+		return insertTokens(ctx, q, idEntity, entity.Tokens)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return idEntity, nil
+
+}
+
+// UpdateEntity Сохранение отредактированной сущности
+func (s *SqliteStorage) UpdateEntity(ctx context.Context, entity entity.EntityModel) error {
+
+	return s.withTx(ctx, func(q dbExecutor) error {
+		err := checkFolder(ctx, q, entity.FolderID, entity.UserID)
+		if err != nil {
+			return err
+		}
+
+		query := "UPDATE entities SET updated_at = ?, revision = revision + 1, folder_id = ? WHERE id = ? AND user_id = ? AND deleted_at IS NULL"
+		res, err := q.ExecContext(ctx, query, now(), nullID(entity.FolderID), entity.ID, entity.UserID)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("no entity with id: %v", entity.ID)
+		}
+
+		// заносим свойства (уникальность пары entity_id, field_id гарантирует база)
+		for _, prop := range entity.Props {
+			query := `INSERT INTO properties (entity_id, field_id, value) VALUES (?, ?, ?)
+				ON CONFLICT (entity_id, field_id) DO UPDATE SET value = EXCLUDED.value`
+			_, err = q.ExecContext(ctx, query, entity.ID, prop.FieldID, prop.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		// заносим метаинформацию
+		// Удаляем старую метаинформацию
+		queryDel := "DELETE FROM metainfo WHERE entity_id = ?"
+		_, err = q.ExecContext(ctx, queryDel, entity.ID)
+		if err != nil {
+			return err
+		}
+
+		// Добавляем новые
+		for _, meta := range entity.Metainfo {
+			query := "INSERT INTO metainfo (entity_id, title, value) VALUES (?, ?, ?)"
+			_, err = q.ExecContext(ctx, query, entity.ID, meta.Title, meta.Value)
+			if err != nil {
+				return err
+			}
+		}
+
+		// теги заменяются целиком
+		_, err = q.ExecContext(ctx, "DELETE FROM entity_tags WHERE entity_id = ?", entity.ID)
+		if err != nil {
+			return err
+		}
+		err = insertTags(ctx, q, entity.ID, entity.Tags)
+		if err != nil {
+			return err
+		}
+
+		// токены слепого индекса вычислены от новой метаинформации, старые удаляем
+		_, err = q.ExecContext(ctx, "DELETE FROM blind_index WHERE entity_id = ?", entity.ID)
+		if err != nil {
+			return err
+		}
+
+		return insertTokens(ctx, q, entity.ID, entity.Tokens)
+	})
+
+}
+
+// GetEntity получить сущность
+func (s *SqliteStorage) GetEntity(ctx context.Context, id int32) (entity.EntityModel, error) {
+
+	empty := entity.EntityModel{}
+
+	query := "SELECT user_id, etype, folder_id, favorite, accessed_at FROM entities WHERE id = ? AND deleted_at IS NULL"
+	var userID int32
+	var etype string
+	var folderID sql.NullInt32
+	var favorite bool
+	var accessedAt sql.NullTime
+	row := s.conn().QueryRowContext(ctx, query, id)
+	err := row.Scan(&userID, &etype, &folderID, &favorite, &accessedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return empty, fmt.Errorf("no entity with id: %v", id)
+		}
+		return empty, err
+	}
+
+	ent := entity.EntityModel{
+		ID:         id,
+		UserID:     userID,
+		Etype:      etype,
+		FolderID:   folderID.Int32,
+		Favorite:   favorite,
+		AccessedAt: accessedAt.Time,
+	}
+
+	err = s.fillEntity(ctx, &ent)
+	if err != nil {
+		return empty, err
+	}
+
+	return ent, nil
+}
+
+// fillEntity получение свойств и метаинформации сущности
+func (s *SqliteStorage) fillEntity(ctx context.Context, ent *entity.EntityModel) error {
+	id := ent.ID
+
+	// получаем свойства
+	var props []entity.Property
+	var prop entity.Property
+
+	query := `SELECT id, field_id, value FROM properties WHERE entity_id = ? ORDER BY id`
+	rows, err := s.conn().QueryContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("GetEntity error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(&prop.ID, &prop.FieldID, &prop.Value)
+		if err != nil {
+			return fmt.Errorf("scan Property error: %w", err)
+		}
+		prop.EntityID = id
+		props = append(props, prop)
+	}
+
+	// получаем метаинформацию
+	var metainfo []entity.Metainfo
+	var meta entity.Metainfo
+
+	query = `SELECT id, title, value FROM metainfo WHERE entity_id = ? ORDER BY id`
+	rows, err = s.conn().QueryContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("select metainfo error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(&meta.ID, &meta.Title, &meta.Value)
+		if err != nil {
+			return fmt.Errorf("scan Property error: %w", err)
+		}
+		meta.EntityID = id
+		metainfo = append(metainfo, meta)
+	}
+
+	// получаем теги
+	var tags []string
+	var tag string
+
+	query = `SELECT tag FROM entity_tags WHERE entity_id = ? ORDER BY id`
+	rows, err = s.conn().QueryContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("select tags error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		err := rows.Scan(&tag)
+		if err != nil {
+			return fmt.Errorf("scan tag error: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+
+	ent.Props = props
+	ent.Metainfo = metainfo
+	ent.Tags = tags
+
+	return nil
+}
+
+// GetBinaryFilenameByEntityID Получение данных по именам файлов хранения бинарных данных
+func (s *SqliteStorage) GetBinaryFilenameByEntityID(ctx context.Context, entityID int32) (string, error) {
+	query := "SELECT p.value FROM entities e, properties p WHERE e.id = ? AND e.id = p.entity_id AND e.deleted_at IS NULL ORDER BY p.id LIMIT 1"
+	var filename string
+	row := s.conn().QueryRowContext(ctx, query, entityID)
+	err := row.Scan(&filename)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", fmt.Errorf("no property with entityID: %v", entityID)
+		}
+		return "", err
+	}
+
+	return filename, nil
+}
+
+// BinaryFileDataProperty Для десериализации данных по загруженным файлам
+type BinaryFileDataProperty struct {
+	Servername string `json:"servername"`
+	Clientname string `json:"clientname"`
+	Chunkcount int32  `json:"chunkcount"`
+}
+
+// SetChunkCountForCryptoBinary Сохранение кол-ва фрагментов, на которые разбит бинарный файл
+func (s *SqliteStorage) SetChunkCountForCryptoBinary(ctx context.Context, entityID int32, chunkCount int32) error {
+	query := "SELECT p.id property_id, p.value FROM entities e, properties p WHERE e.id = ? AND e.id = p.entity_id ORDER BY p.id LIMIT 1"
+	var filedata string
+	var propertyID int32
+	row := s.conn().QueryRowContext(ctx, query, entityID)
+	err := row.Scan(&propertyID, &filedata)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("no property with entityID: %v", entityID)
+		}
+		return err
+	}
+
+	fd := &BinaryFileDataProperty{}
+	err = json.Unmarshal([]byte(filedata), fd)
+	if err != nil {
+		return err
+	}
+
+	fd.Chunkcount = chunkCount
+	filedataStr, err := json.Marshal(fd)
+	if err != nil {
+		return err
+	}
+
+	query = "UPDATE properties SET value = ? WHERE id = ?"
+	_, err = s.conn().ExecContext(ctx, query, string(filedataStr), propertyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetEntityListByType Получение списка сущностей указанного типа для конкретного пользователя
+// Сущности с метаинформацией и отметками в порядке возрастания ID
+func (s *SqliteStorage) GetEntityListByType(ctx context.Context, etype string, userID int32, filter entity.ListFilter) ([]entity.EntitySummary, error) {
+
+	query := `SELECT e.id, e.etype, e.folder_id, e.favorite, e.created_at, e.updated_at, e.accessed_at, e.revision, m.title, m.value FROM entities e LEFT JOIN metainfo m 
+                        ON e.id = m.entity_id
+                        WHERE (?1 = '' OR e.etype = ?1) AND e.user_id = ?2 AND e.deleted_at IS NULL
+                        AND (?3 = 0 OR ?3 = -1 AND e.folder_id IS NULL OR e.folder_id = ?3)
+                        AND (?4 = '' OR EXISTS (SELECT 1 FROM blind_index b WHERE b.entity_id = e.id AND b.token = ?4))
+                        AND (?5 = 0 OR e.favorite)
+                        ORDER BY e.id, m.id`
+	rows, err := s.conn().QueryContext(ctx, query, etype, userID, filter.FolderID, filter.TagToken, filter.Favorite)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	return scanEntitySummaries(rows)
+}
+
+// SetFavorite Добавление сущности пользователя в избранное или удаление из него
+func (s *SqliteStorage) SetFavorite(ctx context.Context, id int32, userID int32, favorite bool) error {
+	query := "UPDATE entities SET favorite = ? WHERE id = ? AND user_id = ? AND deleted_at IS NULL"
+	res, err := s.conn().ExecContext(ctx, query, favorite, id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no entity with id: %v", id)
+	}
+
+	return nil
+}
+
+// ListEntities Страница списка сущностей пользователя в порядке сортировки запроса
+// Страница отбирается подзапросом, чтобы строки метаданных не влияли на ограничение количества
+func (s *SqliteStorage) ListEntities(ctx context.Context, userID int32, page entity.ListPage) ([]entity.EntitySummary, error) {
+	where, order, args := listPageQuery(userID, page)
+	query := `SELECT e.id, e.etype, e.folder_id, e.favorite, e.created_at, e.updated_at, e.accessed_at, e.revision, m.title, m.value
+                        FROM (SELECT * FROM entities e WHERE ` + where + ` ORDER BY ` + order + ` LIMIT ` + fmt.Sprint(page.Limit) + `) e
+                        LEFT JOIN metainfo m ON e.id = m.entity_id
+                        ORDER BY ` + order + `, m.id`
+	rows, err := s.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	return scanEntitySummaries(rows)
+}
+
+// listSortColumns столбцы полей сортировки по времени
+var listSortColumns = map[entity.ListSort]string{
+	entity.SortCreated:  "e.created_at",
+	entity.SortUpdated:  "e.updated_at",
+	entity.SortAccessed: "e.accessed_at",
+}
+
+// listPageQuery условие отбора, порядок сортировки и параметры запроса страницы списка сущностей
+// Незаданное время сортировки заменяется нулевым, чтобы такие сущности шли раньше остальных
+func listPageQuery(userID int32, page entity.ListPage) (string, string, []any) {
+	var args []any
+	param := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("?%d", len(args))
+	}
+
+	where := "e.user_id = " + param(userID) + " AND e.deleted_at IS NULL"
+	if len(page.Etypes) > 0 {
+		etypes := make([]string, len(page.Etypes))
+		for i, etype := range page.Etypes {
+			etypes[i] = param(etype)
+		}
+		where += " AND e.etype IN (" + strings.Join(etypes, ", ") + ")"
+	}
+	switch {
+	case page.Filter.FolderID == constants.RootFolder:
+		where += " AND e.folder_id IS NULL"
+	case page.Filter.FolderID > 0:
+		where += " AND e.folder_id = " + param(page.Filter.FolderID)
+	}
+	if page.Filter.TagToken != "" {
+		where += " AND EXISTS (SELECT 1 FROM blind_index b WHERE b.entity_id = e.id AND b.token = " + param(page.Filter.TagToken) + ")"
+	}
+	if page.Filter.Favorite {
+		where += " AND e.favorite"
+	}
+
+	dir, cmp := "ASC", ">"
+	if page.Desc {
+		dir, cmp = "DESC", "<"
+	}
+	order := "e.id " + dir
+	column, byTime := listSortColumns[page.Sort]
+	if byTime {
+		key := "COALESCE(" + column + ", " + param(time.Time{}) + ")"
+		order = key + " " + dir + ", " + order
+		if page.After != nil {
+			after := param(page.After.Time.UTC())
+			where += " AND (" + key + " " + cmp + " " + after + " OR " + key + " = " + after + " AND e.id " + cmp + " " + param(page.After.ID) + ")"
+		}
+	} else if page.After != nil {
+		where += " AND e.id " + cmp + " " + param(page.After.ID)
+	}
+
+	return where, order, args
+}
+
+// TouchEntity Запись времени получения сущности пользователя
+func (s *SqliteStorage) TouchEntity(ctx context.Context, id int32, userID int32, accessedAt time.Time) error {
+	query := "UPDATE entities SET accessed_at = ? WHERE id = ? AND user_id = ?"
+	_, err := s.conn().ExecContext(ctx, query, accessedAt.UTC(), id, userID)
+
+	return err
+}
+
+// insertTokens Запись токенов слепого индекса сущности
+func insertTokens(ctx context.Context, q dbExecutor, entityID int32, tokens []string) error {
+	for _, token := range tokens {
+		_, err := q.ExecContext(ctx, "INSERT INTO blind_index (entity_id, token) VALUES (?, ?) ON CONFLICT DO NOTHING", entityID, token)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertTags Запись тегов сущности
+func insertTags(ctx context.Context, q dbExecutor, entityID int32, tags []string) error {
+	for _, tag := range tags {
+		_, err := q.ExecContext(ctx, "INSERT INTO entity_tags (entity_id, tag) VALUES (?, ?)", entityID, tag)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SearchEntities Поиск сущностей пользователя, у которых есть все указанные токены слепого индекса
+// Токены должны быть уникальными, пустой etype - сущности всех типов
+func (s *SqliteStorage) SearchEntities(ctx context.Context, userID int32, etype string, tokens []string) ([]entity.FoundEntity, error) {
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	args := []any{userID, etype, etype}
+	placeholders := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		args = append(args, token)
+		placeholders = append(placeholders, "?")
+	}
+	args = append(args, len(tokens))
+
+	query := `SELECT e.id, e.etype, m.title, m.value FROM entities e LEFT JOIN metainfo m
+                        ON e.id = m.entity_id
+                        WHERE e.user_id = ? AND (? = '' OR e.etype = ?) AND e.deleted_at IS NULL
+                        AND e.id IN (SELECT entity_id FROM blind_index WHERE token IN (` + strings.Join(placeholders, ", ") + `)
+                                     GROUP BY entity_id HAVING COUNT(*) = ?)
+                        ORDER BY e.id, m.id`
+	rows, err := s.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		id     int32
+		etypeE string
+		title  sql.NullString
+		value  sql.NullString
+	)
+
+	var list []entity.FoundEntity
+	for rows.Next() {
+		err := rows.Scan(&id, &etypeE, &title, &value)
+		if err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+
+		if len(list) == 0 || list[len(list)-1].ID != id {
+			list = append(list, entity.FoundEntity{ID: id, Etype: etypeE})
+		}
+
+		if title.Valid {
+			last := &list[len(list)-1]
+			last.Metainfo = append(last.Metainfo, entity.Metainfo{
+				EntityID: id,
+				Title:    title.String,
+				Value:    value.String,
+			})
+		}
+	}
+
+	return list, rows.Err()
+}
+
+// DeleteEntity Перемещение сущности в корзину (мягкое удаление)
+func (s *SqliteStorage) DeleteEntity(ctx context.Context, id int32, userID int32) error {
+	query := "UPDATE entities SET deleted_at = ? WHERE id = ? AND user_id = ? AND deleted_at IS NULL"
+	res, err := s.conn().ExecContext(ctx, query, now(), id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no entity with id: %v", id)
+	}
+
+	return nil
+}
+
+// GetTrashList Получение списка сущностей пользователя, находящихся в корзине (новые удаленные - первыми)
+func (s *SqliteStorage) GetTrashList(ctx context.Context, userID int32) ([]entity.TrashItem, error) {
+	query := `SELECT e.id, e.etype, e.deleted_at, m.title, m.value FROM entities e LEFT JOIN metainfo m
+                        ON e.id = m.entity_id
+                        WHERE e.user_id = ? AND e.deleted_at IS NOT NULL
+                        ORDER BY e.deleted_at DESC, e.id, m.id`
+	rows, err := s.conn().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		id        int32
+		etype     string
+		deletedAt time.Time
+		title     sql.NullString
+		value     sql.NullString
+	)
+
+	var list []entity.TrashItem
+	for rows.Next() {
+		err := rows.Scan(&id, &etype, &deletedAt, &title, &value)
+		if err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+
+		if len(list) == 0 || list[len(list)-1].ID != id {
+			list = append(list, entity.TrashItem{
+				ID:        id,
+				UserID:    userID,
+				Etype:     etype,
+				DeletedAt: deletedAt,
+			})
+		}
+
+		if title.Valid {
+			last := &list[len(list)-1]
+			last.Metainfo = append(last.Metainfo, entity.Metainfo{
+				EntityID: id,
+				Title:    title.String,
+				Value:    value.String,
+			})
+		}
+	}
+
+	return list, rows.Err()
+}
+
+// GetDeletedEntity Получение сущности пользователя, находящейся в корзине
+func (s *SqliteStorage) GetDeletedEntity(ctx context.Context, id int32, userID int32) (entity.EntityModel, error) {
+	empty := entity.EntityModel{}
+
+	query := "SELECT etype, folder_id FROM entities WHERE id = ? AND user_id = ? AND deleted_at IS NOT NULL"
+	var etype string
+	var folderID sql.NullInt32
+	row := s.conn().QueryRowContext(ctx, query, id, userID)
+	err := row.Scan(&etype, &folderID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return empty, fmt.Errorf("no entity in trash with id: %v", id)
+		}
+		return empty, err
+	}
+
+	ent := entity.EntityModel{
+		ID:       id,
+		UserID:   userID,
+		Etype:    etype,
+		FolderID: folderID.Int32,
+	}
+
+	err = s.fillEntity(ctx, &ent)
+	if err != nil {
+		return empty, err
+	}
+
+	return ent, nil
+}
+
+// RestoreEntity Восстановление сущности из корзины
+func (s *SqliteStorage) RestoreEntity(ctx context.Context, id int32, userID int32) error {
+	query := "UPDATE entities SET deleted_at = NULL, updated_at = ? WHERE id = ? AND user_id = ? AND deleted_at IS NOT NULL"
+	res, err := s.conn().ExecContext(ctx, query, now(), id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no entity in trash with id: %v", id)
+	}
+
+	return nil
+}
+
+// PurgeEntity Окончательное удаление данных сущности, находящейся в корзине, из базы
+// Свойства и метаинформация удаляются каскадно внешними ключами
+func (s *SqliteStorage) PurgeEntity(ctx context.Context, id int32, userID int32) error {
+	query := "DELETE FROM entities WHERE id = ? AND user_id = ? AND deleted_at IS NOT NULL"
+	res, err := s.conn().ExecContext(ctx, query, id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no entity in trash with id: %v", id)
+	}
+
+	return nil
+}
+
+// GetExpiredTrash Получение сущностей, перемещенных в корзину ранее указанного момента
+func (s *SqliteStorage) GetExpiredTrash(ctx context.Context, deletedBefore time.Time) ([]entity.TrashItem, error) {
+	query := "SELECT id, user_id, etype, deleted_at FROM entities WHERE deleted_at IS NOT NULL AND deleted_at < ? ORDER BY id"
+	rows, err := s.conn().QueryContext(ctx, query, deletedBefore.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var list []entity.TrashItem
+	for rows.Next() {
+		var item entity.TrashItem
+		err := rows.Scan(&item.ID, &item.UserID, &item.Etype, &item.DeletedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		list = append(list, item)
+	}
+
+	return list, rows.Err()
+}
+
+// scanEntitySummaries сбор сущностей списка из строк выборки, соединенной с метаданными
+// Строки одной сущности идут подряд, у сущности без метаданных title и value - NULL
+func scanEntitySummaries(rows *sql.Rows) ([]entity.EntitySummary, error) {
+	var list []entity.EntitySummary
+	for rows.Next() {
+		var item entity.EntitySummary
+		var folderID sql.NullInt32
+		var createdAt, updatedAt, accessedAt sql.NullTime
+		var title, value sql.NullString
+		err := rows.Scan(&item.ID, &item.Etype, &folderID, &item.Favorite, &createdAt, &updatedAt, &accessedAt, &item.Revision, &title, &value)
+		if err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+
+		if len(list) == 0 || list[len(list)-1].ID != item.ID {
+			item.FolderID = folderID.Int32
+			item.CreatedAt = createdAt.Time
+			item.UpdatedAt = updatedAt.Time
+			item.AccessedAt = accessedAt.Time
+			list = append(list, item)
+		}
+		if title.Valid {
+			last := &list[len(list)-1]
+			last.Metainfo = append(last.Metainfo, entity.Metainfo{EntityID: item.ID, Title: title.String, Value: value.String})
+		}
+	}
+
+	return list, rows.Err()
+}
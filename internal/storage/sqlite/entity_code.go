@@ -0,0 +1,131 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+)
+
+// GetEntityCodes получение типов сущностей, доступных пользователю: встроенные и созданные им
+func (s *SqliteStorage) GetEntityCodes(ctx context.Context, userID int32) ([]entity_code.CodeModel, error) {
+
+	query := `SELECT etype, name, COALESCE(user_id, 0), archived_at IS NOT NULL FROM entity_codes
+			  WHERE user_id IS NULL OR user_id = ?1 ORDER BY user_id NULLS FIRST, etype`
+	cRows, err := s.conn().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("GetEntityCodes error: %w", err)
+	}
+	defer cRows.Close()
+
+	var res []entity_code.CodeModel
+	var code entity_code.CodeModel
+	for cRows.Next() {
+		err = cRows.Scan(&code.Etype, &code.Name, &code.UserID, &code.Archived)
+		if err != nil {
+			return nil, fmt.Errorf("GetEntityCodes fetch error: %w", err)
+		}
+
+		res = append(res, code)
+	}
+
+	return res, cRows.Err()
+}
+
+// GetEntityCode получение типа сущности по коду, у несуществующего типа пустой Etype
+func (s *SqliteStorage) GetEntityCode(ctx context.Context, etype string) (entity_code.CodeModel, error) {
+	query := `SELECT etype, name, COALESCE(user_id, 0), archived_at IS NOT NULL FROM entity_codes WHERE etype = ?1`
+
+	var code entity_code.CodeModel
+	row := s.conn().QueryRowContext(ctx, query, etype)
+	err := row.Scan(&code.Etype, &code.Name, &code.UserID, &code.Archived)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity_code.CodeModel{}, nil
+		}
+		return entity_code.CodeModel{}, err
+	}
+
+	return code, nil
+}
+
+// CreateEntityType создание пользовательского типа сущности вместе с описаниями полей
+func (s *SqliteStorage) CreateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) error {
+	return s.withTx(ctx, func(q dbExecutor) error {
+		query := `INSERT INTO entity_codes (etype, name, user_id) VALUES (?1, ?2, ?3)`
+		_, err := q.ExecContext(ctx, query, code.Etype, code.Name, code.UserID)
+		if err != nil {
+			return fmt.Errorf("CreateEntityType: %w", err)
+		}
+
+		return s.saveFields(ctx, q, fields)
+	})
+}
+
+// UpdateEntityType изменение названия пользовательского типа, изменение полей и добавление новых (ID = 0)
+func (s *SqliteStorage) UpdateEntityType(ctx context.Context, code entity_code.CodeModel, fields []field.EntityFields) error {
+	return s.withTx(ctx, func(q dbExecutor) error {
+		query := `UPDATE entity_codes SET name = ?1 WHERE etype = ?2 AND user_id = ?3`
+		res, err := q.ExecContext(ctx, query, code.Name, code.Etype, code.UserID)
+		if err != nil {
+			return fmt.Errorf("UpdateEntityType: %w", err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("no entity type: %v", code.Etype)
+		}
+
+		return s.saveFields(ctx, q, fields)
+	})
+}
+
+// saveFields изменение описаний полей с ID и добавление полей без ID
+func (s *SqliteStorage) saveFields(ctx context.Context, q dbExecutor, fields []field.EntityFields) error {
+	for _, f := range fields {
+		options, err := marshalOptions(f.Options)
+		if err != nil {
+			return fmt.Errorf("saveFields: %w", err)
+		}
+		if f.ID == 0 {
+			query := `INSERT INTO fields (etype, name, ftype, validate_rules, validate_messages, secret, position, options)
+					  VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)`
+			_, err = q.ExecContext(ctx, query, f.Etype, f.Name, f.Ftype, f.ValidateRules, f.ValidateMessages, f.Secret, f.Position, options)
+		} else {
+			query := `UPDATE fields SET name = ?1, ftype = ?2, validate_rules = ?3, validate_messages = ?4, secret = ?5, position = ?6,
+					  options = ?7 WHERE id = ?8 AND etype = ?9`
+			_, err = q.ExecContext(ctx, query, f.Name, f.Ftype, f.ValidateRules, f.ValidateMessages, f.Secret, f.Position, options, f.ID, f.Etype)
+		}
+		if err != nil {
+			return fmt.Errorf("saveFields: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SetEntityTypeArchived перемещение пользовательского типа в архив или возврат из архива
+func (s *SqliteStorage) SetEntityTypeArchived(ctx context.Context, etype string, userID int32, archived bool) error {
+	query := `UPDATE entity_codes SET archived_at = CASE WHEN ?1 THEN COALESCE(archived_at, ?4) END
+			  WHERE etype = ?2 AND user_id = ?3`
+	res, err := s.conn().ExecContext(ctx, query, archived, etype, userID, now())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("no entity type: %v", etype)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+)
+
+// GetEntityFields получение набора полей сущности в порядке их следования в форме
+func (s *SqliteStorage) GetEntityFields(ctx context.Context, etype string) ([]field.EntityFields, error) {
+
+	query := `SELECT id, name, ftype, validate_rules, validate_messages, secret, position, options FROM fields
+			  WHERE etype = ? ORDER BY position, id`
+	rows, err := s.conn().QueryContext(ctx, query, etype)
+	if err != nil {
+		return nil, fmt.Errorf("GetEntityCodes error: %w", err)
+	}
+	defer rows.Close()
+
+	var ef field.EntityFields
+	var fields []field.EntityFields
+	var options string
+	for rows.Next() {
+		err := rows.Scan(&ef.ID, &ef.Name, &ef.Ftype, &ef.ValidateRules, &ef.ValidateMessages, &ef.Secret, &ef.Position, &options)
+		if err != nil {
+			return nil, fmt.Errorf("GetEntityFields: %w", err)
+		}
+		ef.Options, err = unmarshalOptions(options)
+		if err != nil {
+			return nil, fmt.Errorf("GetEntityFields: %w", err)
+		}
+		ef.Etype = etype
+		fields = append(fields, ef)
+	}
+
+	return fields, nil
+
+}
+
+// GetFieldByEtypeAndName получить код записи и тип по типу сущности и имени
+func (s *SqliteStorage) GetFieldByEtypeAndName(ctx context.Context, etype string, name string) (int32, string, error) {
+	query := `SELECT id, ftype  FROM fields WHERE etype = ? AND name = ?`
+
+	var id int32
+	var ftype string
+	row := s.conn().QueryRowContext(ctx, query, etype, name)
+	err := row.Scan(&id, &ftype)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, "", nil
+		}
+		return 0, "", err
+	}
+
+	return id, ftype, nil
+}
+
+// IsFieldType имеет ли поле с указанным идентификатором определенный тип?
+func (s *SqliteStorage) IsFieldType(ctx context.Context, id int32, ftype string) (bool, error) {
+	query := `SELECT name FROM fields WHERE id = ? AND ftype = ?`
+
+	var name string
+	row := s.conn().QueryRowContext(ctx, query, id, ftype)
+	err := row.Scan(&name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// marshalOptions варианты значения поля select в виде JSON массива для колонки options
+func marshalOptions(options []string) (string, error) {
+	if len(options) == 0 {
+		return "[]", nil
+	}
+	data, err := json.Marshal(options)
+
+	return string(data), err
+}
+
+// unmarshalOptions варианты значения поля select из колонки options
+func unmarshalOptions(data string) ([]string, error) {
+	var options []string
+	if data == "" || data == "[]" {
+		return nil, nil
+	}
+	err := json.Unmarshal([]byte(data), &options)
+
+	return options, err
+}
@@ -0,0 +1,114 @@
+// Папки пользователя
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
+)
+
+// GetFolders Получение всех папок пользователя
+func (s *SqliteStorage) GetFolders(ctx context.Context, userID int32) ([]folder.FolderModel, error) {
+	query := "SELECT id, parent_id, name FROM folders WHERE user_id = ? ORDER BY id"
+	rows, err := s.conn().QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var list []folder.FolderModel
+	for rows.Next() {
+		var f folder.FolderModel
+		var parentID sql.NullInt32
+		err := rows.Scan(&f.ID, &parentID, &f.Name)
+		if err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		f.UserID = userID
+		f.ParentID = parentID.Int32
+		list = append(list, f)
+	}
+
+	return list, rows.Err()
+}
+
+// CreateFolder Создание папки
+func (s *SqliteStorage) CreateFolder(ctx context.Context, f folder.FolderModel) (int32, error) {
+	var id int32
+	query := "INSERT INTO folders (user_id, parent_id, name) VALUES (?, ?, ?) RETURNING id"
+	err := s.conn().QueryRowContext(ctx, query, f.UserID, nullID(f.ParentID), f.Name).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// UpdateFolder Изменение названия и родительской папки
+func (s *SqliteStorage) UpdateFolder(ctx context.Context, f folder.FolderModel) error {
+	query := "UPDATE folders SET parent_id = ?, name = ? WHERE id = ? AND user_id = ?"
+	res, err := s.conn().ExecContext(ctx, query, nullID(f.ParentID), f.Name, f.ID, f.UserID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("no folder with id: %v", f.ID)
+	}
+
+	return nil
+}
+
+// DeleteFolder Удаление папки, вложенные папки и сущности (в том числе в корзине) переносятся в родительскую папку
+func (s *SqliteStorage) DeleteFolder(ctx context.Context, id int32, userID int32) error {
+	return s.withTx(ctx, func(q dbExecutor) error {
+		var parentID sql.NullInt32
+		query := "SELECT parent_id FROM folders WHERE id = ? AND user_id = ?"
+		err := q.QueryRowContext(ctx, query, id, userID).Scan(&parentID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("no folder with id: %v", id)
+			}
+			return err
+		}
+
+		_, err = q.ExecContext(ctx, "UPDATE folders SET parent_id = ? WHERE parent_id = ?", parentID, id)
+		if err != nil {
+			return err
+		}
+		_, err = q.ExecContext(ctx, "UPDATE entities SET folder_id = ? WHERE folder_id = ?", parentID, id)
+		if err != nil {
+			return err
+		}
+		_, err = q.ExecContext(ctx, "DELETE FROM folders WHERE id = ?", id)
+
+		return err
+	})
+}
+
+// checkFolder Проверка принадлежности папки пользователю, 0 - вне папок
+func checkFolder(ctx context.Context, q dbExecutor, folderID int32, userID int32) error {
+	if folderID == 0 {
+		return nil
+	}
+
+	var id int32
+	err := q.QueryRowContext(ctx, "SELECT id FROM folders WHERE id = ? AND user_id = ?", folderID, userID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("no folder with id: %v", folderID)
+	}
+
+	return err
+}
+
+// nullID NULL вместо нулевого ID
+func nullID(id int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: id, Valid: id != 0}
+}
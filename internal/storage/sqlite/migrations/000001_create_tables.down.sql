@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS metainfo;
+DROP TABLE IF EXISTS properties;
+DROP TABLE IF EXISTS entities;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS fields;
+DROP TABLE IF EXISTS entity_codes;
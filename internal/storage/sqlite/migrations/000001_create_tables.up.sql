@@ -0,0 +1,64 @@
+CREATE TABLE entity_codes
+(
+    etype TEXT PRIMARY KEY,
+    name  TEXT
+);
+
+CREATE TABLE fields
+(
+    id                INTEGER PRIMARY KEY AUTOINCREMENT,
+    etype             TEXT NOT NULL REFERENCES entity_codes (etype) ON DELETE CASCADE,
+    name              TEXT NOT NULL,
+    ftype             TEXT NOT NULL,
+    validate_rules    TEXT,
+    validate_messages TEXT
+);
+
+CREATE INDEX etype_index ON fields (etype);
+CREATE INDEX ftype_index ON fields (ftype);
+
+CREATE TABLE users
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    login      TEXT NOT NULL,
+    password   TEXT NOT NULL,
+    salt       TEXT NOT NULL,
+    created_at DATETIME
+);
+
+CREATE UNIQUE INDEX login_index ON users (login);
+
+CREATE TABLE entities
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER  NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    etype      TEXT     NOT NULL REFERENCES entity_codes (etype) ON DELETE CASCADE,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME,
+    deleted_at DATETIME
+);
+
+CREATE INDEX entity_user_id_index ON entities (user_id);
+CREATE INDEX entity_etype_index ON entities (etype);
+CREATE INDEX entity_deleted_at_index ON entities (deleted_at);
+
+CREATE TABLE properties
+(
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id INTEGER NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    field_id  INTEGER NOT NULL REFERENCES fields (id) ON DELETE CASCADE,
+    value     TEXT    NOT NULL,
+    UNIQUE (entity_id, field_id)
+);
+
+CREATE INDEX field_id_index ON properties (field_id);
+
+CREATE TABLE metainfo
+(
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id INTEGER NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    title     TEXT    NOT NULL,
+    value     TEXT    NOT NULL
+);
+
+CREATE INDEX meta_entity_id_index ON metainfo (entity_id);
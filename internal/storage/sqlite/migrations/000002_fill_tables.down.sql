@@ -0,0 +1,2 @@
+DELETE FROM fields;
+DELETE FROM entity_codes;
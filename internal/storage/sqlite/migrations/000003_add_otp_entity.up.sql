@@ -0,0 +1,5 @@
+INSERT INTO entity_codes (etype, name)
+VALUES ('otp', 'Одноразовые пароли (OTP)');
+
+INSERT INTO fields (etype, name, ftype, validate_rules, validate_messages)
+VALUES ('otp', 'Секрет (base32 или otpauth:// URI)', 'otp', 'required,otpauth', '{"required": "Секрет не может быть пустым", "otpauth": "Неверный формат секрета или otpauth:// URI"}');
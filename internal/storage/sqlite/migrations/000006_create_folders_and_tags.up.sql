@@ -0,0 +1,24 @@
+CREATE TABLE folders
+(
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id   INTEGER NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    parent_id INTEGER REFERENCES folders (id) ON DELETE CASCADE,
+    name      TEXT    NOT NULL
+);
+
+CREATE INDEX folders_user_id_index ON folders (user_id);
+CREATE INDEX folders_parent_id_index ON folders (parent_id);
+
+ALTER TABLE entities
+    ADD COLUMN folder_id INTEGER REFERENCES folders (id) ON DELETE SET NULL;
+
+CREATE INDEX entity_folder_id_index ON entities (folder_id);
+
+CREATE TABLE entity_tags
+(
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id INTEGER NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    tag       TEXT    NOT NULL
+);
+
+CREATE INDEX entity_tags_entity_id_index ON entity_tags (entity_id);
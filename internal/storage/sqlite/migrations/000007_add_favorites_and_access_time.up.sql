@@ -0,0 +1,6 @@
+ALTER TABLE entities
+    ADD COLUMN favorite BOOLEAN NOT NULL DEFAULT 0;
+ALTER TABLE entities
+    ADD COLUMN accessed_at DATETIME;
+
+CREATE INDEX entity_user_accessed_at_index ON entities (user_id, accessed_at);
@@ -0,0 +1,2 @@
+ALTER TABLE entities
+    ADD COLUMN revision INTEGER NOT NULL DEFAULT 1;
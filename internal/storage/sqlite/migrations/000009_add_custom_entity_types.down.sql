@@ -0,0 +1,6 @@
+DELETE FROM entity_codes WHERE user_id IS NOT NULL;
+ALTER TABLE fields DROP COLUMN position;
+ALTER TABLE fields DROP COLUMN secret;
+DROP INDEX IF EXISTS entity_codes_user_id_index;
+ALTER TABLE entity_codes DROP COLUMN archived_at;
+ALTER TABLE entity_codes DROP COLUMN user_id;
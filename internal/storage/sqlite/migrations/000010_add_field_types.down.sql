@@ -0,0 +1,4 @@
+UPDATE fields SET validate_rules = 'len=5', validate_messages = '{"len": "Месяц/год должны быть в формате mm/dd"}'
+WHERE etype = 'card' AND name = 'Месяц/Год (mm/yy) до которого действует карта';
+UPDATE fields SET ftype = 'string' WHERE ftype IN ('secret', 'multiline', 'url', 'date', 'monthyear', 'number', 'select');
+ALTER TABLE fields DROP COLUMN options;
@@ -0,0 +1,9 @@
+/* Варианты значения поля типа select (JSON массив строк) */
+ALTER TABLE fields
+    ADD COLUMN options TEXT NOT NULL DEFAULT '[]';
+
+/* Пароль и код проверки карты вводятся без отображения, срок действия карты - месяц и год */
+UPDATE fields SET ftype = 'secret' WHERE etype = 'logopas' AND name = 'Пароль';
+UPDATE fields SET ftype = 'secret' WHERE etype = 'card' AND name = 'Код проверки подлинности';
+UPDATE fields SET ftype = 'monthyear', validate_rules = '', validate_messages = '{}'
+WHERE etype = 'card' AND name = 'Месяц/Год (mm/yy) до которого действует карта';
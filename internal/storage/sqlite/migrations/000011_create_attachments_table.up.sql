@@ -0,0 +1,13 @@
+CREATE TABLE attachments
+(
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id   INTEGER  NOT NULL REFERENCES entities (id) ON DELETE CASCADE,
+    name        TEXT     NOT NULL,
+    size        INTEGER  NOT NULL DEFAULT 0,
+    digest      TEXT     NOT NULL DEFAULT '',
+    servername  TEXT     NOT NULL,
+    chunk_count INTEGER  NOT NULL DEFAULT 0,
+    created_at  DATETIME NOT NULL
+);
+
+CREATE INDEX attachments_entity_id_index ON attachments (entity_id);
@@ -0,0 +1,159 @@
+// Package sqlite хранилище данных в файле SQLite для однопользовательской установки сервера.
+// Схема базы создается встроенными в бинарный файл миграциями (MigrateUp или NewMigrate)
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	migratesqlite "github.com/golang-migrate/migrate/v4/database/sqlite"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	_ "modernc.org/sqlite"
+
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	"github.com/dnsoftware/gophkeeper/logger"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// connParams параметры соединения: внешние ключи, ожидание блокировки,
+// журнал WAL для чтения во время записи и захват блокировки записи в начале транзакции
+const connParams = "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"
+
+// dbExecutor общий набор методов *sql.DB и *sql.Tx, через который выполняются запросы
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// SqliteStorage работает с базой данных SQLite.
+type SqliteStorage struct {
+	db *sql.DB
+	tx *sql.Tx // транзакция единицы работы (nil - хранилище работает вне транзакции)
+}
+
+// NewSqliteStorage открытие (создание) файла базы данных
+// Схема не изменяется: миграции применяются отдельно (при запуске сервера, подкомандой migrate или MigrateUp)
+func NewSqliteStorage(path string) (*SqliteStorage, error) {
+
+	db, err := sql.Open("sqlite", "file:"+path+connParams)
+	if err != nil {
+		logger.Log().Error(err.Error())
+		return nil, err
+	}
+
+	ss := &SqliteStorage{
+		db: db,
+	}
+
+	return ss, nil
+}
+
+// MigrateUp приведение схемы базы данных в файле path к актуальной версии
+func MigrateUp(path string) error {
+	m, err := NewMigrate(path)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	err = m.Up()
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		logger.Log().Error("sqlite migrate: " + err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// NewMigrate управление схемой базы данных из файла path встроенными миграциями
+func NewMigrate(path string) (*migrate.Migrate, error) {
+	db, err := sql.Open("sqlite", "file:"+path+connParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return newMigrate(db)
+}
+
+// newMigrate golang-migrate поверх открытой базы и встроенных миграций
+func newMigrate(db *sql.DB) (*migrate.Migrate, error) {
+	source, err := iofs.New(migrations, "migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := migratesqlite.WithInstance(db, &migratesqlite.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewWithInstance("iofs", source, "sqlite", driver)
+}
+
+// Close закрытие базы данных
+func (s *SqliteStorage) Close() error {
+	return s.db.Close()
+}
+
+// now текущее время в UTC: SQLite хранит время строкой, единая зона нужна для корректного сравнения
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// conn текущее соединение: транзакция единицы работы, если она открыта, иначе пул соединений
+func (s *SqliteStorage) conn() dbExecutor {
+	if s.tx != nil {
+		return s.tx
+	}
+
+	return s.db
+}
+
+// withTx выполнение fn в транзакции
+// Внутри единицы работы используется ее транзакция, фиксацией которой управляет Transaction
+func (s *SqliteStorage) withTx(ctx context.Context, fn func(q dbExecutor) error) error {
+	if s.tx != nil {
+		return fn(s.tx)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Transaction выполнение набора операций с хранилищем в одной транзакции (единица работы)
+// fn получает хранилище, привязанное к транзакции. Если fn вернула ошибку - транзакция откатывается
+func (s *SqliteStorage) Transaction(ctx context.Context, fn func(repo entity.EntityRepo) error) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(&SqliteStorage{db: s.db, tx: tx})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,53 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dnsoftware/gophkeeper/internal/storage/storagetest"
+)
+
+func TestConformance(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) storagetest.Storage {
+		path := t.TempDir() + "/gophkeeper.db"
+		require.NoError(t, MigrateUp(path))
+		db, err := NewSqliteStorage(path)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = db.Close()
+		})
+
+		return db
+	})
+}
+
+func TestReopen(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir() + "/gophkeeper.db"
+
+	// без миграций схемы нет
+	db, err := NewSqliteStorage(path)
+	require.NoError(t, err)
+	_, err = db.UserCreate(ctx, "user", "password", "salt")
+	require.Error(t, err)
+	require.NoError(t, db.Close())
+
+	require.NoError(t, MigrateUp(path))
+	db, err = NewSqliteStorage(path)
+	require.NoError(t, err)
+	_, err = db.UserCreate(ctx, "user", "password", "salt")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// повторное применение миграций ничего не меняет, данные на месте
+	require.NoError(t, MigrateUp(path))
+	db, err = NewSqliteStorage(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	id, _, err := db.GetUser(ctx, "user")
+	require.NoError(t, err)
+	require.Equal(t, 1, id)
+}
@@ -0,0 +1,1075 @@
+// Package storagetest общий набор тестов на соответствие реализаций хранилища сервера единой семантике.
+// Запускается для каждой реализации из ее собственных тестов
+package storagetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dnsoftware/gophkeeper/internal/constants"
+	pb "github.com/dnsoftware/gophkeeper/internal/proto"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/entity_code"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/field"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/folder"
+	"github.com/dnsoftware/gophkeeper/internal/server/domain/user"
+	"github.com/dnsoftware/gophkeeper/internal/utils"
+)
+
+// Storage полный набор интерфейсов хранилища, необходимых доменным сервисам
+type Storage interface {
+	entity.EntityRepo
+	user.UserStorage
+	field.FieldStorage
+	entity_code.EntityCodeStorage
+	folder.FolderStorage
+	GetFieldByEtypeAndName(ctx context.Context, etype string, name string) (int32, string, error)
+}
+
+// Run запуск набора тестов. newStorage должна возвращать чистое хранилище с начальными данными
+func Run(t *testing.T, newStorage func(t *testing.T) Storage) {
+	t.Run("users", func(t *testing.T) { testUsers(t, newStorage(t)) })
+	t.Run("entity codes and fields", func(t *testing.T) { testDictionaries(t, newStorage(t)) })
+	t.Run("custom entity types", func(t *testing.T) { testCustomTypes(t, newStorage(t)) })
+	t.Run("entity lifecycle", func(t *testing.T) { testEntityLifecycle(t, newStorage(t)) })
+	t.Run("trash", func(t *testing.T) { testTrash(t, newStorage(t)) })
+	t.Run("blind index search", func(t *testing.T) { testSearch(t, newStorage(t)) })
+	t.Run("folders and tags", func(t *testing.T) { testFolders(t, newStorage(t)) })
+	t.Run("favorites and recent", func(t *testing.T) { testFavorites(t, newStorage(t)) })
+	t.Run("list pages", func(t *testing.T) { testListPages(t, newStorage(t)) })
+	t.Run("binary", func(t *testing.T) { testBinary(t, newStorage(t)) })
+	t.Run("attachments", func(t *testing.T) { testAttachments(t, newStorage(t)) })
+	t.Run("transaction", func(t *testing.T) { testTransaction(t, newStorage(t)) })
+	t.Run("concurrency", func(t *testing.T) { testConcurrency(t, newStorage(t)) })
+	t.Run("entity lock", func(t *testing.T) { testLockEntity(t, newStorage(t)) })
+	t.Run("domain service", func(t *testing.T) { testDomainService(t, newStorage(t)) })
+	t.Run("domain attachments", func(t *testing.T) { testDomainAttachments(t, newStorage(t)) })
+}
+
+// createUser регистрация тестового пользователя
+func createUser(t *testing.T, s Storage, login string) int32 {
+	_, salt := utils.SaltGenerate()
+	id, err := s.UserCreate(context.Background(), login, utils.PassGenerate("password", salt), salt)
+	require.NoError(t, err)
+	require.Greater(t, id, 0)
+
+	return int32(id)
+}
+
+// cardEntity банковская карта с заполненными свойствами и метаинформацией
+func cardEntity(t *testing.T, s Storage, userID int32) entity.EntityModel {
+	ctx := context.Background()
+	numberID, _, err := s.GetFieldByEtypeAndName(ctx, "card", "Номер банковской карты")
+	require.NoError(t, err)
+	codeID, _, err := s.GetFieldByEtypeAndName(ctx, "card", "Код проверки подлинности")
+	require.NoError(t, err)
+
+	return entity.EntityModel{
+		UserID: userID,
+		Etype:  "card",
+		Props: []entity.Property{
+			{FieldID: numberID, Value: "4111111111111111"},
+			{FieldID: codeID, Value: "123"},
+		},
+		Metainfo: []entity.Metainfo{{Title: "Банк", Value: "Тест"}},
+	}
+}
+
+func testUsers(t *testing.T, s Storage) {
+	ctx := context.Background()
+
+	id, _, err := s.GetUser(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, 0, id)
+
+	userID := createUser(t, s, "user")
+
+	id, createdAt, err := s.GetUser(ctx, "user")
+	require.NoError(t, err)
+	assert.Equal(t, int(userID), id)
+	assert.False(t, createdAt.IsZero())
+
+	_, err = s.UserCreate(ctx, "user", "password", "salt")
+	assert.Error(t, err)
+
+	id, msg := s.LoginUser(ctx, "user", "password")
+	assert.Equal(t, int(userID), id)
+	assert.Empty(t, msg)
+
+	id, msg = s.LoginUser(ctx, "user", "wrong")
+	assert.Equal(t, 0, id)
+	assert.Equal(t, constants.ErrBadPassword, msg)
+
+	id, msg = s.LoginUser(ctx, "nobody", "password")
+	assert.Equal(t, 0, id)
+	assert.Equal(t, constants.ErrNoSuchUser, msg)
+}
+
+func testDictionaries(t *testing.T, s Storage) {
+	ctx := context.Background()
+
+	codes, err := s.GetEntityCodes(ctx, 0)
+	require.NoError(t, err)
+	etypes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		etypes = append(etypes, code.Etype)
+		assert.Zero(t, code.UserID)
+		assert.False(t, code.Archived)
+	}
+	assert.ElementsMatch(t, []string{"logopas", "card", "text", "binary", "otp", "sshkey"}, etypes)
+
+	code, err := s.GetEntityCode(ctx, "card")
+	require.NoError(t, err)
+	assert.Equal(t, entity_code.CodeModel{Etype: "card", Name: "Банковская карта"}, code)
+	code, err = s.GetEntityCode(ctx, "unknown")
+	require.NoError(t, err)
+	assert.Empty(t, code.Etype)
+
+	fields, err := s.GetEntityFields(ctx, "card")
+	require.NoError(t, err)
+	require.Len(t, fields, 3)
+	assert.Equal(t, "card", fields[0].Etype)
+	// номер и срок действия карты открыты, секретен только код проверки
+	assert.Equal(t, []bool{false, false, true}, []bool{fields[0].Secret, fields[1].Secret, fields[2].Secret})
+	assert.Equal(t, []string{constants.FieldTypeString, constants.FieldTypeMonthYear, constants.FieldTypeSecret},
+		[]string{fields[0].Ftype, fields[1].Ftype, fields[2].Ftype})
+
+	fields, err = s.GetEntityFields(ctx, "logopas")
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, constants.FieldTypeSecret, fields[1].Ftype)
+	assert.Empty(t, fields[1].Options)
+	assert.False(t, fields[0].Secret)
+	assert.True(t, fields[1].Secret)
+
+	fields, err = s.GetEntityFields(ctx, "otp")
+	require.NoError(t, err)
+	require.Len(t, fields, 1)
+	assert.True(t, fields[0].Secret)
+
+	fields, err = s.GetEntityFields(ctx, "sshkey")
+	require.NoError(t, err)
+	secret := make(map[string]bool)
+	for _, f := range fields {
+		secret[f.Ftype] = f.Secret
+	}
+	assert.Equal(t, map[string]bool{
+		constants.FieldTypeSSHKey:        true,
+		constants.FieldTypeSSHPassphrase: true,
+		constants.FieldTypeSSHPublicKey:  false,
+		constants.FieldTypeSSHComment:    false,
+	}, secret)
+
+	id, ftype, err := s.GetFieldByEtypeAndName(ctx, "binary", "Произвольные бинарные данные (путь к файлу)")
+	require.NoError(t, err)
+	assert.Greater(t, id, int32(0))
+	assert.Equal(t, constants.FieldTypePath, ftype)
+
+	isType, err := s.IsFieldType(ctx, id, constants.FieldTypePath)
+	require.NoError(t, err)
+	assert.True(t, isType)
+
+	isType, err = s.IsFieldType(ctx, id, "string")
+	require.NoError(t, err)
+	assert.False(t, isType)
+
+	id, ftype, err = s.GetFieldByEtypeAndName(ctx, "card", "нет такого поля")
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), id)
+	assert.Empty(t, ftype)
+}
+
+func testCustomTypes(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	err := s.CreateEntityType(ctx, entity_code.CodeModel{Etype: "u1_wifi", Name: "Wi-Fi", UserID: userID}, []field.EntityFields{
+		{Etype: "u1_wifi", Name: "Пароль", Ftype: "string", ValidateRules: "required", ValidateMessages: "{}", Secret: true, Position: 2},
+		{Etype: "u1_wifi", Name: "SSID", Ftype: "string", ValidateMessages: "{}", Position: 1},
+	})
+	require.NoError(t, err)
+
+	// пользовательский тип виден только владельцу
+	codes, err := s.GetEntityCodes(ctx, userID)
+	require.NoError(t, err)
+	assert.Contains(t, codes, entity_code.CodeModel{Etype: "u1_wifi", Name: "Wi-Fi", UserID: userID})
+	codes, err = s.GetEntityCodes(ctx, otherID)
+	require.NoError(t, err)
+	assert.Len(t, codes, 6)
+
+	// поля упорядочены по position
+	fields, err := s.GetEntityFields(ctx, "u1_wifi")
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "SSID", fields[0].Name)
+	assert.Equal(t, "Пароль", fields[1].Name)
+	assert.True(t, fields[1].Secret)
+	ssidID, passID := fields[0].ID, fields[1].ID
+
+	// сущность пользовательского типа
+	entID, err := s.CreateEntity(ctx, entity.EntityModel{
+		UserID: userID,
+		Etype:  "u1_wifi",
+		Props:  []entity.Property{{FieldID: ssidID, Value: "home"}, {FieldID: passID, Value: "secret"}},
+	})
+	require.NoError(t, err)
+
+	// изменение: переименование, перестановка полей, новое поле
+	err = s.UpdateEntityType(ctx, entity_code.CodeModel{Etype: "u1_wifi", Name: "Сеть Wi-Fi", UserID: userID}, []field.EntityFields{
+		{ID: passID, Etype: "u1_wifi", Name: "Ключ сети", Ftype: "string", ValidateMessages: "{}", Secret: true, Position: 1},
+		{ID: ssidID, Etype: "u1_wifi", Name: "SSID", Ftype: "string", ValidateMessages: "{}", Position: 2},
+		{Etype: "u1_wifi", Name: "Частота", Ftype: "select", ValidateMessages: "{}", Position: 3, Options: []string{"2.4 ГГц", "5 ГГц"}},
+	})
+	require.NoError(t, err)
+	fields, err = s.GetEntityFields(ctx, "u1_wifi")
+	require.NoError(t, err)
+	require.Len(t, fields, 3)
+	assert.Equal(t, []string{"Ключ сети", "SSID", "Частота"}, []string{fields[0].Name, fields[1].Name, fields[2].Name})
+	assert.Equal(t, passID, fields[0].ID)
+	assert.Empty(t, fields[0].ValidateRules)
+	assert.Equal(t, []string{"2.4 ГГц", "5 ГГц"}, fields[2].Options)
+
+	// значения сохраненной сущности не теряются
+	ent, err := s.GetEntity(ctx, entID)
+	require.NoError(t, err)
+	assert.Len(t, ent.Props, 2)
+
+	// чужой тип не изменяется
+	err = s.UpdateEntityType(ctx, entity_code.CodeModel{Etype: "u1_wifi", Name: "x", UserID: otherID}, nil)
+	assert.Error(t, err)
+	assert.Error(t, s.SetEntityTypeArchived(ctx, "u1_wifi", otherID, true))
+	assert.Error(t, s.SetEntityTypeArchived(ctx, "card", userID, true))
+
+	// архив
+	require.NoError(t, s.SetEntityTypeArchived(ctx, "u1_wifi", userID, true))
+	code, err := s.GetEntityCode(ctx, "u1_wifi")
+	require.NoError(t, err)
+	assert.Equal(t, entity_code.CodeModel{Etype: "u1_wifi", Name: "Сеть Wi-Fi", UserID: userID, Archived: true}, code)
+	require.NoError(t, s.SetEntityTypeArchived(ctx, "u1_wifi", userID, false))
+	code, err = s.GetEntityCode(ctx, "u1_wifi")
+	require.NoError(t, err)
+	assert.False(t, code.Archived)
+}
+
+func testEntityLifecycle(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	ent := cardEntity(t, s, userID)
+	id, err := s.CreateEntity(ctx, ent)
+	require.NoError(t, err)
+	require.Greater(t, id, int32(0))
+
+	saved, err := s.GetEntity(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, userID, saved.UserID)
+	assert.Equal(t, "card", saved.Etype)
+	require.Len(t, saved.Props, 2)
+	assert.Equal(t, ent.Props[0].Value, saved.Props[0].Value)
+	require.Len(t, saved.Metainfo, 1)
+	assert.Equal(t, "Банк", saved.Metainfo[0].Title)
+
+	_, err = s.GetEntity(ctx, id+100)
+	assert.Error(t, err)
+
+	// свойство с несуществующим описанием поля
+	bad := cardEntity(t, s, userID)
+	bad.Props[0].FieldID = 100000
+	_, err = s.CreateEntity(ctx, bad)
+	assert.Error(t, err)
+
+	// редактирование: значения свойств обновляются, метаинформация заменяется
+	edited := cardEntity(t, s, userID)
+	edited.ID = id
+	edited.Props[1].Value = "321"
+	edited.Metainfo = []entity.Metainfo{{Title: "Банк", Value: "Новый"}, {Title: "Владелец", Value: "Иванов"}}
+	require.NoError(t, s.UpdateEntity(ctx, edited))
+
+	saved, err = s.GetEntity(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, saved.Props, 2)
+	assert.Equal(t, "321", saved.Props[1].Value)
+	require.Len(t, saved.Metainfo, 2)
+	assert.Equal(t, "Новый", saved.Metainfo[0].Value)
+
+	// чужая сущность не редактируется
+	edited.UserID = otherID
+	assert.Error(t, s.UpdateEntity(ctx, edited))
+
+	list, err := s.GetEntityListByType(ctx, "card", userID, entity.ListFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []int32{id}, listIDs(list))
+	assert.Equal(t, "card", list[0].Etype)
+	assert.Equal(t, int64(2), list[0].Revision)
+	assert.False(t, list[0].CreatedAt.IsZero())
+	assert.False(t, list[0].UpdatedAt.IsZero())
+	assert.ElementsMatch(t, []string{"Банк:Новый", "Владелец:Иванов"}, metaPairs(list[0].Metainfo))
+
+	list, err = s.GetEntityListByType(ctx, "card", otherID, entity.ListFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// сущность без метаинформации
+	noMeta := cardEntity(t, s, userID)
+	noMeta.Metainfo = nil
+	noMetaID, err := s.CreateEntity(ctx, noMeta)
+	require.NoError(t, err)
+	list, err = s.GetEntityListByType(ctx, "card", userID, entity.ListFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []int32{id, noMetaID}, listIDs(list))
+	assert.Empty(t, list[1].Metainfo)
+	assert.Equal(t, int64(1), list[1].Revision)
+	assert.True(t, list[1].UpdatedAt.IsZero())
+}
+
+func testTrash(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	first, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+	second, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+
+	assert.Error(t, s.DeleteEntity(ctx, first, otherID))
+	require.NoError(t, s.DeleteEntity(ctx, first, userID))
+	assert.Error(t, s.DeleteEntity(ctx, first, userID))
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, s.DeleteEntity(ctx, second, userID))
+
+	_, err = s.GetEntity(ctx, first)
+	assert.Error(t, err)
+
+	list, err := s.GetEntityListByType(ctx, "card", userID, entity.ListFilter{})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// новые удаленные - первыми
+	trash, err := s.GetTrashList(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, trash, 2)
+	assert.Equal(t, second, trash[0].ID)
+	assert.Equal(t, first, trash[1].ID)
+	require.Len(t, trash[1].Metainfo, 1)
+	assert.Equal(t, "Тест", trash[1].Metainfo[0].Value)
+
+	trash, err = s.GetTrashList(ctx, otherID)
+	require.NoError(t, err)
+	assert.Empty(t, trash)
+
+	deleted, err := s.GetDeletedEntity(ctx, first, userID)
+	require.NoError(t, err)
+	assert.Len(t, deleted.Props, 2)
+	_, err = s.GetDeletedEntity(ctx, first, otherID)
+	assert.Error(t, err)
+
+	// восстановление
+	assert.Error(t, s.RestoreEntity(ctx, first, otherID))
+	require.NoError(t, s.RestoreEntity(ctx, first, userID))
+	assert.Error(t, s.RestoreEntity(ctx, first, userID))
+	_, err = s.GetEntity(ctx, first)
+	require.NoError(t, err)
+
+	// окончательное удаление только из корзины
+	assert.Error(t, s.PurgeEntity(ctx, first, userID))
+	expired, err := s.GetExpiredTrash(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, second, expired[0].ID)
+	assert.Equal(t, userID, expired[0].UserID)
+
+	expired, err = s.GetExpiredTrash(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+
+	assert.Error(t, s.PurgeEntity(ctx, second, otherID))
+	require.NoError(t, s.PurgeEntity(ctx, second, userID))
+	assert.Error(t, s.PurgeEntity(ctx, second, userID))
+	_, err = s.GetDeletedEntity(ctx, second, userID)
+	assert.Error(t, err)
+}
+
+func testSearch(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	ent := cardEntity(t, s, userID)
+	ent.Tokens = []string{"t-bank", "t-test", "t-test"}
+	first, err := s.CreateEntity(ctx, ent)
+	require.NoError(t, err)
+
+	ent.Tokens = []string{"t-bank"}
+	second, err := s.CreateEntity(ctx, ent)
+	require.NoError(t, err)
+
+	ent.UserID = otherID
+	ent.Tokens = []string{"t-bank", "t-test"}
+	_, err = s.CreateEntity(ctx, ent)
+	require.NoError(t, err)
+
+	ids := func(list []entity.FoundEntity) []int32 {
+		var res []int32
+		for _, item := range list {
+			res = append(res, item.ID)
+		}
+		return res
+	}
+
+	// нужны все токены запроса, только сущности владельца
+	found, err := s.SearchEntities(ctx, userID, "", []string{"t-bank"})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{first, second}, ids(found))
+	found, err = s.SearchEntities(ctx, userID, "card", []string{"t-bank", "t-test"})
+	require.NoError(t, err)
+	require.Equal(t, []int32{first}, ids(found))
+	assert.Equal(t, "card", found[0].Etype)
+	require.Len(t, found[0].Metainfo, 1)
+	assert.Equal(t, "Тест", found[0].Metainfo[0].Value)
+
+	found, err = s.SearchEntities(ctx, userID, "logopas", []string{"t-bank"})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+	found, err = s.SearchEntities(ctx, userID, "", []string{"t-none"})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	// при сохранении токены заменяются
+	upd := cardEntity(t, s, userID)
+	upd.ID = second
+	upd.Tokens = []string{"t-new"}
+	require.NoError(t, s.UpdateEntity(ctx, upd))
+	found, err = s.SearchEntities(ctx, userID, "", []string{"t-bank"})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{first}, ids(found))
+	found, err = s.SearchEntities(ctx, userID, "", []string{"t-new"})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{second}, ids(found))
+
+	// сущности в корзине не находятся
+	require.NoError(t, s.DeleteEntity(ctx, first, userID))
+	found, err = s.SearchEntities(ctx, userID, "", []string{"t-test"})
+	require.NoError(t, err)
+	assert.Empty(t, found)
+	require.NoError(t, s.PurgeEntity(ctx, first, userID))
+}
+
+func testFolders(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	work, err := s.CreateFolder(ctx, folder.FolderModel{UserID: userID, Name: "work"})
+	require.NoError(t, err)
+	team, err := s.CreateFolder(ctx, folder.FolderModel{UserID: userID, ParentID: work, Name: "team"})
+	require.NoError(t, err)
+	_, err = s.CreateFolder(ctx, folder.FolderModel{UserID: otherID, Name: "foreign"})
+	require.NoError(t, err)
+
+	folders, err := s.GetFolders(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []folder.FolderModel{
+		{ID: work, UserID: userID, Name: "work"},
+		{ID: team, UserID: userID, ParentID: work, Name: "team"},
+	}, folders)
+
+	require.NoError(t, s.UpdateFolder(ctx, folder.FolderModel{ID: team, UserID: userID, Name: "team2"}))
+	assert.Error(t, s.UpdateFolder(ctx, folder.FolderModel{ID: team, UserID: otherID, Name: "x"}))
+	require.NoError(t, s.UpdateFolder(ctx, folder.FolderModel{ID: team, UserID: userID, ParentID: work, Name: "team"}))
+
+	// сущность в папке с тегами
+	ent := cardEntity(t, s, userID)
+	ent.FolderID = team
+	ent.Tags = []string{"enc-tag-1", "enc-tag-2"}
+	ent.Tokens = []string{"t-tag"}
+	inTeam, err := s.CreateEntity(ctx, ent)
+	require.NoError(t, err)
+	got, err := s.GetEntity(ctx, inTeam)
+	require.NoError(t, err)
+	assert.Equal(t, team, got.FolderID)
+	assert.Equal(t, []string{"enc-tag-1", "enc-tag-2"}, got.Tags)
+
+	root, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+
+	// чужая папка недоступна
+	foreign := cardEntity(t, s, otherID)
+	foreign.FolderID = team
+	_, err = s.CreateEntity(ctx, foreign)
+	assert.Error(t, err)
+
+	// фильтры списка
+	list, err := s.GetEntityListByType(ctx, "card", userID, entity.ListFilter{FolderID: team})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{inTeam}, listIDs(list))
+	list, err = s.GetEntityListByType(ctx, "", userID, entity.ListFilter{FolderID: constants.RootFolder})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{root}, listIDs(list))
+	list, err = s.GetEntityListByType(ctx, "", userID, entity.ListFilter{TagToken: "t-tag"})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{inTeam}, listIDs(list))
+	list, err = s.GetEntityListByType(ctx, "logopas", userID, entity.ListFilter{TagToken: "t-tag"})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// при сохранении теги и папка заменяются
+	ent.ID = inTeam
+	ent.FolderID = 0
+	ent.Tags = []string{"enc-tag-3"}
+	require.NoError(t, s.UpdateEntity(ctx, ent))
+	got, err = s.GetEntity(ctx, inTeam)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), got.FolderID)
+	assert.Equal(t, []string{"enc-tag-3"}, got.Tags)
+
+	// удаление папки: вложенные папки и сущности переходят к родителю
+	ent.FolderID = team
+	require.NoError(t, s.UpdateEntity(ctx, ent))
+	assert.Error(t, s.DeleteFolder(ctx, team, otherID))
+	require.NoError(t, s.DeleteFolder(ctx, team, userID))
+	got, err = s.GetEntity(ctx, inTeam)
+	require.NoError(t, err)
+	assert.Equal(t, work, got.FolderID)
+
+	sub, err := s.CreateFolder(ctx, folder.FolderModel{UserID: userID, ParentID: work, Name: "sub"})
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteFolder(ctx, work, userID))
+	folders, err = s.GetFolders(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []folder.FolderModel{{ID: sub, UserID: userID, Name: "sub"}}, folders)
+	got, err = s.GetEntity(ctx, inTeam)
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), got.FolderID)
+}
+
+func testFavorites(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	first, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+	second, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetFavorite(ctx, second, userID, true))
+	assert.Error(t, s.SetFavorite(ctx, first, otherID, true))
+	assert.Error(t, s.SetFavorite(ctx, second+100, userID, true))
+
+	list, err := s.GetEntityListByType(ctx, "", userID, entity.ListFilter{Favorite: true})
+	require.NoError(t, err)
+	require.Equal(t, []int32{second}, listIDs(list))
+	assert.True(t, list[0].Favorite)
+	assert.True(t, list[0].AccessedAt.IsZero())
+
+	// время получения и отметка избранного не меняются при редактировании
+	accessed := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, s.TouchEntity(ctx, second, userID, accessed))
+	// чужой пользователь время получения не меняет
+	require.NoError(t, s.TouchEntity(ctx, second, otherID, accessed.Add(time.Hour)))
+	ent, err := s.GetEntity(ctx, second)
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateEntity(ctx, ent))
+
+	got, err := s.GetEntity(ctx, second)
+	require.NoError(t, err)
+	assert.True(t, got.Favorite)
+	assert.True(t, accessed.Equal(got.AccessedAt), got.AccessedAt)
+
+	list, err = s.GetEntityListByType(ctx, "card", userID, entity.ListFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []int32{first, second}, listIDs(list))
+	assert.False(t, list[0].Favorite)
+	assert.True(t, list[0].AccessedAt.IsZero())
+	assert.True(t, accessed.Equal(list[1].AccessedAt), list[1].AccessedAt)
+
+	require.NoError(t, s.SetFavorite(ctx, second, userID, false))
+	list, err = s.GetEntityListByType(ctx, "", userID, entity.ListFilter{Favorite: true})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+
+	// сущность в корзине в избранное не добавляется
+	require.NoError(t, s.DeleteEntity(ctx, first, userID))
+	assert.Error(t, s.SetFavorite(ctx, first, userID, true))
+}
+
+func testBinary(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+
+	fieldID, _, err := s.GetFieldByEtypeAndName(ctx, "binary", "Произвольные бинарные данные (путь к файлу)")
+	require.NoError(t, err)
+	value, _ := json.Marshal(entity.BinaryFileProperty{Servername: "/tmp/bank/file", Clientname: "file.bin"})
+
+	id, err := s.CreateEntity(ctx, entity.EntityModel{
+		UserID: userID,
+		Etype:  "binary",
+		Props:  []entity.Property{{FieldID: fieldID, Value: string(value)}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetChunkCountForCryptoBinary(ctx, id, 5))
+
+	filedata, err := s.GetBinaryFilenameByEntityID(ctx, id)
+	require.NoError(t, err)
+	fd := &entity.BinaryFileProperty{}
+	require.NoError(t, json.Unmarshal([]byte(filedata), fd))
+	assert.Equal(t, "/tmp/bank/file", fd.Servername)
+	assert.Equal(t, "file.bin", fd.Clientname)
+	assert.Equal(t, int32(5), fd.Chunkcount)
+
+	_, err = s.GetBinaryFilenameByEntityID(ctx, id+100)
+	assert.Error(t, err)
+}
+
+func testAttachments(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+
+	id, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+	other, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+
+	first, err := s.CreateAttachment(ctx, entity.AttachmentModel{EntityID: id, Name: "enc1", Size: 10, Digest: "d1", Servername: "/tmp/bank/a/a", ChunkCount: 2})
+	require.NoError(t, err)
+	second, err := s.CreateAttachment(ctx, entity.AttachmentModel{EntityID: id, Name: "enc2", Size: 20, Digest: "d2", Servername: "/tmp/bank/b/b", ChunkCount: 3})
+	require.NoError(t, err)
+	_, err = s.CreateAttachment(ctx, entity.AttachmentModel{EntityID: other, Name: "enc3", Servername: "/tmp/bank/c/c"})
+	require.NoError(t, err)
+
+	list, err := s.GetAttachments(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, first, list[0].ID)
+	assert.Equal(t, second, list[1].ID)
+	assert.False(t, list[0].CreatedAt.IsZero())
+
+	att, err := s.GetAttachment(ctx, second)
+	require.NoError(t, err)
+	att.CreatedAt = time.Time{}
+	assert.Equal(t, entity.AttachmentModel{ID: second, EntityID: id, Name: "enc2", Size: 20, Digest: "d2", Servername: "/tmp/bank/b/b", ChunkCount: 3}, att)
+
+	require.NoError(t, s.DeleteAttachment(ctx, first))
+	assert.Error(t, s.DeleteAttachment(ctx, first))
+	_, err = s.GetAttachment(ctx, first)
+	assert.Error(t, err)
+
+	// вложения удаляются вместе с сущностью
+	require.NoError(t, s.DeleteEntity(ctx, id, userID))
+	require.NoError(t, s.PurgeEntity(ctx, id, userID))
+	list, err = s.GetAttachments(ctx, id)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	list, err = s.GetAttachments(ctx, other)
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
+
+func testTransaction(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+
+	// хранилище на время транзакции может быть заблокировано, поэтому данные готовятся заранее
+	ent := cardEntity(t, s, userID)
+
+	// откат
+	var id int32
+	err := s.Transaction(ctx, func(repo entity.EntityRepo) error {
+		var err error
+		id, err = repo.CreateEntity(ctx, ent)
+		require.NoError(t, err)
+		_, err = repo.GetEntity(ctx, id)
+		require.NoError(t, err)
+
+		return errors.New("testerr")
+	})
+	require.Error(t, err)
+	_, err = s.GetEntity(ctx, id)
+	assert.Error(t, err)
+
+	// фиксация
+	err = s.Transaction(ctx, func(repo entity.EntityRepo) error {
+		var err error
+		id, err = repo.CreateEntity(ctx, ent)
+		if err != nil {
+			return err
+		}
+
+		return repo.DeleteEntity(ctx, id, userID)
+	})
+	require.NoError(t, err)
+	_, err = s.GetDeletedEntity(ctx, id, userID)
+	assert.NoError(t, err)
+}
+
+func testConcurrency(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	ent := cardEntity(t, s, userID)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			_, err := s.UserCreate(ctx, fmt.Sprintf("user%d", i), "password", "salt")
+			errs <- err
+		}(i)
+		go func() {
+			defer wg.Done()
+			errs <- s.Transaction(ctx, func(repo entity.EntityRepo) error {
+				_, err := repo.CreateEntity(ctx, ent)
+				return err
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	list, err := s.GetEntityListByType(ctx, "card", userID, entity.ListFilter{})
+	require.NoError(t, err)
+	assert.Len(t, list, 10)
+}
+
+// testLockEntity транзакции, заблокировавшие сущность, проверяют количество вложений и добавляют новое по очереди:
+// параллельные добавления не превышают ограничение
+func testLockEntity(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	id, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+
+	assert.Error(t, s.Transaction(ctx, func(repo entity.EntityRepo) error {
+		return repo.LockEntity(ctx, id+100)
+	}))
+
+	const limit = 3
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- s.Transaction(ctx, func(repo entity.EntityRepo) error {
+				err := repo.LockEntity(ctx, id)
+				if err != nil {
+					return err
+				}
+				list, err := repo.GetAttachments(ctx, id)
+				if err != nil {
+					return err
+				}
+				if len(list) >= limit {
+					return nil
+				}
+				// окно между проверкой и вставкой, в которое без блокировки успевают другие транзакции
+				time.Sleep(10 * time.Millisecond)
+				_, err = repo.CreateAttachment(ctx, entity.AttachmentModel{EntityID: id, Name: fmt.Sprintf("enc%d", i), Servername: fmt.Sprintf("/tmp/bank/%d/%d", i, i)})
+				return err
+			})
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	list, err := s.GetAttachments(ctx, id)
+	require.NoError(t, err)
+	assert.Len(t, list, limit)
+}
+
+// testListPages постраничный список: сортировка, пропуск до предыдущей страницы и ограничение выполняет хранилище
+func testListPages(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	ids := make([]int32, 4)
+	for i := range ids {
+		id, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+		require.NoError(t, err)
+		ids[i] = id
+	}
+	_, err := s.CreateEntity(ctx, cardEntity(t, s, otherID))
+	require.NoError(t, err)
+	deleted, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+	require.NoError(t, s.DeleteEntity(ctx, deleted, userID))
+
+	// у первой и третьей сущности время получения не задано, они идут раньше остальных
+	accessed := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, s.TouchEntity(ctx, ids[1], userID, accessed.Add(time.Hour)))
+	require.NoError(t, s.TouchEntity(ctx, ids[3], userID, accessed))
+
+	// pages сущности всех страниц по две
+	pages := func(page entity.ListPage) [][]int32 {
+		var res [][]int32
+		page.Limit = 2
+		for {
+			list, err := s.ListEntities(ctx, userID, page)
+			require.NoError(t, err)
+			if len(list) == 0 {
+				return res
+			}
+			for _, item := range list {
+				assert.Equal(t, []string{"Банк:Тест"}, metaPairs(item.Metainfo))
+			}
+			res = append(res, listIDs(list))
+			key := page.Key(list[len(list)-1])
+			page.After = &key
+		}
+	}
+
+	assert.Equal(t, [][]int32{{ids[0], ids[1]}, {ids[2], ids[3]}}, pages(entity.ListPage{}))
+	assert.Equal(t, [][]int32{{ids[3], ids[2]}, {ids[1], ids[0]}}, pages(entity.ListPage{Desc: true}))
+	assert.Equal(t, [][]int32{{ids[0], ids[2]}, {ids[3], ids[1]}}, pages(entity.ListPage{Sort: entity.SortAccessed}))
+	assert.Equal(t, [][]int32{{ids[1], ids[3]}, {ids[2], ids[0]}}, pages(entity.ListPage{Sort: entity.SortAccessed, Desc: true}))
+	assert.Equal(t, [][]int32{{ids[0], ids[1]}, {ids[2], ids[3]}}, pages(entity.ListPage{Etypes: []string{"card", "text"}}))
+	assert.Empty(t, pages(entity.ListPage{Etypes: []string{"text"}}))
+
+	require.NoError(t, s.SetFavorite(ctx, ids[2], userID, true))
+	assert.Equal(t, [][]int32{{ids[2]}}, pages(entity.ListPage{Sort: entity.SortCreated, Filter: entity.ListFilter{Favorite: true}}))
+}
+
+// testDomainService работа доменного сервиса сущностей поверх хранилища:
+// внутри единицы работы сервис обращается к описаниям полей, что не должно блокировать хранилище
+func testDomainService(t *testing.T, s Storage) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	userID := createUser(t, s, "owner")
+
+	service, err := entity.NewEntity(s, s)
+	require.NoError(t, err)
+
+	fieldID, _, err := s.GetFieldByEtypeAndName(ctx, "binary", "Произвольные бинарные данные (путь к файлу)")
+	require.NoError(t, err)
+	ent := entity.EntityModel{
+		UserID: userID,
+		Etype:  "binary",
+		Props:  []entity.Property{{FieldID: fieldID, Value: "file.bin"}},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		id, err := service.AddEntity(ctx, ent)
+		require.NoError(t, err)
+
+		// чужая сущность не отдается
+		_, err = service.Entity(ctx, id, userID+100, true)
+		require.Error(t, err)
+
+		saved, err := service.Entity(ctx, id, userID, true)
+		require.NoError(t, err)
+		require.Len(t, saved.Props, 1)
+		fd := &entity.BinaryFileProperty{}
+		require.NoError(t, json.Unmarshal([]byte(saved.Props[0].Value), fd))
+		defer os.RemoveAll(path.Dir(fd.Servername))
+		assert.Equal(t, "file.bin", fd.Clientname)
+
+		ent.ID = id
+		require.NoError(t, service.SaveEditEntity(ctx, ent))
+		assert.NoDirExists(t, path.Dir(fd.Servername))
+
+		saved, err = service.Entity(ctx, id, userID, true)
+		require.NoError(t, err)
+		fd = &entity.BinaryFileProperty{}
+		require.NoError(t, json.Unmarshal([]byte(saved.Props[0].Value), fd))
+		require.NoError(t, os.RemoveAll(path.Dir(fd.Servername)))
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		t.Fatal("domain service blocked on storage")
+	}
+}
+
+// testDomainAttachments добавление, получение и удаление вложений доменным сервисом
+func testDomainAttachments(t *testing.T, s Storage) {
+	ctx := context.Background()
+	userID := createUser(t, s, "owner")
+	otherID := createUser(t, s, "other")
+
+	service, err := entity.NewEntity(s, s)
+	require.NoError(t, err)
+	id, err := s.CreateEntity(ctx, cardEntity(t, s, userID))
+	require.NoError(t, err)
+
+	key := utils.SymmPassCreate("password", "secret")
+	encrypt := func(data string) []byte { return utils.EncryptBinary([]byte(data), key) }
+	upload := func(userID int32, info *pb.Attachment, chunks ...[]byte) (*attachmentUpload, error) {
+		stream := &attachmentUpload{ctx: ctx, reqs: []*pb.AddAttachmentRequest{{Data: &pb.AddAttachmentRequest_Info{Info: info}}}}
+		for _, chunk := range chunks {
+			stream.reqs = append(stream.reqs, &pb.AddAttachmentRequest{Data: &pb.AddAttachmentRequest_ChunkData{ChunkData: chunk}})
+		}
+		_, err := service.AddAttachment(stream, userID)
+		return stream, err
+	}
+
+	// чужая сущность и вложение без имени не принимаются
+	_, err = upload(otherID, &pb.Attachment{EntityId: id, Name: "enc"}, encrypt("data"))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = upload(userID, &pb.Attachment{EntityId: id}, encrypt("data"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// размер данных должен совпадать с заявленным, фрагмент не может быть короче заголовка шифрования
+	_, err = upload(userID, &pb.Attachment{EntityId: id, Name: "enc", Size: 7}, encrypt("abc"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = upload(userID, &pb.Attachment{EntityId: id, Name: "enc", Size: 0}, []byte("abc"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	chunks := [][]byte{encrypt("abc"), encrypt("defg")}
+	stream, err := upload(userID, &pb.Attachment{EntityId: id, Name: "enc", Size: 7, Digest: "sum"}, chunks...)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7+2*utils.BinaryOverhead), stream.resp.Size)
+
+	list, err := service.ListAttachments(ctx, id, userID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, stream.resp.Id, list[0].ID)
+	assert.Equal(t, int32(2), list[0].ChunkCount)
+	dir := path.Dir(list[0].Servername)
+	defer os.RemoveAll(dir)
+	_, err = service.ListAttachments(ctx, id, otherID)
+	assert.Error(t, err)
+
+	// папка и файлы фрагментов доступны только владельцу процесса
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+	info, err = os.Stat(fmt.Sprintf("%v/%06d_%v", dir, 1, path.Base(list[0].Servername)))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	download := &attachmentDownload{ctx: ctx}
+	assert.Error(t, service.DownloadAttachment(list[0].ID, otherID, download))
+	require.NoError(t, service.DownloadAttachment(list[0].ID, userID, download))
+	assert.Equal(t, chunks, download.chunks)
+
+	assert.Error(t, service.DeleteAttachment(ctx, list[0].ID, otherID))
+	require.NoError(t, service.DeleteAttachment(ctx, list[0].ID, userID))
+	assert.NoDirExists(t, dir)
+
+	// вложения, добавленные за время передачи данных, учитываются в ограничении количества
+	stream = &attachmentUpload{ctx: ctx, reqs: []*pb.AddAttachmentRequest{{Data: &pb.AddAttachmentRequest_Info{Info: &pb.Attachment{EntityId: id, Name: "enc", Size: 4}}}}}
+	stream.reqs = append(stream.reqs, &pb.AddAttachmentRequest{Data: &pb.AddAttachmentRequest_ChunkData{ChunkData: encrypt("data")}})
+	stream.onEOF = func() {
+		for i := 0; i < constants.MaxAttachments; i++ {
+			_, err := s.CreateAttachment(ctx, entity.AttachmentModel{EntityID: id, Name: "enc", Servername: "/tmp/bank/x/x"})
+			require.NoError(t, err)
+		}
+	}
+	_, err = service.AddAttachment(stream, userID)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	list, err = service.ListAttachments(ctx, id, userID)
+	require.NoError(t, err)
+	require.Len(t, list, constants.MaxAttachments)
+	for _, att := range list {
+		require.NoError(t, s.DeleteAttachment(ctx, att.ID))
+	}
+
+	// при окончательном удалении сущности удаляются и файлы вложений
+	_, err = upload(userID, &pb.Attachment{EntityId: id, Name: "enc", Size: 4}, encrypt("data"))
+	require.NoError(t, err)
+	list, err = service.ListAttachments(ctx, id, userID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	dir = path.Dir(list[0].Servername)
+	defer os.RemoveAll(dir)
+	require.NoError(t, service.DeleteEntity(ctx, id, userID))
+	assert.DirExists(t, dir)
+	require.NoError(t, service.PurgeEntity(ctx, id, userID))
+	assert.NoDirExists(t, dir)
+}
+
+// attachmentUpload поток загрузки вложения: отдает заготовленные сообщения и запоминает ответ
+type attachmentUpload struct {
+	grpc.ServerStream
+	ctx   context.Context
+	reqs  []*pb.AddAttachmentRequest
+	resp  *pb.AddAttachmentResponse
+	onEOF func() // вызывается, когда сообщения закончились
+}
+
+func (u *attachmentUpload) Context() context.Context { return u.ctx }
+
+func (u *attachmentUpload) Recv() (*pb.AddAttachmentRequest, error) {
+	if len(u.reqs) == 0 {
+		if u.onEOF != nil {
+			u.onEOF()
+			u.onEOF = nil
+		}
+		return nil, io.EOF
+	}
+	req := u.reqs[0]
+	u.reqs = u.reqs[1:]
+
+	return req, nil
+}
+
+func (u *attachmentUpload) SendAndClose(resp *pb.AddAttachmentResponse) error {
+	u.resp = resp
+	return nil
+}
+
+// attachmentDownload поток отдачи вложения: запоминает отправленные фрагменты
+type attachmentDownload struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (d *attachmentDownload) Context() context.Context { return d.ctx }
+
+func (d *attachmentDownload) Send(resp *pb.DownloadBinResponse) error {
+	d.chunks = append(d.chunks, resp.ChunkData)
+	return nil
+}
+
+// listIDs коды сущностей списка в порядке списка
+func listIDs(list []entity.EntitySummary) []int32 {
+	ids := make([]int32, 0, len(list))
+	for _, item := range list {
+		ids = append(ids, item.ID)
+	}
+
+	return ids
+}
+
+// metaPairs метаинформация строками название:значение
+func metaPairs(metainfo []entity.Metainfo) []string {
+	pairs := make([]string, 0, len(metainfo))
+	for _, meta := range metainfo {
+		pairs = append(pairs, meta.Title+":"+meta.Value)
+	}
+
+	return pairs
+}
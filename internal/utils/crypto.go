@@ -3,7 +3,9 @@ package utils
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 )
@@ -79,6 +81,9 @@ func SymmPassCreate(password string, secretKey string) string {
 	return string(key)
 }
 
+// BinaryOverhead прирост размера данных при шифровании EncryptBinary: nonce (12 байт) и тег (16 байт) AES-GCM
+const BinaryOverhead = 12 + 16
+
 // EncryptBinary шифровка бинарных данных
 func EncryptBinary(binData []byte, secretKey string) []byte {
 	aes, err := aes.NewCipher([]byte(secretKey))
@@ -127,3 +132,24 @@ func DecryptBinary(cipherBin []byte, secretKey string) []byte {
 
 	return binData
 }
+
+// blindIndexContext контекст вывода ключа слепого индекса, отделяет его от ключа шифрования
+const blindIndexContext = "gophkeeper blind index v1"
+
+// BlindIndexKeyCreate ключ слепого индекса, производный от ключа хранилища (пароль и секретный ключ)
+// Ключ не совпадает с ключом шифрования, поэтому токены не раскрывают ключ шифрования
+func BlindIndexKeyCreate(password string, secretKey string) []byte {
+	mac := hmac.New(sha256.New, []byte(SymmPassCreate(password, secretKey)))
+	mac.Write([]byte(blindIndexContext))
+
+	return mac.Sum(nil)
+}
+
+// BlindToken токен слепого индекса: HMAC-SHA256 от текста, усеченный до 128 бит, в виде hex строки
+// Одинаковые тексты дают одинаковые токены, по токену текст восстановить нельзя без ключа
+func BlindToken(key []byte, text string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(text))
+
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
@@ -36,3 +36,15 @@ func TestBinaryEncryption(t *testing.T) {
 	assert.Equal(t, str, string(decipher))
 
 }
+
+func TestBlindToken(t *testing.T) {
+	key := BlindIndexKeyCreate("12345678", "tail1234")
+	assert.Len(t, key, 32)
+	assert.NotEqual(t, key, BlindIndexKeyCreate("12345679", "tail1234"))
+
+	token := BlindToken(key, "w:github")
+	assert.Len(t, token, 32)
+	assert.Equal(t, token, BlindToken(key, "w:github"))
+	assert.NotEqual(t, token, BlindToken(key, "w:gitlab"))
+	assert.NotEqual(t, token, BlindToken(BlindIndexKeyCreate("12345679", "tail1234"), "w:github"))
+}